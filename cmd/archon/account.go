@@ -1,21 +1,18 @@
-// This script is a small convenience tool for manipulating user accounts in the
-// configured server database.
+// This script is a small convenience tool for managing user accounts through
+// the shipgate.
 package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
 
-	"gorm.io/driver/postgres"
-	"gorm.io/gorm"
-	gormlogger "gorm.io/gorm/logger"
-
 	"github.com/dcrodman/archon/internal/core"
-	"github.com/dcrodman/archon/internal/core/data"
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/core/proto"
 	"github.com/dcrodman/archon/internal/shipgate"
-	"github.com/glebarez/sqlite"
 	"github.com/spf13/cobra"
 )
 
@@ -26,19 +23,46 @@ var accountCmd = &cobra.Command{
 
 var accountAddCmd = &cobra.Command{
 	Use:   "add",
-	Short: "Registers new accounts in the database",
+	Short: "Registers new accounts",
 	Run:   AccountAddCommand,
 }
 
 var accountDeleteCmd = &cobra.Command{
 	Use:   "delete",
-	Short: "Deletes accounts from the database",
+	Short: "Deletes accounts",
 	Run:   AccountDeleteCommand,
 }
 
-var PermanentFlag bool
+var accountPasswordCmd = &cobra.Command{
+	Use:   "password [username]",
+	Short: "Changes the password of an account",
+	Run:   AccountPasswordCommand,
+}
+
+var accountResetPasswordCmd = &cobra.Command{
+	Use:   "reset-password [username]",
+	Short: "Prints a token the player can use to reset their password, or sets a new one with --password",
+	Run:   AccountResetPasswordCommand,
+}
+
+var accountListCmd = &cobra.Command{
+	Use:   "list [username prefix]",
+	Short: "Lists accounts, optionally only those whose usernames start with a prefix",
+	Run:   AccountListCommand,
+}
 
-func initDB() *gorm.DB {
+var accountRoleCmd = &cobra.Command{
+	Use:   "role [username] [role]",
+	Short: "Assigns a role to an account",
+	Run:   AccountRoleCommand,
+}
+
+var (
+	PermanentFlag bool
+	PasswordFlag  string
+)
+
+func loadConfig() *core.Config {
 	// Change to the same directory as the config file so that any relative
 	// paths in the config file will resolve.
 	if ConfigFlag != "" {
@@ -47,29 +71,23 @@ func initDB() *gorm.DB {
 			os.Exit(1)
 		}
 	}
+	return core.LoadConfig(ConfigFlag)
+}
 
-	cfg := core.LoadConfig(ConfigFlag)
-	var dialector gorm.Dialector
-	switch strings.ToLower(cfg.Database.Engine) {
-	case "sqlite":
-		dialector = sqlite.Open(cfg.QualifiedPath("archon.db"))
-	case "postgres":
-		dialector = postgres.Open(cfg.DatabaseURL())
-	default:
-		fmt.Println("unsupported database engine:", cfg.Database.Engine)
-		os.Exit(1)
-	}
-
-	db, err := gorm.Open(dialector, &gorm.Config{Logger: gormlogger.Discard})
+// initShipgate returns a client for the shipgate, which the tools go through
+// so that every change is validated and audited in one place.
+func initShipgate() (shipgate.Shipgate, *core.Config) {
+	cfg := loadConfig()
+	client, err := shipgate.NewRPCClient(cfg)
 	if err != nil {
-		fmt.Println("error connecting to database:", err.Error())
+		fmt.Println("error creating shipgate client:", err)
 		os.Exit(1)
 	}
-	return db
+	return client, cfg
 }
 
 func AccountAddCommand(cmd *cobra.Command, args []string) {
-	db := initDB()
+	client, _ := initShipgate()
 	var (
 		usernameInput string
 		username      string
@@ -88,40 +106,26 @@ func AccountAddCommand(cmd *cobra.Command, args []string) {
 
 	passwordInput, args = popArg(args, "Password")
 	password = strings.ToLower(passwordInput)
-	if password != usernameInput {
+	if password != passwordInput {
 		fmt.Println("Warning: PSOBB client does not support capital letters in passwords. Using lowercase version")
 	}
 
 	email, _ = popArg(args, "Email")
 
-	account, err := findAccount(db, username)
-	if err != nil {
-		fmt.Println("error finding account:", err)
-		return
-	} else if account != nil {
-		fmt.Printf("account '%s' already exists; skipping\n", username)
-		return
-	}
-
-	if err := data.CreateAccount(db, &data.Account{
+	account, err := client.CreateAccount(context.Background(), &shipgate.CreateAccountRequest{
 		Username: username,
-		Password: shipgate.HashPassword(password),
+		Password: password,
 		Email:    email,
-	}); err != nil {
-		fmt.Println("error creating account:", err)
-		return
-	}
-
-	account, err = findAccount(db, username)
+	})
 	if err != nil {
-		fmt.Println("error finding account:", err)
+		fmt.Println("error creating account:", err)
 		return
 	}
-	fmt.Printf("created account for '%s' (ID: %d)\n", account.Username, account.ID)
+	fmt.Printf("created account for '%s' (ID: %d)\n", account.Username, account.Id)
 }
 
 func AccountDeleteCommand(cmd *cobra.Command, args []string) {
-	db := initDB()
+	client, _ := initShipgate()
 
 	usernameInput, _ := popArg(args, "Username")
 	username := strings.ToLower(usernameInput)
@@ -129,24 +133,133 @@ func AccountDeleteCommand(cmd *cobra.Command, args []string) {
 		fmt.Println("Warning: PSOBB client does not support capital letters in usernames. Using lowercase version")
 	}
 
-	account, err := findAccount(db, username)
+	account, err := findAccount(client, username)
 	if err != nil {
-		fmt.Println("error finding account:", err)
+		fmt.Println(err)
 		return
 	}
 
-	if PermanentFlag {
-		if err := data.PermanentlyDeleteAccount(db, account); err != nil {
-			fmt.Println("error deleting account:", err)
-			return
+	if _, err := client.DeleteAccount(context.Background(), &shipgate.DeleteAccountRequest{
+		AccountId: account.Id,
+		Permanent: PermanentFlag,
+	}); err != nil {
+		fmt.Println("error deleting account:", err)
+		return
+	}
+	fmt.Println("deleted account")
+}
+
+func AccountPasswordCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	var username, passwordInput string
+	username, args = popArg(args, "Username")
+	passwordInput, _ = popArg(args, "New password")
+	password := strings.ToLower(passwordInput)
+	if password != passwordInput {
+		fmt.Println("Warning: PSOBB client does not support capital letters in passwords. Using lowercase version")
+	}
+
+	account, err := findAccount(client, strings.ToLower(username))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if _, err := client.SetPassword(context.Background(), &shipgate.SetPasswordRequest{
+		AccountId: account.Id,
+		Password:  password,
+	}); err != nil {
+		fmt.Println("error changing password:", err)
+		return
+	}
+	fmt.Printf("changed password of '%s'\n", account.Username)
+}
+
+func AccountResetPasswordCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	username, _ := popArg(args, "Username")
+	account, err := findAccount(client, strings.ToLower(username))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if PasswordFlag != "" {
+		password := strings.ToLower(PasswordFlag)
+		if password != PasswordFlag {
+			fmt.Println("Warning: PSOBB client does not support capital letters in passwords. Using lowercase version")
 		}
-	} else {
-		if err := data.DeleteAccount(db, account); err != nil {
-			fmt.Println("error deleting account:", err)
+		if _, err := client.SetPassword(context.Background(), &shipgate.SetPasswordRequest{
+			AccountId: account.Id,
+			Password:  password,
+		}); err != nil {
+			fmt.Println("error changing password:", err)
 			return
 		}
+		fmt.Printf("changed password of '%s'\n", account.Username)
+		return
 	}
-	fmt.Println("deleted account")
+
+	resp, err := client.IssuePasswordReset(context.Background(), &shipgate.IssuePasswordResetRequest{AccountId: account.Id})
+	if err != nil {
+		fmt.Println("error issuing password reset token:", err)
+		return
+	}
+	fmt.Printf("password reset token for '%s' (valid for 24 hours; redeem by POSTing it and a new password to /api/password-reset):\n%s\n",
+		account.Username, resp.Token)
+}
+
+func AccountListCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	prefix := ""
+	if len(args) > 0 {
+		prefix = args[0]
+	}
+	resp, err := client.ListAccounts(context.Background(), &shipgate.ListAccountsRequest{UsernamePrefix: prefix})
+	if err != nil {
+		fmt.Println("error retrieving accounts:", err)
+		return
+	} else if len(resp.Accounts) == 0 {
+		fmt.Println("no accounts found")
+		return
+	}
+
+	for _, account := range resp.Accounts {
+		fmt.Printf("%d\t%s\t%s\n", account.Id, account.Username, account.Email)
+	}
+}
+
+func AccountRoleCommand(cmd *cobra.Command, args []string) {
+	client, cfg := initShipgate()
+
+	roles, err := auth.NewRoles(cfg)
+	if err != nil {
+		fmt.Println("error loading roles:", err)
+		return
+	}
+
+	var username, roleName string
+	username, args = popArg(args, "Username")
+	roleName, _ = popArg(args, fmt.Sprintf("Role (%s)", strings.Join(roles.Names(), ", ")))
+
+	role, ok := roles.ByName(roleName)
+	if !ok {
+		fmt.Printf("unknown role '%s'; must be one of: %s\n", roleName, strings.Join(roles.Names(), ", "))
+		return
+	}
+
+	username = strings.ToLower(username)
+	if _, err := client.SetPrivilegeLevel(context.Background(), &shipgate.SetPrivilegeLevelRequest{
+		Username:       username,
+		PrivilegeLevel: uint32(role.Level),
+	}); err != nil {
+		fmt.Println("error assigning role:", err)
+		return
+	}
+	fmt.Printf("assigned role '%s' to '%s'\n", role.Name, username)
 }
 
 func popArg(args []string, prompt string) (string, []string) {
@@ -162,10 +275,16 @@ func popArg(args []string, prompt string) (string, []string) {
 	return scanner.Text(), args
 }
 
-func findAccount(db *gorm.DB, username string) (*data.Account, error) {
-	account, err := data.FindAccountByUsername(db, username)
+// findAccount returns the account with username or an error if it doesn't exist.
+func findAccount(client shipgate.Shipgate, username string) (*proto.Account, error) {
+	resp, err := client.ListAccounts(context.Background(), &shipgate.ListAccountsRequest{UsernamePrefix: username})
 	if err != nil {
 		return nil, fmt.Errorf("error looking up account: %v", err)
 	}
-	return account, nil
+	for _, account := range resp.Accounts {
+		if account.Username == username {
+			return account, nil
+		}
+	}
+	return nil, fmt.Errorf("account '%s' does not exist", username)
 }
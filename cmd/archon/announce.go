@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+var announceCmd = &cobra.Command{
+	Use:   "announce [message]",
+	Short: "Sends a message to every player on every ship",
+	Run:   AnnounceCommand,
+	Args:  cobra.MinimumNArgs(1),
+}
+
+// Delay before the announcement is delivered (e.g. for maintenance warnings).
+var AnnounceDelayFlag time.Duration
+
+func AnnounceCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	req := &shipgate.BroadcastRequest{Message: strings.Join(args, " ")}
+	if AnnounceDelayFlag > 0 {
+		req.DeliverAt = time.Now().Add(AnnounceDelayFlag).Unix()
+	}
+
+	if _, err := client.Broadcast(context.Background(), req); err != nil {
+		fmt.Println("error sending announcement:", err)
+		os.Exit(1)
+	}
+
+	if AnnounceDelayFlag > 0 {
+		fmt.Println("scheduled announcement for", time.Unix(req.DeliverAt, 0).Format(time.RFC1123))
+	} else {
+		fmt.Println("sent announcement")
+	}
+}
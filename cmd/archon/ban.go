@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dcrodman/archon/internal/core/data"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+var banCmd = &cobra.Command{
+	Use:   "ban",
+	Short: "Ban management tools",
+}
+
+var banAddCmd = &cobra.Command{
+	Use:   "add [account|ip|hardware] [username|address|hardware ID]",
+	Short: "Bans an account, IP address (or CIDR range), or client hardware ID",
+	Run:   BanAddCommand,
+}
+
+var banListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the bans currently in effect",
+	Run:   BanListCommand,
+}
+
+var banLiftCmd = &cobra.Command{
+	Use:   "lift [ban ID]",
+	Short: "Lifts a ban before it expires",
+	Run:   BanLiftCommand,
+}
+
+var (
+	BanReasonFlag   string
+	BanDurationFlag time.Duration
+)
+
+func BanAddCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	var banType, target string
+	banType, args = popArg(args, "Type (account, ip, hardware)")
+	target, _ = popArg(args, "Target")
+
+	var expiresAt int64
+	if BanDurationFlag > 0 {
+		expiresAt = time.Now().Add(BanDurationFlag).Unix()
+	}
+
+	var ban *shipgate.Ban
+	var err error
+	if banType = strings.ToLower(banType); banType == data.BanTypeAccount {
+		account, findErr := findAccount(client, strings.ToLower(target))
+		if findErr != nil {
+			fmt.Println(findErr)
+			return
+		}
+		ban, err = client.BanAccount(context.Background(), &shipgate.BanAccountRequest{
+			AccountId: account.Id,
+			Reason:    BanReasonFlag,
+			ExpiresAt: expiresAt,
+		})
+	} else {
+		ban, err = client.BanPlayer(context.Background(), &shipgate.BanRequest{
+			Ban: &shipgate.Ban{Type: banType, Value: target, Reason: BanReasonFlag, ExpiresAt: expiresAt},
+		})
+	}
+	if err != nil {
+		fmt.Println("error creating ban:", err)
+		return
+	}
+	fmt.Printf("banned %s '%s' (ban ID: %d, %s)\n", ban.Type, target, ban.Id, describeExpiry(ban))
+}
+
+func BanListCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	resp, err := client.ListBans(context.Background(), &shipgate.ListBansRequest{})
+	if err != nil {
+		fmt.Println("error retrieving bans:", err)
+		return
+	} else if len(resp.Bans) == 0 {
+		fmt.Println("no bans in effect")
+		return
+	}
+
+	for _, ban := range resp.Bans {
+		target := ban.Value
+		if ban.Type == data.BanTypeAccount {
+			target = fmt.Sprintf("account %d", ban.AccountId)
+		}
+		fmt.Printf("%d\t%s\t%s\t%s\t%s\n", ban.Id, ban.Type, target, describeExpiry(ban), ban.Reason)
+	}
+}
+
+func BanLiftCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	idInput, _ := popArg(args, "Ban ID")
+	id, err := strconv.ParseUint(idInput, 10, 64)
+	if err != nil {
+		fmt.Printf("invalid ban ID '%s'\n", idInput)
+		return
+	}
+
+	if _, err := client.LiftBan(context.Background(), &shipgate.LiftBanRequest{BanId: id}); err != nil {
+		fmt.Println("error lifting ban:", err)
+		return
+	}
+	fmt.Printf("lifted ban %d\n", id)
+}
+
+func describeExpiry(ban *shipgate.Ban) string {
+	if ban.ExpiresAt == 0 {
+		return "permanent"
+	}
+	return "until " + time.Unix(ban.ExpiresAt, 0).Format(time.RFC1123)
+}
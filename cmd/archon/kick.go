@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+var kickCmd = &cobra.Command{
+	Use:   "kick [username] [message]",
+	Short: "Disconnects a player from whichever server they're connected to",
+	Run:   KickCommand,
+	Args:  cobra.MinimumNArgs(1),
+}
+
+func KickCommand(cmd *cobra.Command, args []string) {
+	client, _ := initShipgate()
+
+	account, err := findAccount(client, args[0])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if _, err := client.KickPlayer(context.Background(), &shipgate.KickPlayerRequest{
+		AccountId: account.Id,
+		Message:   strings.Join(args[1:], " "),
+	}); err != nil {
+		fmt.Println("error kicking player:", err)
+		os.Exit(1)
+	}
+	fmt.Println("kicked", account.Username)
+}
@@ -18,13 +18,32 @@ func main() {
 
 	accountCmd.AddCommand(accountAddCmd)
 	accountCmd.AddCommand(accountDeleteCmd)
+	accountCmd.AddCommand(accountPasswordCmd)
+	accountCmd.AddCommand(accountResetPasswordCmd)
+	accountCmd.AddCommand(accountListCmd)
+	accountCmd.AddCommand(accountRoleCmd)
 	accountDeleteCmd.Flags().BoolVar(&PermanentFlag, "permanent", false, "Permanently delete the account (as opposed to a soft delete)")
+	accountResetPasswordCmd.Flags().StringVar(&PasswordFlag, "password", "", "Set this password directly instead of printing a reset token")
+
+	banCmd.AddCommand(banAddCmd)
+	banCmd.AddCommand(banListCmd)
+	banCmd.AddCommand(banLiftCmd)
+	banAddCmd.Flags().StringVar(&BanReasonFlag, "reason", "", "Reason for the ban")
+	banAddCmd.Flags().DurationVar(&BanDurationFlag, "for", 0, "How long the ban lasts (e.g. 72h); permanent if not set")
 
 	patchCmd.Flags().StringVarP(&NewAddressFlag, "address", "a", "127.0.0.1", "The new address or IPv4 address")
 	patchCmd.Flags().StringVarP(&ExeVersionFlag, "version", "v", "TethVer12513", "Version of the PSOBB client")
 
+	announceCmd.Flags().DurationVar(&AnnounceDelayFlag, "in", 0, "Deliver the announcement after a delay (e.g. 10m)")
+
 	rootCmd.AddCommand(accountCmd)
+	rootCmd.AddCommand(announceCmd)
+	rootCmd.AddCommand(banCmd)
+	rootCmd.AddCommand(kickCmd)
 	rootCmd.AddCommand(patchCmd)
+	rootCmd.AddCommand(shipgateCmd)
+	rootCmd.AddCommand(loginCmd)
+	rootCmd.AddCommand(shipCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -16,7 +16,36 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var shipgateCmd = &cobra.Command{
+	Use:   "shipgate",
+	Short: "Runs only the shipgate, for ships and login servers running elsewhere",
+	Run:   runComponents(internal.ComponentShipgate),
+}
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Runs the PATCH, DATA, LOGIN, and CHARACTER servers against a remote shipgate",
+	Run:   runComponents(internal.ComponentLogin),
+}
+
+var shipCmd = &cobra.Command{
+	Use:   "ship",
+	Short: "Runs a ship and its blocks against a remote shipgate",
+	Run:   runComponents(internal.ComponentShip),
+}
+
 func ServerCommand(cmd *cobra.Command, args []string) {
+	runServers()
+}
+
+// runComponents returns a command that only runs the servers in components.
+func runComponents(components ...internal.Component) func(*cobra.Command, []string) {
+	return func(cmd *cobra.Command, args []string) {
+		runServers(components...)
+	}
+}
+
+func runServers(components ...internal.Component) {
 	config := core.LoadConfig(ConfigFlag)
 	fmt.Println("loaded configuration from", config.FilePath)
 
@@ -39,7 +68,8 @@ func ServerCommand(cmd *cobra.Command, args []string) {
 
 	// Start up the controller to handle all of the resources and server init.
 	controller := &internal.Controller{
-		Config: config,
+		Config:     config,
+		Components: components,
 	}
 	controller.Start(ctx)
 	fmt.Println("shut down")
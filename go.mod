@@ -10,12 +10,13 @@ require (
 	github.com/google/gopacket v1.1.19
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/pkg/errors v0.8.1 // indirect
-	github.com/spf13/cobra v1.9.1 // indirect
+	github.com/spf13/cobra v1.9.1
 	github.com/spf13/pflag v1.0.7 // indirect
 	github.com/spf13/viper v1.6.2
 	github.com/twitchtv/twirp v8.1.2+incompatible
 	go.uber.org/multierr v1.11.0 // indirect
 	go.uber.org/zap v1.27.0
+	golang.org/x/crypto v0.6.0
 	golang.org/x/text v0.7.0
 	google.golang.org/protobuf v1.25.0
 	gopkg.in/yaml.v2 v2.4.0 // indirect
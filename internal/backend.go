@@ -29,3 +29,10 @@ type Backend interface {
 	// for generally handling all packets from a client as well as sending any responses.
 	Handle(ctx context.Context, c *client.Client, data []byte) error
 }
+
+// DisconnectHandler may be implemented by a Backend that needs to clean up any
+// state tied to a Client once its connection has been closed.
+type DisconnectHandler interface {
+	// HandleDisconnect is called after the client's connection has been closed.
+	HandleDisconnect(c *client.Client)
+}
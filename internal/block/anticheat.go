@@ -0,0 +1,356 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// violationAction is what the block does when a player breaks one of the anti-cheat rules.
+type violationAction string
+
+const (
+	actionLog  violationAction = "log"
+	actionDrop violationAction = "drop"
+	actionKick violationAction = "kick"
+	actionBan  violationAction = "ban"
+)
+
+func parseViolationAction(name string) (violationAction, error) {
+	switch action := violationAction(strings.ToLower(name)); action {
+	case actionLog, actionDrop, actionKick, actionBan:
+		return action, nil
+	case "":
+		return actionLog, nil
+	default:
+		return "", fmt.Errorf("unknown anti-cheat action: %s", name)
+	}
+}
+
+// Item subcommands checked by the anti-cheat rules.
+const (
+	subcmdItemEquip   = 0x25
+	subcmdItemUnequip = 0x26
+	subcmdItemUse     = 0x27
+	subcmdItemDiscard = 0x29
+	subcmdItemPickUp  = 0x59
+	subcmdDropStack   = 0xC3
+)
+
+const (
+	// Most meseta a character can carry.
+	maxMeseta = 999999
+	// Item ID used for meseta in drop commands.
+	mesetaItemID = 0xFFFFFFFF
+	// Items in a player's inventory are numbered from a base determined by their
+	// client ID, each player getting its own block of IDs.
+	inventoryItemIDBase  = 0x00010000
+	inventoryItemIDRange = 0x00200000
+	// Items created by the server are numbered after the last player's block of IDs.
+	createdItemIDBase = inventoryItemIDBase + maxGamePlayers*inventoryItemIDRange
+)
+
+// Last floor number in each episode.
+var lastFloors = map[int]int{1: 0x11, 2: 0x11, 4: 0x0A}
+
+// Smallest valid size of the subcommands the block inspects, excluding the packet header.
+var minSubcommandSizes = map[uint8]int{
+	subcmdEnemyHit:           binary.Size(packets.EnemyHitCommand{}) - packets.BBHeaderSize,
+	subcmdFloorChange:        binary.Size(packets.FloorChangeCommand{}) - packets.BBHeaderSize,
+	subcmdFloorWarp:          binary.Size(packets.FloorChangeCommand{}) - packets.BBHeaderSize,
+	subcmdItemEquip:          binary.Size(packets.ItemCommand{}) - packets.BBHeaderSize,
+	subcmdItemUnequip:        binary.Size(packets.ItemCommand{}) - packets.BBHeaderSize,
+	subcmdItemUse:            binary.Size(packets.ItemCommand{}) - packets.BBHeaderSize,
+	subcmdItemDiscard:        binary.Size(packets.ItemCommand{}) - packets.BBHeaderSize,
+	subcmdChallengeRecords:   binary.Size(packets.ChallengeRecordsCommand{}) - packets.BBHeaderSize,
+	subcmdEnemyExperienceReq: binary.Size(packets.EnemyExperienceRequest{}) - packets.BBHeaderSize,
+	subcmdDropStack:          binary.Size(packets.DropStackCommand{}) - packets.BBHeaderSize,
+	subcmdItemPickUp:         binary.Size(packets.PickUpItemCommand{}) - packets.BBHeaderSize,
+}
+
+// validationRule checks the game commands sent by players for one kind of cheating.
+type validationRule struct {
+	name string
+	// Subcommands the rule applies to. Rules without any apply to every subcommand.
+	subcommands []uint8
+	// check returns an error describing the violation if the command breaks the rule.
+	check func(s *Server, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) error
+}
+
+func (r *validationRule) appliesTo(subcommand uint8) bool {
+	if len(r.subcommands) == 0 {
+		return true
+	}
+	for _, s := range r.subcommands {
+		if s == subcommand {
+			return true
+		}
+	}
+	return false
+}
+
+// validator runs game commands through each of its rules, which are checked in
+// the order they were registered.
+type validator struct {
+	rules   []*validationRule
+	actions map[string]violationAction
+}
+
+func (v *validator) register(rule *validationRule) {
+	v.rules = append(v.rules, rule)
+}
+
+// defaultValidator returns a validator containing every anti-cheat rule supported
+// by the block server, with the actions configured for each. New rules only need
+// to be registered here.
+func defaultValidator(cfg *core.Config) (*validator, error) {
+	v := &validator{}
+	v.register(&validationRule{name: "packet_size", check: checkPacketSize})
+	v.register(&validationRule{
+		name:        "item_id",
+		subcommands: []uint8{subcmdItemEquip, subcmdItemUnequip, subcmdItemUse, subcmdItemDiscard, subcmdDropStack},
+		check:       checkItemID,
+	})
+	v.register(&validationRule{name: "meseta", subcommands: []uint8{subcmdDropStack}, check: checkMeseta})
+	v.register(&validationRule{name: "stat_edit", subcommands: []uint8{subcmdLevelUp, subcmdGiveExperience}, check: checkStatEdit})
+	v.register(&validationRule{name: "warp_floor", subcommands: []uint8{subcmdFloorChange, subcmdFloorWarp}, check: checkWarpFloor})
+
+	if err := v.configure(cfg); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// configure sets the action taken for each rule from the anti-cheat config.
+func (v *validator) configure(cfg *core.Config) error {
+	defaultAction, err := parseViolationAction(cfg.BlockServer.AntiCheat.DefaultAction)
+	if err != nil {
+		return err
+	}
+
+	v.actions = make(map[string]violationAction)
+	for _, rule := range v.rules {
+		v.actions[rule.name] = defaultAction
+	}
+	for name, actionName := range cfg.BlockServer.AntiCheat.Rules {
+		if _, ok := v.actions[name]; !ok {
+			return fmt.Errorf("unknown anti-cheat rule: %s", name)
+		}
+		if v.actions[name], err = parseViolationAction(actionName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGameCommand checks a game command against the anti-cheat rules and
+// acts on any violations. Returns false if the command should not be processed.
+func (s *Server) validateGameCommand(ctx context.Context, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) bool {
+	for _, rule := range s.validator.rules {
+		if !rule.appliesTo(subcommand.Type) {
+			continue
+		}
+		err := rule.check(s, c, header, subcommand, data)
+		if err == nil {
+			continue
+		}
+
+		action := s.validator.actions[rule.name]
+		s.reportViolation(ctx, c, rule.name, err.Error(), action)
+		switch action {
+		case actionLog:
+			continue
+		case actionKick, actionBan:
+			if err := s.sendTextMessage(c, "You have been disconnected for breaking the server's rules."); err != nil {
+				s.Logger.Warnf("[%s] error notifying %s of disconnect: %v", s.Name, c.IPAddr(), err)
+			}
+			// Closing the connection ends the player's packet loop, which handles the rest of the cleanup.
+			if err := c.Close(); err != nil {
+				s.Logger.Warnf("[%s] error disconnecting %s: %v", s.Name, c.IPAddr(), err)
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// reportViolation records a violation through the shipgate for GMs to review.
+func (s *Server) reportViolation(ctx context.Context, c *client.Client, rule, details string, action violationAction) {
+	var characterName string
+	if c.Character != nil {
+		characterName = c.Character.ReadableName
+	}
+	s.Logger.Warnf("[%s] %s (%s) broke anti-cheat rule %s: %s (action: %s)",
+		s.Name, c.IPAddr(), characterName, rule, details, action)
+
+	if _, err := s.shipgateClient.ReportViolation(ctx, &shipgate.Violation{
+		AccountId:     c.Account.GetId(),
+		CharacterName: characterName,
+		Rule:          rule,
+		Details:       details,
+		Action:        string(action),
+		Source:        s.Name,
+	}); err != nil {
+		s.Logger.Errorf("[%s] error reporting violation by %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
+// checkPacketSize makes sure the size a subcommand claims to be fits within the
+// command and is large enough for what the block expects to read from it.
+func checkPacketSize(s *Server, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) error {
+	available := int(header.Size) - packets.BBHeaderSize
+	if int(header.Size) > len(data) {
+		available = len(data) - packets.BBHeaderSize
+	}
+
+	size := int(subcommand.Size) * 4
+	// Large commands set the size to zero and put the real size after the subcommand header.
+	if subcommand.Size == 0 && available >= 8 {
+		size = int(binary.LittleEndian.Uint32(data[packets.BBHeaderSize+4:]))
+	}
+	if size == 0 || size > available {
+		return fmt.Errorf("subcommand %#x claims to be %d bytes but the command only contains %d", subcommand.Type, size, available)
+	}
+	if min, ok := minSubcommandSizes[subcommand.Type]; ok && size < min {
+		return fmt.Errorf("subcommand %#x is %d bytes but must be at least %d", subcommand.Type, size, min)
+	}
+	return nil
+}
+
+// handleItemPickUp records which player picked up an item from the floor so that
+// they're allowed to use it, since items on the floor are numbered outside of the
+// players' ranges. Pickups are announced by the game leader's client, so they're
+// ignored from anyone else.
+func (s *Server) handleItemPickUp(c *client.Client, data []byte) {
+	g := s.gameOf(c)
+	if g == nil {
+		return
+	}
+	if clientID, ok := g.clientID(c); !ok || clientID != g.leader() {
+		return
+	}
+	var cmd packets.PickUpItemCommand
+	if !decodeGameCommand(data, &cmd) || int(cmd.ClientID) >= maxGamePlayers {
+		return
+	}
+	g.recordPickUp(int(cmd.ClientID), cmd.ItemID)
+}
+
+// handleTradeItems records the items a player offers to another player in a
+// trade so that the other player is allowed to use them once they've been traded.
+// The player offering them still can as well, in case the trade is cancelled.
+func (s *Server) handleTradeItems(c *client.Client, data []byte) {
+	g := s.gameOf(c)
+	if g == nil {
+		return
+	}
+	if _, ok := g.clientID(c); !ok {
+		return
+	}
+	var pkt packets.TradeItems
+	if !decodeGameCommand(data, &pkt) || int(pkt.TargetClientID) >= maxGamePlayers {
+		return
+	}
+	for i := 0; i < int(pkt.ItemCount) && i < len(pkt.Items); i++ {
+		g.recordPickUp(int(pkt.TargetClientID), pkt.Items[i].ItemID)
+	}
+}
+
+// itemHolder is a player allowed to use an item outside of their range of item IDs.
+type itemHolder struct {
+	clientID int
+	itemID   uint32
+}
+
+// recordPickUp notes that the player with clientID now holds the item.
+func (g *game) recordPickUp(clientID int, itemID uint32) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.itemHolders == nil {
+		g.itemHolders = make(map[itemHolder]bool)
+	}
+	g.itemHolders[itemHolder{clientID: clientID, itemID: itemID}] = true
+}
+
+// pickedUp returns whether the player with clientID picked up the item in the
+// game or was offered it in a trade.
+func (g *game) pickedUp(clientID int, itemID uint32) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.itemHolders[itemHolder{clientID: clientID, itemID: itemID}]
+}
+
+// checkItemID makes sure players only act on items with IDs that could belong to
+// them. Each player's items are numbered within their own range of IDs, while
+// items they picked up or traded for keep the ID they had before.
+func checkItemID(s *Server, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) error {
+	g := s.gameOf(c)
+	if g == nil {
+		return nil
+	}
+	clientID, ok := g.clientID(c)
+	if !ok {
+		return nil
+	}
+
+	var itemID uint32
+	if subcommand.Type == subcmdDropStack {
+		var cmd packets.DropStackCommand
+		if !decodeGameCommand(data, &cmd) || cmd.ItemID == mesetaItemID {
+			return nil
+		}
+		itemID = cmd.ItemID
+	} else {
+		var cmd packets.ItemCommand
+		if !decodeGameCommand(data, &cmd) {
+			return nil
+		}
+		itemID = cmd.ItemID
+	}
+
+	base := uint32(inventoryItemIDBase + clientID*inventoryItemIDRange)
+	if (itemID < base || itemID >= base+inventoryItemIDRange) && !g.pickedUp(clientID, itemID) {
+		return fmt.Errorf("subcommand %#x used item %#08x, which can't belong to client %d", subcommand.Type, itemID, clientID)
+	}
+	return nil
+}
+
+// checkMeseta makes sure players don't drop more meseta than a character can hold.
+func checkMeseta(s *Server, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) error {
+	var cmd packets.DropStackCommand
+	if !decodeGameCommand(data, &cmd) || cmd.ItemID != mesetaItemID {
+		return nil
+	}
+	if cmd.Amount > maxMeseta {
+		return fmt.Errorf("dropped %d meseta", cmd.Amount)
+	}
+	return nil
+}
+
+// checkStatEdit rejects commands that change stats, experience, or levels, which
+// are handed out by the server.
+func checkStatEdit(s *Server, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) error {
+	return fmt.Errorf("sent server-only subcommand %#x", subcommand.Type)
+}
+
+// checkWarpFloor makes sure players in a game only move to floors that exist in its episode.
+func checkWarpFloor(s *Server, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) error {
+	g := s.gameOf(c)
+	if g == nil {
+		return nil
+	}
+	var cmd packets.FloorChangeCommand
+	if !decodeGameCommand(data, &cmd) {
+		return nil
+	}
+	if last, ok := lastFloors[episodeNumber(g.episode)]; ok && int(cmd.Floor) > last {
+		return fmt.Errorf("moved to floor %d in episode %d", cmd.Floor, episodeNumber(g.episode))
+	}
+	return nil
+}
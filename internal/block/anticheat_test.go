@@ -0,0 +1,232 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/packets"
+)
+
+func TestDefaultValidator(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.BlockServer.AntiCheat.DefaultAction = "drop"
+	cfg.BlockServer.AntiCheat.Rules = map[string]string{"meseta": "Ban"}
+
+	v, err := defaultValidator(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error creating validator: %v", err)
+	}
+	if got := v.actions["meseta"]; got != actionBan {
+		t.Errorf("got action %s for meseta, want %s", got, actionBan)
+	}
+	if got := v.actions["warp_floor"]; got != actionDrop {
+		t.Errorf("got action %s for warp_floor, want the default %s", got, actionDrop)
+	}
+
+	cfg.BlockServer.AntiCheat.Rules = map[string]string{"speed_hack": "kick"}
+	if _, err := defaultValidator(cfg); err == nil {
+		t.Error("expected an error for an unknown rule")
+	}
+	cfg.BlockServer.AntiCheat.Rules = map[string]string{"meseta": "jail"}
+	if _, err := defaultValidator(cfg); err == nil {
+		t.Error("expected an error for an unknown action")
+	}
+}
+
+// testGameCommand serializes cmd and returns it along with its headers.
+func testGameCommand(t *testing.T, cmd interface{}) (*packets.BBHeader, *packets.SubcommandHeader, []byte) {
+	t.Helper()
+	data, size := bytes.BytesFromStruct(cmd)
+	header := &packets.BBHeader{Type: packets.GameCommandType, Size: uint16(size)}
+	var subcommand packets.SubcommandHeader
+	bytes.StructFromBytes(data[packets.BBHeaderSize:packets.BBHeaderSize+4], &subcommand)
+	return header, &subcommand, data
+}
+
+func TestValidationRules(t *testing.T) {
+	player := &client.Client{}
+	g := &game{episode: 1}
+	g.add(&client.Client{})
+	g.add(player)
+	s := &Server{games: map[int]*game{1: g}}
+
+	tests := []struct {
+		name    string
+		check   func(s *Server, c *client.Client, header *packets.BBHeader, subcommand *packets.SubcommandHeader, data []byte) error
+		cmd     interface{}
+		wantErr bool
+	}{
+		{
+			name:  "valid subcommand size",
+			check: checkPacketSize,
+			cmd: &packets.FloorChangeCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdFloorChange, Size: 2},
+			},
+		},
+		{
+			name:  "subcommand larger than the command",
+			check: checkPacketSize,
+			cmd: &packets.FloorChangeCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdFloorChange, Size: 3},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "subcommand too small",
+			check: checkPacketSize,
+			cmd: &packets.EnemyHitCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdEnemyHit, Size: 2},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "own item",
+			check: checkItemID,
+			cmd: &packets.ItemCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdItemEquip, Size: 2},
+				ItemID:     0x00210005,
+			},
+		},
+		{
+			name:  "another player's item",
+			check: checkItemID,
+			cmd: &packets.ItemCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdItemUse, Size: 2},
+				ItemID:     0x00010005,
+			},
+			wantErr: true,
+		},
+		{
+			name:  "dropped meseta",
+			check: checkMeseta,
+			cmd: &packets.DropStackCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdDropStack, Size: 6},
+				ItemID:     mesetaItemID,
+				Amount:     1000,
+			},
+		},
+		{
+			name:  "dropped too much meseta",
+			check: checkMeseta,
+			cmd: &packets.DropStackCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdDropStack, Size: 6},
+				ItemID:     mesetaItemID,
+				Amount:     maxMeseta + 1,
+			},
+			wantErr: true,
+		},
+		{
+			name:  "floor in episode",
+			check: checkWarpFloor,
+			cmd: &packets.FloorChangeCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdFloorWarp, Size: 2},
+				Floor:      0x0E,
+			},
+		},
+		{
+			name:  "floor outside episode",
+			check: checkWarpFloor,
+			cmd: &packets.FloorChangeCommand{
+				Subcommand: packets.SubcommandHeader{Type: subcmdFloorWarp, Size: 2},
+				Floor:      0x12,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, subcommand, data := testGameCommand(t, tt.cmd)
+			if err := tt.check(s, player, header, subcommand, data); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestItemPickUp(t *testing.T) {
+	leader, player := &client.Client{}, &client.Client{}
+	g := &game{episode: 1}
+	g.add(leader)
+	g.add(player)
+	s := &Server{games: map[int]*game{1: g}}
+
+	const floorItemID = 0x00810003
+	useItem := func(c *client.Client) error {
+		header, subcommand, data := testGameCommand(t, &packets.ItemCommand{
+			Subcommand: packets.SubcommandHeader{Type: subcmdItemEquip, Size: 2},
+			ItemID:     floorItemID,
+		})
+		return checkItemID(s, c, header, subcommand, data)
+	}
+	pickUp := func(sender *client.Client, clientID uint16) {
+		_, _, data := testGameCommand(t, &packets.PickUpItemCommand{
+			Subcommand: packets.SubcommandHeader{Type: subcmdItemPickUp, Size: 3},
+			ClientID:   clientID,
+			ItemID:     floorItemID,
+		})
+		s.handleItemPickUp(sender, data)
+	}
+
+	if err := useItem(player); err == nil {
+		t.Fatalf("expected an error for an item the player hasn't picked up")
+	}
+	// Only the leader's client announces pickups.
+	pickUp(player, 1)
+	if err := useItem(player); err == nil {
+		t.Fatalf("expected pickups announced by other players to be ignored")
+	}
+	pickUp(leader, 1)
+	if err := useItem(player); err != nil {
+		t.Errorf("expected the player to be able to use an item they picked up, got %v", err)
+	}
+	if err := useItem(leader); err == nil {
+		t.Errorf("expected an error for an item picked up by another player")
+	}
+
+	// Whoever takes the player's place doesn't inherit the item.
+	g.remove(player)
+	newPlayer := &client.Client{}
+	g.add(newPlayer)
+	if err := useItem(newPlayer); err == nil {
+		t.Errorf("expected an error for an item picked up by the previous player")
+	}
+}
+
+func TestTradeItems(t *testing.T) {
+	giver, receiver := &client.Client{}, &client.Client{}
+	g := &game{episode: 1}
+	g.add(giver)
+	g.add(receiver)
+	s := &Server{games: map[int]*game{1: g}}
+
+	const giverItemID, floorItemID = 0x00010002, 0x00810003
+	g.recordPickUp(0, floorItemID)
+	useItem := func(c *client.Client, itemID uint32) error {
+		header, subcommand, data := testGameCommand(t, &packets.ItemCommand{
+			Subcommand: packets.SubcommandHeader{Type: subcmdItemEquip, Size: 2},
+			ItemID:     itemID,
+		})
+		return checkItemID(s, c, header, subcommand, data)
+	}
+
+	pkt := &packets.TradeItems{TargetClientID: 1, ItemCount: 2}
+	pkt.Items[0].ItemID = giverItemID
+	pkt.Items[1].ItemID = floorItemID
+	pkt.Items[2].ItemID = 0x00010009
+	data, _ := bytes.BytesFromStruct(pkt)
+	s.handleTradeItems(giver, data)
+
+	for _, itemID := range []uint32{giverItemID, floorItemID} {
+		if err := useItem(receiver, itemID); err != nil {
+			t.Errorf("expected the receiver to be able to use traded item %#08x, got %v", itemID, err)
+		}
+		if err := useItem(giver, itemID); err != nil {
+			t.Errorf("expected the giver to still be able to use item %#08x, got %v", itemID, err)
+		}
+	}
+	if err := useItem(receiver, 0x00010009); err == nil {
+		t.Errorf("expected an error for an item past the trade's item count")
+	}
+}
@@ -0,0 +1,210 @@
+package block
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/packets"
+)
+
+// Item types, as stored in the first byte of an item's data.
+const (
+	itemTypeWeapon = 0x00
+	itemTypeArmor  = 0x01
+	itemTypeMag    = 0x02
+	itemTypeTool   = 0x03
+)
+
+// Item categories that can be restricted by battle rules.
+const (
+	itemCategoryWeapons = "weapons"
+	itemCategoryArmor   = "armor"
+	itemCategoryShields = "shields"
+	itemCategoryUnits   = "units"
+	itemCategoryMags    = "mags"
+	itemCategoryTools   = "tools"
+)
+
+// battleRules is a preset of rules for battle mode games. Presets are defined
+// in the battle rules file in the server config directory.
+type battleRules struct {
+	Name string `mapstructure:"name"`
+	// Whether players come back after dying and how many lives they have (0 for unlimited).
+	Respawn bool `mapstructure:"respawn"`
+	Lives   int  `mapstructure:"lives"`
+	// Length of the match in minutes (0 for no limit).
+	TimeLimit int `mapstructure:"time_limit"`
+	// Range of character levels allowed to join (0 for no limit).
+	MinLevel int `mapstructure:"min_level"`
+	MaxLevel int `mapstructure:"max_level"`
+	// Most meseta a player may carry into the game (0 for no limit).
+	MaxMeseta int `mapstructure:"max_meseta"`
+	// Categories of items players may not carry into the game.
+	ForbiddenItems []string `mapstructure:"forbidden_items"`
+	Techniques     bool     `mapstructure:"techniques"`
+}
+
+// loadBattleRules reads the battle rule presets from the file configured for the
+// block server, keyed by name. Servers without a battle rules file have no presets.
+func loadBattleRules(cfg *core.Config) (map[string]*battleRules, error) {
+	presets := make(map[string]*battleRules)
+	if cfg.BlockServer.BattleRulesFile == "" {
+		return presets, nil
+	}
+
+	path := cfg.QualifiedPath(cfg.BlockServer.BattleRulesFile)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return presets, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading battle rules from %s: %w", path, err)
+	}
+
+	var rulesFile struct {
+		Presets []*battleRules `mapstructure:"presets"`
+	}
+	if err := v.Unmarshal(&rulesFile); err != nil {
+		return nil, fmt.Errorf("error parsing battle rules from %s: %w", path, err)
+	}
+
+	for _, rules := range rulesFile.Presets {
+		name := strings.ToLower(rules.Name)
+		if name == "" {
+			return nil, fmt.Errorf("battle rules in %s must have a name", path)
+		} else if _, ok := presets[name]; ok {
+			return nil, fmt.Errorf("duplicate battle rules %s", rules.Name)
+		}
+		if err := rules.check(); err != nil {
+			return nil, fmt.Errorf("invalid battle rules %s: %w", rules.Name, err)
+		}
+		presets[name] = rules
+	}
+	return presets, nil
+}
+
+// check returns an error if the rules contain invalid values.
+func (r *battleRules) check() error {
+	if r.Lives < 0 || r.TimeLimit < 0 || r.MinLevel < 0 || r.MaxLevel < 0 || r.MaxMeseta < 0 {
+		return fmt.Errorf("limits cannot be negative")
+	}
+	if r.MaxLevel > 0 && r.MinLevel > r.MaxLevel {
+		return fmt.Errorf("min_level is greater than max_level")
+	}
+	for _, category := range r.ForbiddenItems {
+		switch category {
+		case itemCategoryWeapons, itemCategoryArmor, itemCategoryShields, itemCategoryUnits, itemCategoryMags, itemCategoryTools:
+		default:
+			return fmt.Errorf("unknown item category: %s", category)
+		}
+	}
+	return nil
+}
+
+// validatePlayer returns an error describing why the player isn't allowed to join
+// a game played under these rules, or nil if they are.
+func (r *battleRules) validatePlayer(c *client.Client) error {
+	// Levels are zero-based in the character data.
+	level := int(c.Character.Level) + 1
+	if r.MinLevel > 0 && level < r.MinLevel {
+		return fmt.Errorf("characters must be at least level %d", r.MinLevel)
+	}
+	if r.MaxLevel > 0 && level > r.MaxLevel {
+		return fmt.Errorf("characters cannot be above level %d", r.MaxLevel)
+	}
+	if r.MaxMeseta > 0 && int(c.Character.Meseta) > r.MaxMeseta {
+		return fmt.Errorf("characters cannot carry more than %d meseta", r.MaxMeseta)
+	}
+
+	for _, item := range c.Inventory {
+		category := itemCategory(item)
+		for _, forbidden := range r.ForbiddenItems {
+			if category == forbidden {
+				return fmt.Errorf("%s are not allowed", category)
+			}
+		}
+	}
+	return nil
+}
+
+// summary describes the rules for players joining the game.
+func (r *battleRules) summary() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Battle rules: %s\n", r.Name))
+	switch {
+	case !r.Respawn:
+		b.WriteString("No respawning\n")
+	case r.Lives > 0:
+		b.WriteString(fmt.Sprintf("Lives: %d\n", r.Lives))
+	default:
+		b.WriteString("Unlimited lives\n")
+	}
+	if r.TimeLimit > 0 {
+		b.WriteString(fmt.Sprintf("Time limit: %d minutes\n", r.TimeLimit))
+	}
+	if !r.Techniques {
+		b.WriteString("Techniques are disabled\n")
+	}
+	if len(r.ForbiddenItems) > 0 {
+		b.WriteString(fmt.Sprintf("Forbidden items: %s\n", strings.Join(r.ForbiddenItems, ", ")))
+	}
+	return b.String()
+}
+
+// packet returns the rules in the form sent to the client when it joins the game.
+func (r *battleRules) packet() packets.BattleRules {
+	rules := packets.BattleRules{
+		Lives:     uint32(r.Lives),
+		TimeLimit: uint32(r.TimeLimit),
+	}
+	switch {
+	case !r.Respawn:
+		rules.RespawnMode = 1
+	case r.Lives > 0:
+		rules.RespawnMode = 2
+	}
+	if !r.Techniques {
+		rules.TechDiskMode = 1
+	}
+	for _, category := range r.ForbiddenItems {
+		switch category {
+		case itemCategoryWeapons, itemCategoryArmor, itemCategoryShields, itemCategoryUnits:
+			rules.WeaponAndArmorMode = 2
+		case itemCategoryMags:
+			rules.MagMode = 1
+		case itemCategoryTools:
+			rules.ToolMode = 2
+		}
+	}
+	return rules
+}
+
+// itemCategory returns the battle rule category of an inventory item.
+func itemCategory(item packets.InventoryItem) string {
+	switch item.Item.Data[0] {
+	case itemTypeWeapon:
+		return itemCategoryWeapons
+	case itemTypeArmor:
+		switch item.Item.Data[1] {
+		case 0x02:
+			return itemCategoryShields
+		case 0x03:
+			return itemCategoryUnits
+		default:
+			return itemCategoryArmor
+		}
+	case itemTypeMag:
+		return itemCategoryMags
+	case itemTypeTool:
+		return itemCategoryTools
+	default:
+		return ""
+	}
+}
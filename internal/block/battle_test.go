@@ -0,0 +1,163 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/packets"
+)
+
+func TestBattleRules_ValidatePlayer(t *testing.T) {
+	rules := &battleRules{
+		Name:           "test",
+		MinLevel:       10,
+		MaxLevel:       20,
+		MaxMeseta:      1000,
+		ForbiddenItems: []string{itemCategoryMags, itemCategoryShields},
+	}
+	if err := rules.check(); err != nil {
+		t.Fatalf("unexpected error checking rules: %v", err)
+	}
+
+	item := func(data ...uint8) packets.InventoryItem {
+		var i packets.InventoryItem
+		copy(i.Item.Data[:], data)
+		return i
+	}
+	tests := []struct {
+		name      string
+		character *proto.Character
+		inventory []packets.InventoryItem
+		wantErr   bool
+	}{
+		{
+			name:      "allowed player",
+			character: &proto.Character{Level: 14, Meseta: 500},
+			inventory: []packets.InventoryItem{item(itemTypeWeapon), item(itemTypeArmor, 0x01)},
+		},
+		{
+			name:      "level too low",
+			character: &proto.Character{Level: 5},
+			wantErr:   true,
+		},
+		{
+			name:      "level too high",
+			character: &proto.Character{Level: 20},
+			wantErr:   true,
+		},
+		{
+			name:      "too much meseta",
+			character: &proto.Character{Level: 14, Meseta: 1001},
+			wantErr:   true,
+		},
+		{
+			name:      "forbidden item",
+			character: &proto.Character{Level: 14},
+			inventory: []packets.InventoryItem{item(itemTypeArmor, 0x02)},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &client.Client{Character: tt.character, Inventory: tt.inventory}
+			if err := rules.validatePlayer(c); (err != nil) != tt.wantErr {
+				t.Errorf("validatePlayer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBattleRules_Check(t *testing.T) {
+	if err := (&battleRules{MinLevel: 30, MaxLevel: 20}).check(); err == nil {
+		t.Errorf("expected an error for an invalid level range")
+	}
+	if err := (&battleRules{ForbiddenItems: []string{"swords"}}).check(); err == nil {
+		t.Errorf("expected an error for an unknown item category")
+	}
+}
+
+func TestLoadBattleRules(t *testing.T) {
+	cfg := &core.Config{BaseDir: "../../server"}
+	cfg.BlockServer.BattleRulesFile = "battle_rules.yaml"
+
+	presets, err := loadBattleRules(cfg)
+	if err != nil {
+		t.Fatalf("error loading battle rules: %v", err)
+	}
+	if rules := presets["low_level"]; rules == nil || rules.MaxLevel != 20 || rules.Lives != 3 {
+		t.Errorf("expected low_level preset to be loaded, got %+v", rules)
+	}
+}
+
+func TestBattleRules_Packet(t *testing.T) {
+	rules := (&battleRules{Respawn: true, Lives: 3, TimeLimit: 10, ForbiddenItems: []string{itemCategoryUnits, itemCategoryMags}}).packet()
+	if rules.RespawnMode != 2 || rules.Lives != 3 || rules.TimeLimit != 10 {
+		t.Errorf("expected limited lives and a time limit, got %+v", rules)
+	}
+	if rules.WeaponAndArmorMode != 2 || rules.MagMode != 1 || rules.ToolMode != 0 || rules.TechDiskMode != 1 {
+		t.Errorf("expected weapons, armor, mags, and techniques to be forbidden, got %+v", rules)
+	}
+	if rules := (&battleRules{Techniques: true}).packet(); rules.RespawnMode != 1 || rules.TechDiskMode != 0 {
+		t.Errorf("expected no respawning with techniques allowed, got %+v", rules)
+	}
+}
+
+func TestHandleGameSelection_BattleRules(t *testing.T) {
+	rules := &battleRules{Name: "test", MaxLevel: 20}
+	g := &game{
+		id:          1,
+		name:        "battle",
+		password:    "secret",
+		mode:        gameModeBattle,
+		battleRules: rules,
+		experienced: make(map[*client.Client]bool),
+	}
+	s := &Server{Name: "BLOCK01", games: map[int]*game{1: g}}
+
+	tests := []struct {
+		name     string
+		level    uint32
+		password string
+		joined   bool
+	}{
+		{name: "wrong password", level: 10, password: "guess"},
+		{name: "level too high", level: 30, password: "secret"},
+		{name: "allowed player", level: 10, password: "secret", joined: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, conn := newTestClient(t)
+			c.Character = &proto.Character{Level: tt.level}
+			if err := s.handleGameSelection(context.Background(), c, g.id, tt.password); err != nil {
+				t.Fatalf("error selecting game: %v", err)
+			}
+
+			if joined := g.contains(c); joined != tt.joined {
+				t.Fatalf("expected joined to be %v, got %v", tt.joined, joined)
+			}
+			header, _ := conn.next()
+			if !tt.joined {
+				if header.Type != packets.TextMessageType {
+					t.Errorf("expected a message explaining why the player can't join, got %02x", header.Type)
+				}
+				return
+			}
+
+			if header.Type != packets.GameJoinType {
+				t.Fatalf("expected game join packet, got %02x", header.Type)
+			}
+			header, data := conn.next()
+			var cmd packets.StartBattleCommand
+			if header.Type != packets.GameCommandType || !decodeGameCommand(data, &cmd) || cmd.Subcommand.Type != subcmdStartBattle {
+				t.Fatalf("expected battle rules to be sent, got %02x", header.Type)
+			}
+			if int(cmd.Subcommand.Size)*4 != len(data)-packets.BBHeaderSize {
+				t.Errorf("expected subcommand size to cover the rules, got %d words", cmd.Subcommand.Size)
+			}
+			g.remove(c)
+		})
+	}
+}
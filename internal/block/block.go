@@ -3,6 +3,9 @@ package block
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/text/cases"
@@ -10,8 +13,10 @@ import (
 
 	"github.com/dcrodman/archon/internal/character"
 	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/auth"
 	"github.com/dcrodman/archon/internal/core/bytes"
 	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/maps"
 	"github.com/dcrodman/archon/internal/packets"
 	"github.com/dcrodman/archon/internal/shipgate"
 )
@@ -19,20 +24,98 @@ import (
 var loginCopyright = []byte("Phantasy Star Online Blue Burst Game Server. Copyright 1999-2004 SONICTEAM.")
 
 type Server struct {
-	Name   string
+	Name string
+	// Block number (starting from 1).
+	ID     int
 	Config *core.Config
 	Logger *zap.SugaredLogger
 
+	lobbies        []*lobby
+	commands       commandRegistry
+	roles          *auth.Roles
 	shipgateClient shipgate.Shipgate
+
+	eventSchedule *eventSchedule
+	eventMu       sync.Mutex
+	currentEvent  lobbyEvent
+	// Event set by a GM that takes precedence over the schedule.
+	eventOverride *lobbyEvent
+
+	battleRules map[string]*battleRules
+	// Rules that battle mode games are created with, if battle mode is enabled.
+	defaultBattleRules *battleRules
+	challenge          *challengeConfig
+	gameMaps           *maps.Maps
+	// Battle parameters for each episode, keyed by file name.
+	battleParams map[string]*maps.BattleParams
+	levelTable   *character.LevelTable
+	validator    *validator
+
+	gamesMu    sync.RWMutex
+	games      map[int]*game
+	nextGameID int
+
+	warpMu sync.Mutex
+	// Lobbies that players warped from other blocks are placed into when they arrive.
+	warpLobbies map[*client.Client]int
+
+	// Clients that have logged in to the block, so that they can be messaged or kicked.
+	sessions *client.Registry
 }
 
 func (s *Server) Identifier() string {
 	return s.Name
 }
 
-// Init connects to the shipgate.
+// Init connects to the shipgate and creates the block's lobbies.
 func (s *Server) Init(ctx context.Context) error {
-	s.shipgateClient = shipgate.NewRPCClient(s.Config)
+	var err error
+	if s.shipgateClient, err = shipgate.NewRPCClient(s.Config); err != nil {
+		return fmt.Errorf("error creating shipgate client: %w", err)
+	}
+	s.commands = defaultCommands()
+
+	if s.roles, err = auth.NewRoles(s.Config); err != nil {
+		return fmt.Errorf("error loading roles: %w", err)
+	}
+
+	for i := 0; i < s.Config.BlockServer.NumLobbies; i++ {
+		s.lobbies = append(s.lobbies, newLobby(i))
+	}
+
+	if s.eventSchedule, err = newEventSchedule(s.Config, s.ID); err != nil {
+		return fmt.Errorf("error loading lobby events: %w", err)
+	}
+	s.currentEvent = s.eventSchedule.at(time.Now())
+	go s.watchLobbyEvents(ctx)
+
+	if s.battleRules, err = loadBattleRules(s.Config); err != nil {
+		return err
+	}
+	if name := s.Config.BlockServer.DefaultBattleRules; name != "" {
+		// Presets are keyed by their lowercased names.
+		if s.defaultBattleRules = s.battleRules[strings.ToLower(name)]; s.defaultBattleRules == nil {
+			return fmt.Errorf("default battle rules %s not found", name)
+		}
+	}
+	if s.challenge, err = loadChallengeConfig(s.Config); err != nil {
+		return err
+	}
+	if s.gameMaps, err = s.loadMaps(); err != nil {
+		return err
+	}
+	if s.battleParams, err = loadBattleParams(); err != nil {
+		return fmt.Errorf("error loading battle parameters: %w", err)
+	}
+	if s.levelTable, err = character.LoadLevelTable(); err != nil {
+		return fmt.Errorf("error loading level table: %w", err)
+	}
+	if s.validator, err = defaultValidator(s.Config); err != nil {
+		return fmt.Errorf("error loading anti-cheat rules: %w", err)
+	}
+	s.games = make(map[int]*game)
+	s.warpLobbies = make(map[*client.Client]int)
+	s.sessions = client.NewRegistry()
 	return nil
 }
 
@@ -65,9 +148,52 @@ func (s *Server) Handle(ctx context.Context, c *client.Client, data []byte) erro
 		var loginPkt packets.Login
 		bytes.StructFromBytes(data, &loginPkt)
 		err = s.handleLogin(ctx, c, &loginPkt)
-	case packets.CharacterDataType:
-		// TODO: Probably have some data to copy in here.
-		err = s.sendPacket67(c)
+	case packets.CharacterDataType, packets.LeaveGameType:
+		// The client sends its character data when entering the block and again
+		// when returning to the lobby from a game.
+		var charPkt packets.CharacterData
+		bytes.StructFromBytes(data, &charPkt)
+		s.updateInventory(c, &charPkt)
+		s.leaveGame(ctx, c)
+
+		var (
+			l        *lobby
+			clientID int
+		)
+		if l, clientID, err = s.joinLobby(c); err == nil {
+			s.updateLocation(ctx, c, l.id, "")
+			err = s.sendPacket67(c, l, clientID)
+		}
+	case packets.CreateGameType:
+		var createPkt packets.CreateGame
+		bytes.StructFromBytes(data, &createPkt)
+		err = s.handleCreateGame(ctx, c, &createPkt)
+	case packets.QuestListType:
+		err = s.handleQuestListRequest(c)
+	case packets.MenuSelectType:
+		var menuSelectPkt packets.MenuSelection
+		bytes.StructFromBytes(data, &menuSelectPkt)
+		err = s.handleMenuSelection(ctx, c, &menuSelectPkt, data[:packetHeader.Size])
+	case packets.GameListType:
+		err = s.sendGameList(c)
+	case packets.GameCommandType, packets.GameCommandTargetType,
+		packets.GameCommandLargeType, packets.GameCommandLargeTargetType:
+		err = s.handleGameCommand(ctx, c, &packetHeader, data[:packetHeader.Size])
+	case packets.ChatType:
+		var chatPkt *packets.Chat
+		if chatPkt, err = parseChat(data[:packetHeader.Size]); err == nil {
+			err = s.handleChat(ctx, c, chatPkt)
+		}
+	case packets.GuildcardSearchType:
+		var searchPkt packets.GuildcardSearch
+		bytes.StructFromBytes(data, &searchPkt)
+		err = s.handleGuildcardSearch(ctx, c, &searchPkt)
+	case packets.TradeItemsType:
+		s.handleTradeItems(c, data[:packetHeader.Size])
+	case packets.InfoBoardRequestType:
+		err = s.sendInfoBoard(c)
+	case packets.InfoBoardUpdateType:
+		err = s.handleInfoBoardUpdate(ctx, c, data[packets.BBHeaderSize:packetHeader.Size])
 	default:
 		s.Logger.Infof("received unknown packet %x from %s", packetHeader.Type, c.IPAddr())
 	}
@@ -75,29 +201,47 @@ func (s *Server) Handle(ctx context.Context, c *client.Client, data []byte) erro
 }
 
 func (s *Server) handleLogin(ctx context.Context, c *client.Client, loginPkt *packets.Login) error {
-	username := string(bytes.StripPadding(loginPkt.Username[:]))
-	password := string(bytes.StripPadding(loginPkt.Password[:]))
+	bytes.StructFromBytes(loginPkt.Security[:], &c.Config)
 
-	account, err := s.shipgateClient.AuthenticateAccount(ctx, &shipgate.AuthenticateAccountRequest{
-		Username: username,
-		Password: password,
+	// Players can be sent here from the ship's block menu, by a warp, or to meet
+	// another player, so the capacity is enforced here rather than by each of them.
+	if s.full() {
+		if err := s.sendMessage(c, fmt.Sprintf("%s is full.", s.Name)); err != nil {
+			s.Logger.Warnf("[%s] error notifying %s that the block is full: %v", s.Name, c.IPAddr(), err)
+		}
+		return fmt.Errorf("refusing %s: block is full", c.IPAddr())
+	}
+
+	resp, err := s.shipgateClient.VerifySessionToken(ctx, &shipgate.VerifySessionTokenRequest{
+		Token:        c.Config.SessionToken(),
+		BlockAddress: s.address(),
 	})
 	if err != nil {
-		switch err {
-		case shipgate.ErrInvalidCredentials:
-			return s.sendSecurity(c, packets.BBLoginErrorPassword)
-		case shipgate.ErrAccountBanned:
-			return s.sendSecurity(c, packets.BBLoginErrorBanned)
+		var sendErr error
+		switch {
+		case shipgate.IsError(err, shipgate.ErrAccountBanned):
+			sendErr = s.sendSecurity(c, packets.BBLoginErrorBanned)
 		default:
-			sendErr := s.sendMessage(c, cases.Title(language.English).String(err.Error()))
-			if sendErr == nil {
-				return sendErr
-			}
-			return err
+			sendErr = s.sendMessage(c, cases.Title(language.English).String(err.Error()))
 		}
+		if sendErr != nil {
+			s.Logger.Warnf("[%s] error notifying %s of failed login: %v", s.Name, c.IPAddr(), sendErr)
+		}
+		// Players can't do anything without a valid session, so the connection is closed.
+		return fmt.Errorf("error verifying session for %s: %w", c.IPAddr(), err)
 	}
+	account := resp.Account
 	c.Account = account
 	c.ActiveSlot = loginPkt.Slot
+	if resp.Warped {
+		// Players warped from another block are placed in the lobby they were sent to.
+		c.ActiveSlot = resp.Slot
+		s.setWarpLobby(c, int(resp.LobbyId))
+	}
+	c.Guildcard = uint32(account.Guildcard)
+	c.TeamID = uint32(account.TeamId)
+	c.IsGm = account.Gm
+	s.sessions.Add(c)
 
 	if err := s.sendSecurity(c, packets.BBLoginErrorNone); err != nil {
 		return err
@@ -142,6 +286,103 @@ func (s *Server) sendMessage(c *client.Client, message string) error {
 	})
 }
 
+// sendTextMessage sends a system message to the player that's displayed in the lobby.
+func (s *Server) sendTextMessage(c *client.Client, message string) error {
+	return c.Send(&packets.TextMessage{
+		Header:  packets.BBHeader{Type: packets.TextMessageType},
+		Message: bytes.ConvertToUtf16(languageMarker + message + "\x00"),
+	})
+}
+
+// sendScrollMessage sends a message that scrolls across the top of the player's screen.
+func (s *Server) sendScrollMessage(c *client.Client, message string) error {
+	return c.Send(&packets.ScrollMessagePacket{
+		Header:  packets.BBHeader{Type: packets.LoginScrollMessageType},
+		Message: bytes.ConvertToUtf16(languageMarker + message + "\x00"),
+	})
+}
+
+// Announce sends a scrolling message to every player on the block.
+func (s *Server) Announce(message string) {
+	for _, l := range s.lobbies {
+		for _, player := range l.players() {
+			if err := s.sendScrollMessage(player, message); err != nil {
+				s.Logger.Warnf("[%s] error sending announcement to %s: %v", s.Name, player.IPAddr(), err)
+			}
+		}
+	}
+}
+
+// MessagePlayer sends a message to the player with the account if they're on
+// the block, returning whether they were.
+func (s *Server) MessagePlayer(accountID uint64, message string) bool {
+	player := s.sessions.Find(accountID)
+	if player == nil {
+		return false
+	}
+	if err := s.sendTextMessage(player, message); err != nil {
+		s.Logger.Warnf("[%s] error sending message to %s: %v", s.Name, player.IPAddr(), err)
+	}
+	return true
+}
+
+// KickPlayer disconnects the player with the account if they're on the block,
+// returning whether they were.
+func (s *Server) KickPlayer(accountID uint64, message string) bool {
+	player := s.sessions.Find(accountID)
+	if player == nil {
+		return false
+	}
+	if err := s.kick(player, message); err != nil {
+		s.Logger.Warnf("[%s] error kicking %s: %v", s.Name, player.IPAddr(), err)
+	}
+	return true
+}
+
+// kick shows the player message (or a default one) and disconnects them.
+func (s *Server) kick(player *client.Client, message string) error {
+	if message == "" {
+		message = shipgate.DefaultKickMessage
+	}
+	if err := s.sendTextMessage(player, message); err != nil {
+		s.Logger.Warnf("[%s] error notifying kicked player %s: %v", s.Name, player.IPAddr(), err)
+	}
+	// Closing the connection ends the player's packet loop, which handles the rest of the cleanup.
+	return player.Close()
+}
+
+// PlayerCount returns the number of players in the block's lobbies and games.
+func (s *Server) PlayerCount() int {
+	count := 0
+	for _, l := range s.lobbies {
+		count += len(l.players())
+	}
+
+	s.gamesMu.RLock()
+	defer s.gamesMu.RUnlock()
+	for _, g := range s.games {
+		for _, c := range g.players() {
+			if c != nil {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// MaxPlayers returns the number of players the block can hold.
+func (s *Server) MaxPlayers() int {
+	if s.Config.BlockServer.MaxPlayers > 0 {
+		return s.Config.BlockServer.MaxPlayers
+	}
+	return s.Config.BlockServer.NumLobbies * maxLobbyPlayers
+}
+
+// full returns whether the block has reached its player limit.
+func (s *Server) full() bool {
+	return s.PlayerCount() >= s.MaxPlayers()
+}
+
 func (s *Server) sendLobbyList(c *client.Client) error {
 	lobbyEntries := make([]packets.LobbyListEntry, s.Config.BlockServer.NumLobbies)
 	for i := 0; i < s.Config.BlockServer.NumLobbies; i++ {
@@ -173,6 +414,7 @@ func (s *Server) fetchAndSendCharacter(ctx context.Context, c *client.Client) er
 		return fmt.Errorf("error loading selected character: %v", err)
 	}
 	dbCharacter := resp.Character
+	c.Character = dbCharacter
 
 	charPkt := &packets.FullCharacter{
 		Header: packets.BBHeader{Type: packets.FullCharacterType},
@@ -207,6 +449,7 @@ func (s *Server) fetchAndSendCharacter(ctx context.Context, c *client.Client) er
 	}
 	copy(charPkt.GuildcardStr[:], dbCharacter.GuildcardStr)
 	copy(charPkt.Name[:], dbCharacter.Name)
+	charPkt.ChallengeData = challengeData(dbCharacter)
 
 	charPkt.NameColor = NameColorNormal
 	if c.IsGm {
@@ -229,13 +472,195 @@ func (s *Server) sendFullCharacterEnd(c *client.Client) error {
 	})
 }
 
-func (s *Server) sendPacket67(c *client.Client) error {
+func (s *Server) sendPacket67(c *client.Client, l *lobby, clientID int) error {
 	return c.Send(&packets.Packet67{
 		Header: packets.BBHeader{
-			Type: 0x67,
+			Type: packets.LobbyJoinType,
 		},
-		Unknown1:  0x01,
-		PlayerTag: 0x00010000,
+		ClientID:    uint8(clientID),
+		LeaderID:    uint8(clientID),
+		DisableUDP:  1,
+		LobbyNumber: uint8(l.id),
+		BlockNumber: uint16(s.ID),
+		Event:       uint16(s.lobbyEvent()),
+		PlayerTag:   0x00010000,
 		// Something: ,
 	})
 }
+
+// handleQuestListRequest sends the quests available in the player's game. Only the
+// challenge mode stages are supported for now.
+func (s *Server) handleQuestListRequest(c *client.Client) error {
+	if g := s.gameOf(c); g != nil && g.mode == gameModeChallenge {
+		return s.sendChallengeQuestList(c, g)
+	}
+	return s.sendTextMessage(c, "No quests are available.")
+}
+
+func (s *Server) handleMenuSelection(ctx context.Context, c *client.Client, pkt *packets.MenuSelection, data []byte) error {
+	switch pkt.ItemID & 0xFF000000 {
+	case challengeMenuType:
+		return s.selectChallengeStage(c, int(pkt.ItemID&0x00FFFFFF))
+	case gameMenuType:
+		return s.handleGameSelection(ctx, c, int(pkt.ItemID&0x00FFFFFF), gamePassword(data))
+	default:
+		s.Logger.Infof("[%s] received unknown menu selection %08x from %s", s.Name, pkt.ItemID, c.IPAddr())
+	}
+	return nil
+}
+
+// HandleDisconnect removes the client from whichever lobby or game they were in.
+func (s *Server) HandleDisconnect(c *client.Client) {
+	s.sessions.Remove(c)
+	if l := s.lobbyOf(c); l != nil {
+		l.remove(c)
+	}
+	s.leaveGame(context.Background(), c)
+	s.clearLocation(context.Background(), c)
+	s.takeWarpLobby(c)
+}
+
+// updateInventory saves the items the client reported holding.
+func (s *Server) updateInventory(c *client.Client, charPkt *packets.CharacterData) {
+	numItems := int(charPkt.NumInventoryItems)
+	if numItems > len(charPkt.Inventory) {
+		numItems = len(charPkt.Inventory)
+	}
+	c.Inventory = append([]packets.InventoryItem{}, charPkt.Inventory[:numItems]...)
+}
+
+// joinLobby places the client in the lobby they warped to or the first lobby
+// that has room for them, and returns the lobby along with their client ID in it.
+// Clients that are already in a lobby (e.g. because they re-sent their character
+// data) are taken out of it first so that they never hold more than one slot.
+func (s *Server) joinLobby(c *client.Client) (*lobby, int, error) {
+	if l := s.lobbyOf(c); l != nil {
+		l.remove(c)
+	}
+	if lobbyID, ok := s.takeWarpLobby(c); ok && lobbyID >= 0 && lobbyID < len(s.lobbies) {
+		if clientID, ok := s.lobbies[lobbyID].add(c); ok {
+			return s.lobbies[lobbyID], clientID, nil
+		}
+	}
+	for _, l := range s.lobbies {
+		if clientID, ok := l.add(c); ok {
+			return l, clientID, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("no lobbies with room for client %s", c.IPAddr())
+}
+
+// lobbyOf returns the lobby the client is in or nil if they are not in one.
+func (s *Server) lobbyOf(c *client.Client) *lobby {
+	for _, l := range s.lobbies {
+		if l.contains(c) {
+			return l
+		}
+	}
+	return nil
+}
+
+// Maximum size of the UTF-16 info board text in bytes.
+const maxInfoBoardSize = 344
+
+// handleInfoBoardUpdate saves the info board text the player set on their character.
+func (s *Server) handleInfoBoardUpdate(ctx context.Context, c *client.Client, message []byte) error {
+	if c.Character == nil {
+		return fmt.Errorf("received info board update from %s before character was loaded", c.IPAddr())
+	}
+	if len(message) > maxInfoBoardSize {
+		message = message[:maxInfoBoardSize]
+	}
+
+	if _, err := s.shipgateClient.UpdateInfoBoard(ctx, &shipgate.UpdateInfoBoardRequest{
+		AccountId: c.Account.Id,
+		Slot:      c.ActiveSlot,
+		InfoBoard: message,
+	}); err != nil {
+		return fmt.Errorf("error saving info board: %w", err)
+	}
+	c.Character.InfoBoard = message
+	return nil
+}
+
+// sendInfoBoard sends the client the info boards of everyone in their lobby.
+func (s *Server) sendInfoBoard(c *client.Client) error {
+	pkt := &packets.InfoBoard{
+		Header: packets.BBHeader{Type: packets.InfoBoardRequestType},
+	}
+	if l := s.lobbyOf(c); l != nil {
+		for _, player := range l.players() {
+			if player.Character == nil {
+				continue
+			}
+			var entry packets.InfoBoardEntry
+			copy(entry.Name[:], player.Character.Name)
+			copy(entry.Message[:], player.Character.InfoBoard)
+			pkt.Entries = append(pkt.Entries, entry)
+		}
+	}
+	pkt.Header.Flags = uint32(len(pkt.Entries))
+
+	return c.Send(pkt)
+}
+
+// Language marker that the client prepends to text ("\tE" for English).
+const languageMarker = "\tE"
+
+// Size of the portion of a chat packet that precedes the message.
+const chatHeaderSize = packets.BBHeaderSize + 8
+
+// parseChat decodes a chat packet. StructFromBytes doesn't fill in slices, so
+// the variable-length message is copied out of the packet separately.
+func parseChat(data []byte) (*packets.Chat, error) {
+	if len(data) < chatHeaderSize {
+		return nil, fmt.Errorf("chat packet too short (%d bytes)", len(data))
+	}
+	var pkt packets.Chat
+	bytes.StructFromBytes(data[:chatHeaderSize], &pkt)
+	pkt.Message = append([]byte{}, data[chatHeaderSize:]...)
+	return &pkt, nil
+}
+
+// handleChat relays a chat message to everyone in the player's lobby, or runs it
+// as a command if it starts with the command prefix and the player has a role
+// that grants them any permissions.
+func (s *Server) handleChat(ctx context.Context, c *client.Client, pkt *packets.Chat) error {
+	if c.Character == nil {
+		return fmt.Errorf("received chat from %s before character was loaded", c.IPAddr())
+	}
+
+	text := bytes.ConvertFromUtf16(pkt.Message)
+	if len(text) >= 2 && text[0] == '\t' {
+		text = text[2:]
+	}
+	prefix := s.Config.BlockServer.CommandPrefix
+	if prefix != "" && strings.HasPrefix(text, prefix) && s.roles.ForAccount(c.Account).Privileged() {
+		return s.runCommand(ctx, c, strings.TrimPrefix(text, prefix))
+	}
+
+	// Chat goes to everyone in the same lobby or game as the player.
+	var recipients []*client.Client
+	if l := s.lobbyOf(c); l != nil {
+		recipients = l.players()
+	} else if g := s.gameOf(c); g != nil {
+		for _, player := range g.players() {
+			if player != nil {
+				recipients = append(recipients, player)
+			}
+		}
+	}
+	// The client expects the message to be prefixed with the sender's name.
+	message := bytes.ConvertToUtf16(bytes.ConvertFromUtf16(c.Character.Name) + "\t")
+	message = append(message, pkt.Message...)
+	for _, player := range recipients {
+		if err := player.Send(&packets.Chat{
+			Header:    packets.BBHeader{Type: packets.ChatType},
+			Guildcard: c.Guildcard,
+			Message:   message,
+		}); err != nil {
+			s.Logger.Warnf("[%s] error relaying chat to %s: %v", s.Name, player.IPAddr(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package block
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// plainCrypto leaves packets unencrypted so that tests can read what the server sent.
+type plainCrypto struct{}
+
+func (plainCrypto) HeaderSize() uint16             { return packets.BBHeaderSize }
+func (plainCrypto) Encrypt(bytes []byte, _ uint32) {}
+func (plainCrypto) Decrypt(bytes []byte, _ uint32) {}
+func (plainCrypto) ServerVector() []byte           { return nil }
+func (plainCrypto) ClientVector() []byte           { return nil }
+
+// testConn is the game client's end of a connection to the server.
+type testConn struct {
+	t    *testing.T
+	conn *net.TCPConn
+}
+
+// newTestClient returns a client connected over loopback along with the connection
+// on the other end, which can be used to read the packets sent to it.
+func newTestClient(t *testing.T) (*client.Client, *testConn) {
+	listener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("error initializing test listener: %v", err)
+	}
+	defer listener.Close()
+
+	conn, err := net.DialTCP("tcp", nil, listener.Addr().(*net.TCPAddr))
+	if err != nil {
+		t.Fatalf("error initializing test connection: %v", err)
+	}
+	serverConn, err := listener.AcceptTCP()
+	if err != nil {
+		t.Fatalf("error accepting test connection: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		serverConn.Close()
+	})
+
+	c := client.NewClient(serverConn)
+	c.CryptoSession = plainCrypto{}
+	return c, &testConn{t: t, conn: conn}
+}
+
+// next returns the header and contents of the next packet sent to the client.
+func (tc *testConn) next() (packets.BBHeader, []byte) {
+	tc.t.Helper()
+	tc.conn.SetReadDeadline(time.Now().Add(time.Second))
+
+	data := make([]byte, packets.BBHeaderSize)
+	if _, err := io.ReadFull(tc.conn, data); err != nil {
+		tc.t.Fatalf("error reading packet header: %v", err)
+	}
+	var header packets.BBHeader
+	bytes.StructFromBytes(data, &header)
+
+	data = append(data, make([]byte, int(header.Size)-packets.BBHeaderSize)...)
+	if _, err := io.ReadFull(tc.conn, data[packets.BBHeaderSize:]); err != nil {
+		tc.t.Fatalf("error reading packet: %v", err)
+	}
+	return header, data
+}
+
+// sessionShipgate rejects every session token with err.
+type sessionShipgate struct {
+	shipgate.Shipgate
+	err error
+}
+
+func (s *sessionShipgate) VerifySessionToken(context.Context, *shipgate.VerifySessionTokenRequest) (*shipgate.VerifySessionTokenResponse, error) {
+	return nil, s.err
+}
+
+func TestHandleLogin_InvalidSession(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		packetType uint16
+	}{
+		{name: "expired session", err: shipgate.ErrInvalidSession, packetType: packets.LoginClientMessageType},
+		{name: "banned account", err: shipgate.ErrAccountBanned, packetType: packets.LoginSecurityType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &core.Config{}
+			cfg.BlockServer.NumLobbies = 1
+			s := &Server{
+				Name:           "BLOCK01",
+				ID:             1,
+				Config:         cfg,
+				Logger:         zap.NewNop().Sugar(),
+				shipgateClient: &sessionShipgate{err: tt.err},
+				sessions:       client.NewRegistry(),
+			}
+			c, conn := newTestClient(t)
+
+			// Returning an error is what tells the frontend to close the connection.
+			if err := s.Handle(context.Background(), c, loginPacket()); err == nil {
+				t.Fatalf("expected an error for an invalid session")
+			}
+			if header, _ := conn.next(); header.Type != tt.packetType {
+				t.Errorf("expected the player to be told why, got packet %02x", header.Type)
+			}
+			if c.Account != nil || s.sessions.Len() != 0 {
+				t.Errorf("expected the player not to be logged in")
+			}
+		})
+	}
+}
+
+func TestHandleLogin_Full(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.BlockServer.MaxPlayers = 1
+	s := &Server{
+		Name:           "BLOCK01",
+		ID:             1,
+		Config:         cfg,
+		Logger:         zap.NewNop().Sugar(),
+		shipgateClient: &sessionShipgate{},
+		sessions:       client.NewRegistry(),
+		lobbies:        []*lobby{newLobby(0)},
+	}
+	s.lobbies[0].add(&client.Client{})
+	c, conn := newTestClient(t)
+
+	// Players are turned away however they were sent to the block.
+	if err := s.Handle(context.Background(), c, loginPacket()); err == nil {
+		t.Fatalf("expected an error when the block is full")
+	}
+	if header, _ := conn.next(); header.Type != packets.LoginClientMessageType {
+		t.Errorf("expected the player to be told the block is full, got packet %02x", header.Type)
+	}
+	if c.Account != nil || s.sessions.Len() != 0 {
+		t.Errorf("expected the player not to be logged in")
+	}
+}
+
+// loginPacket returns the bytes of an empty login packet.
+func loginPacket() []byte {
+	data, size := bytes.BytesFromStruct(&packets.Login{Header: packets.BBHeader{Type: packets.LoginType}})
+	data[0], data[1] = byte(size), byte(size>>8)
+	return data
+}
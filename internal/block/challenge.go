@@ -0,0 +1,392 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+const (
+	// Challenge mode only exists for Episodes 1 and 2.
+	challengeStagesEp1 = 9
+	challengeStagesEp2 = 5
+
+	// Prefix OR'd with the stage number of the entries in the challenge quest list.
+	challengeMenuType = 0x30000000
+
+	// Color of the rank title displayed on the character (RGB555).
+	challengeTitleColor = 0x7FFF
+	// Maximum number of characters in a challenge title.
+	maxChallengeTitleLength = 12
+)
+
+// Challenge mode ranks in ascending order. Every clear earns at least a B.
+var challengeRanks = []string{"B", "A", "S"}
+
+// challengeStage is one of the stages in the challenge mode quest category.
+type challengeStage struct {
+	Episode int    `mapstructure:"episode"`
+	Stage   int    `mapstructure:"stage"`
+	Name    string `mapstructure:"name"`
+	// Level the players' characters are set to when starting the stage.
+	Level int `mapstructure:"level"`
+	// Slowest clear times (in seconds) that earn an S or A rank.
+	SRankTime uint32 `mapstructure:"s_rank_time"`
+	ARankTime uint32 `mapstructure:"a_rank_time"`
+}
+
+// rank returns the rank earned by clearing the stage in clearTime seconds.
+func (s *challengeStage) rank(clearTime uint32) string {
+	switch {
+	case clearTime <= s.SRankTime:
+		return "S"
+	case clearTime <= s.ARankTime:
+		return "A"
+	default:
+		return "B"
+	}
+}
+
+// challengeTitle is awarded to characters that have cleared every stage in an
+// episode with at least the required rank.
+type challengeTitle struct {
+	Title   string `mapstructure:"title"`
+	Episode int    `mapstructure:"episode"`
+	Rank    string `mapstructure:"rank"`
+}
+
+// challengeConfig contains the challenge mode stages and titles defined in the
+// challenge file in the server config directory.
+type challengeConfig struct {
+	Stages []*challengeStage `mapstructure:"stages"`
+	// Titles are checked in order and the first one a character qualifies for is awarded.
+	Titles []*challengeTitle `mapstructure:"titles"`
+}
+
+// loadChallengeConfig reads the challenge mode stages and titles from the file
+// configured for the block server. Servers without a challenge file have no stages.
+func loadChallengeConfig(cfg *core.Config) (*challengeConfig, error) {
+	challenge := &challengeConfig{}
+	if cfg.BlockServer.ChallengeFile == "" {
+		return challenge, nil
+	}
+
+	path := cfg.QualifiedPath(cfg.BlockServer.ChallengeFile)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return challenge, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading challenge stages from %s: %w", path, err)
+	}
+	if err := v.Unmarshal(challenge); err != nil {
+		return nil, fmt.Errorf("error parsing challenge stages from %s: %w", path, err)
+	}
+
+	for _, stage := range challenge.Stages {
+		if stage.Stage < 1 || stage.Stage > numChallengeStages(stage.Episode) {
+			return nil, fmt.Errorf("invalid challenge stage: episode %d stage %d", stage.Episode, stage.Stage)
+		}
+		if stage.SRankTime > stage.ARankTime {
+			return nil, fmt.Errorf("challenge stage %d-%d has an S rank time slower than its A rank time", stage.Episode, stage.Stage)
+		}
+	}
+	for _, title := range challenge.Titles {
+		if numChallengeStages(title.Episode) == 0 || rankValue(title.Rank) < 0 {
+			return nil, fmt.Errorf("invalid challenge title %s", title.Title)
+		}
+		if len([]rune(title.Title)) > maxChallengeTitleLength {
+			return nil, fmt.Errorf("challenge title %s is longer than %d characters", title.Title, maxChallengeTitleLength)
+		}
+	}
+	return challenge, nil
+}
+
+// numChallengeStages returns the number of challenge stages in an episode.
+func numChallengeStages(episode int) int {
+	switch episode {
+	case 1:
+		return challengeStagesEp1
+	case 2:
+		return challengeStagesEp2
+	default:
+		return 0
+	}
+}
+
+func rankValue(rank string) int {
+	for i, r := range challengeRanks {
+		if r == rank {
+			return i
+		}
+	}
+	return -1
+}
+
+// stage returns the definition of a challenge stage or nil if it isn't defined.
+func (c *challengeConfig) stage(episode, stage int) *challengeStage {
+	for _, s := range c.Stages {
+		if s.Episode == episode && s.Stage == stage {
+			return s
+		}
+	}
+	return nil
+}
+
+// episodeStages returns the stages defined for an episode in order.
+func (c *challengeConfig) episodeStages(episode int) []*challengeStage {
+	var stages []*challengeStage
+	for stage := 1; stage <= numChallengeStages(episode); stage++ {
+		if s := c.stage(episode, stage); s != nil {
+			stages = append(stages, s)
+		}
+	}
+	return stages
+}
+
+// title returns the title earned by a character with records, or an empty
+// string if they haven't earned one.
+func (c *challengeConfig) title(records []*proto.ChallengeRecord) string {
+	for _, title := range c.Titles {
+		earned := true
+		for stage := 1; stage <= numChallengeStages(title.Episode); stage++ {
+			record := findChallengeRecord(records, uint32(title.Episode), uint32(stage))
+			if record == nil || rankValue(record.Rank) < rankValue(title.Rank) {
+				earned = false
+				break
+			}
+		}
+		if earned {
+			return title.Title
+		}
+	}
+	return ""
+}
+
+func findChallengeRecord(records []*proto.ChallengeRecord, episode, stage uint32) *proto.ChallengeRecord {
+	for _, record := range records {
+		if record.Episode == episode && record.Stage == stage {
+			return record
+		}
+	}
+	return nil
+}
+
+// savedCharacter holds the parts of a character that are replaced while the
+// player is in a challenge mode game.
+type savedCharacter struct {
+	level      uint32
+	experience uint32
+	meseta     uint32
+	inventory  []packets.InventoryItem
+	// Stats gained by levelling up during the game are discarded along with the levels.
+	atp, mst, evp, hp, dfp, ata, lck uint32
+}
+
+// startChallenge replaces the player's character with a fresh one at the stage's
+// level, saving the original so that it can be restored when they leave the game.
+func (g *game) startChallenge(c *client.Client, stage *challengeStage) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.savedCharacters[c]; !ok {
+		g.savedCharacters[c] = &savedCharacter{
+			level:      c.Character.Level,
+			experience: c.Character.Experience,
+			meseta:     c.Character.Meseta,
+			inventory:  c.Inventory,
+			atp:        c.Character.Atp,
+			mst:        c.Character.Mst,
+			evp:        c.Character.Evp,
+			hp:         c.Character.Hp,
+			dfp:        c.Character.Dfp,
+			ata:        c.Character.Ata,
+			lck:        c.Character.Lck,
+		}
+	}
+
+	level := uint32(1)
+	if stage != nil && stage.Level > 0 {
+		level = uint32(stage.Level)
+	}
+	// Levels are zero-based in the character data.
+	c.Character.Level = level - 1
+	c.Character.Experience = 0
+	c.Character.Meseta = 0
+	c.Inventory = nil
+}
+
+// endChallenge restores the character the player had before entering the game.
+func (g *game) endChallenge(c *client.Client) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	saved, ok := g.savedCharacters[c]
+	if !ok {
+		return
+	}
+	c.Character.Level = saved.level
+	c.Character.Experience = saved.experience
+	c.Character.Meseta = saved.meseta
+	c.Inventory = saved.inventory
+	c.Character.Atp = saved.atp
+	c.Character.Mst = saved.mst
+	c.Character.Evp = saved.evp
+	c.Character.Hp = saved.hp
+	c.Character.Dfp = saved.dfp
+	c.Character.Ata = saved.ata
+	c.Character.Lck = saved.lck
+	delete(g.savedCharacters, c)
+}
+
+// startStage records that the players in the game have started playing stage.
+func (g *game) startStage(stage *challengeStage, now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.challengeStage = stage
+	g.challengeStart = now
+}
+
+// stageClearTime returns the stage being played and how many seconds have passed
+// since it started, or false if no stage has been started.
+func (g *game) stageClearTime(now time.Time) (*challengeStage, uint32, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.challengeStage == nil {
+		return nil, 0, false
+	}
+	return g.challengeStage, uint32(now.Sub(g.challengeStart) / time.Second), true
+}
+
+// sendChallengeQuestList sends the challenge stages for the game's episode.
+func (s *Server) sendChallengeQuestList(c *client.Client, g *game) error {
+	stages := s.challenge.episodeStages(int(g.episode))
+	pkt := &packets.QuestList{
+		Header: packets.BBHeader{Type: packets.QuestListType, Flags: uint32(len(stages))},
+	}
+	for _, stage := range stages {
+		entry := packets.QuestListEntry{
+			MenuID:  challengeMenuType,
+			QuestID: challengeMenuType | uint32(stage.Stage),
+		}
+		copy(entry.Name[:], bytes.ConvertToUtf16(stage.Name))
+		copy(entry.Description[:], bytes.ConvertToUtf16(fmt.Sprintf("Level %d", stage.Level)))
+		pkt.Entries = append(pkt.Entries, entry)
+	}
+	return c.Send(pkt)
+}
+
+// selectChallengeStage sets every player's character in the game to the level of
+// the stage chosen from the challenge quest list.
+func (s *Server) selectChallengeStage(c *client.Client, stageNum int) error {
+	g := s.gameOf(c)
+	if g == nil || g.mode != gameModeChallenge {
+		return fmt.Errorf("%s selected a challenge stage outside of a challenge game", c.IPAddr())
+	}
+	stage := s.challenge.stage(int(g.episode), stageNum)
+	if stage == nil {
+		return fmt.Errorf("%s selected unknown challenge stage %d", c.IPAddr(), stageNum)
+	}
+
+	g.startStage(stage, time.Now())
+	for _, player := range g.players() {
+		if player == nil {
+			continue
+		}
+		g.startChallenge(player, stage)
+		if err := s.sendTextMessage(player, fmt.Sprintf("Starting %s", stage.Name)); err != nil {
+			s.Logger.Warnf("[%s] error notifying %s of challenge stage: %v", s.Name, player.IPAddr(), err)
+		}
+	}
+	return nil
+}
+
+// handleChallengeRecords saves the player's record for the challenge stage being
+// played in their game once their client reports clearing it. Clear times are
+// measured by the server from when the stage was started rather than trusting
+// the times reported by the client.
+func (s *Server) handleChallengeRecords(ctx context.Context, c *client.Client, data []byte) error {
+	g := s.gameOf(c)
+	if g == nil || g.mode != gameModeChallenge || c.Character == nil {
+		return nil
+	}
+
+	var cmd packets.ChallengeRecordsCommand
+	if !decodeGameCommand(data, &cmd) {
+		return fmt.Errorf("challenge records from %s are too short", c.IPAddr())
+	}
+
+	stage, clearTime, ok := g.stageClearTime(time.Now())
+	if !ok || reportedClearTime(&cmd.Records, stage) == 0 {
+		// The client only reports clear times for stages it has cleared.
+		return nil
+	}
+	existing := findChallengeRecord(c.Character.ChallengeRecords, uint32(stage.Episode), uint32(stage.Stage))
+	if existing != nil && existing.ClearTime <= clearTime {
+		return nil
+	}
+
+	record := existing
+	if record == nil {
+		record = &proto.ChallengeRecord{Episode: uint32(stage.Episode), Stage: uint32(stage.Stage)}
+		c.Character.ChallengeRecords = append(c.Character.ChallengeRecords, record)
+	}
+	record.ClearTime = clearTime
+	record.Rank = stage.rank(clearTime)
+	c.Character.ChallengeTitle = s.challenge.title(c.Character.ChallengeRecords)
+
+	if _, err := s.shipgateClient.UpdateChallengeRecords(ctx, &shipgate.UpdateChallengeRecordsRequest{
+		AccountId: c.Account.Id,
+		Slot:      c.ActiveSlot,
+		Records:   []*proto.ChallengeRecord{record},
+		Title:     c.Character.ChallengeTitle,
+	}); err != nil {
+		return fmt.Errorf("error saving challenge records: %w", err)
+	}
+	s.Logger.Infof("[%s] %s cleared challenge stage %d-%d in %ds (rank %s)",
+		s.Name, c.Character.ReadableName, record.Episode, record.Stage, record.ClearTime, record.Rank)
+	return nil
+}
+
+// reportedClearTime returns the clear time the client reported for stage.
+func reportedClearTime(records *packets.ChallengeData, stage *challengeStage) uint32 {
+	i := stage.Stage - 1
+	switch {
+	case stage.Episode == 1 && i >= 0 && i < len(records.TimesEp1):
+		return records.TimesEp1[i]
+	case stage.Episode == 2 && i >= 0 && i < len(records.TimesEp2):
+		return records.TimesEp2[i]
+	default:
+		return 0
+	}
+}
+
+// challengeData converts a character's challenge records into the format
+// included in the full character data.
+func challengeData(character *proto.Character) packets.ChallengeData {
+	data := packets.ChallengeData{TitleColor: challengeTitleColor}
+	copy(data.RankTitle[:], bytes.ConvertToUtf16(character.ChallengeTitle))
+
+	for _, record := range character.ChallengeRecords {
+		stage := int(record.Stage) - 1
+		switch {
+		case record.Episode == 1 && stage >= 0 && stage < len(data.TimesEp1):
+			data.TimesEp1[stage] = record.ClearTime
+		case record.Episode == 2 && stage >= 0 && stage < len(data.TimesEp2):
+			data.TimesEp2[stage] = record.ClearTime
+		}
+	}
+	return data
+}
@@ -0,0 +1,144 @@
+package block
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+func TestChallengeConfig(t *testing.T) {
+	cfg := &core.Config{BaseDir: "../../server"}
+	cfg.BlockServer.ChallengeFile = "challenge.yaml"
+
+	challenge, err := loadChallengeConfig(cfg)
+	if err != nil {
+		t.Fatalf("error loading challenge config: %v", err)
+	}
+	if n := len(challenge.episodeStages(1)); n != challengeStagesEp1 {
+		t.Errorf("expected %d Episode 1 stages, got %d", challengeStagesEp1, n)
+	}
+	if n := len(challenge.episodeStages(2)); n != challengeStagesEp2 {
+		t.Errorf("expected %d Episode 2 stages, got %d", challengeStagesEp2, n)
+	}
+
+	stage := challenge.stage(1, 1)
+	for clearTime, want := range map[uint32]string{
+		stage.SRankTime:     "S",
+		stage.ARankTime:     "A",
+		stage.ARankTime + 1: "B",
+	} {
+		if got := stage.rank(clearTime); got != want {
+			t.Errorf("rank(%d) = %s, want %s", clearTime, got, want)
+		}
+	}
+
+	var records []*proto.ChallengeRecord
+	for i := 1; i <= challengeStagesEp2; i++ {
+		records = append(records, &proto.ChallengeRecord{Episode: 2, Stage: uint32(i), Rank: "S"})
+	}
+	if got := challenge.title(records); got != "Ep2 Master" {
+		t.Errorf("expected Ep2 Master title for all S ranks, got %q", got)
+	}
+	records[0].Rank = "A"
+	if got := challenge.title(records); got != "Ep2 Clear" {
+		t.Errorf("expected Ep2 Clear title, got %q", got)
+	}
+	if got := challenge.title(records[1:]); got != "" {
+		t.Errorf("expected no title without clearing every stage, got %q", got)
+	}
+}
+
+func TestGame_ChallengeCharacterReset(t *testing.T) {
+	g := &game{savedCharacters: make(map[*client.Client]*savedCharacter)}
+	c := &client.Client{
+		Character: &proto.Character{Level: 99, Experience: 1000, Meseta: 500, Atp: 800, Hp: 1200, Lck: 10},
+		Inventory: []packets.InventoryItem{{}},
+	}
+
+	g.startChallenge(c, &challengeStage{Level: 20})
+	if c.Character.Level != 19 || c.Character.Meseta != 0 || len(c.Inventory) != 0 {
+		t.Errorf("expected character to be reset for challenge mode, got %+v", c.Character)
+	}
+	// Levelling up during the stage raises the character's stats.
+	c.Character.Atp += 50
+	c.Character.Hp += 40
+	c.Character.Lck += 1
+
+	g.endChallenge(c)
+	if c.Character.Level != 99 || c.Character.Experience != 1000 || c.Character.Meseta != 500 || len(c.Inventory) != 1 {
+		t.Errorf("expected original character to be restored, got %+v", c.Character)
+	}
+	if c.Character.Atp != 800 || c.Character.Hp != 1200 || c.Character.Lck != 10 {
+		t.Errorf("expected original stats to be restored, got %+v", c.Character)
+	}
+}
+
+// recordingShipgate captures the challenge records saved through the shipgate.
+type recordingShipgate struct {
+	shipgate.Shipgate
+	records []*shipgate.UpdateChallengeRecordsRequest
+}
+
+func (r *recordingShipgate) UpdateChallengeRecords(_ context.Context, req *shipgate.UpdateChallengeRecordsRequest) (*emptypb.Empty, error) {
+	r.records = append(r.records, req)
+	return &emptypb.Empty{}, nil
+}
+
+func TestHandleChallengeRecords(t *testing.T) {
+	stage := &challengeStage{Episode: 1, Stage: 2, SRankTime: 60, ARankTime: 120}
+	sg := &recordingShipgate{}
+	c := &client.Client{Account: &proto.Account{Id: 1}, Character: &proto.Character{}}
+	g := &game{id: 1, mode: gameModeChallenge, clients: [maxGamePlayers]*client.Client{c}}
+	s := &Server{
+		Name:           "BLOCK01",
+		Logger:         zap.NewNop().Sugar(),
+		challenge:      &challengeConfig{Stages: []*challengeStage{stage}},
+		shipgateClient: sg,
+		games:          map[int]*game{1: g},
+	}
+
+	// The client claims to have cleared the stage in a second.
+	cmd := &packets.ChallengeRecordsCommand{Subcommand: packets.SubcommandHeader{Type: subcmdChallengeRecords}}
+	cmd.Records.TimesEp1[1] = 1
+	data, _ := bytes.BytesFromStruct(cmd)
+
+	if err := s.handleChallengeRecords(context.Background(), c, data); err != nil {
+		t.Fatalf("error handling challenge records: %v", err)
+	}
+	if len(sg.records) != 0 {
+		t.Fatalf("expected records to be ignored before a stage is started")
+	}
+
+	g.startStage(stage, time.Now().Add(-90*time.Second))
+	if err := s.handleChallengeRecords(context.Background(), c, data); err != nil {
+		t.Fatalf("error handling challenge records: %v", err)
+	}
+	if len(sg.records) != 1 || len(sg.records[0].Records) != 1 {
+		t.Fatalf("expected one record to be saved, got %v", sg.records)
+	}
+	// The clear time comes from when the stage started, not the client.
+	if record := sg.records[0].Records[0]; record.Stage != 2 || record.ClearTime < 90 || record.ClearTime > 91 || record.Rank != "A" {
+		t.Errorf("expected an A rank clear of stage 2 in 90 seconds, got %+v", record)
+	}
+
+	// Reports for stages other than the one being played are ignored.
+	cmd.Records.TimesEp1[1] = 0
+	cmd.Records.TimesEp1[0] = 1
+	data, _ = bytes.BytesFromStruct(cmd)
+	if err := s.handleChallengeRecords(context.Background(), c, data); err != nil {
+		t.Fatalf("error handling challenge records: %v", err)
+	}
+	if len(sg.records) != 1 {
+		t.Errorf("expected records for another stage to be ignored, got %d saves", len(sg.records))
+	}
+}
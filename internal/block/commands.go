@@ -0,0 +1,480 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/data"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// command is a chat command that privileged players can run from inside the game by
+// prefixing a chat message with the configured command prefix (e.g. "/kick 42000001").
+type command struct {
+	name string
+	// Arguments and description displayed by the help command.
+	usage       string
+	description string
+	// Permission required to run the command. Commands that don't require a
+	// permission are available to anyone allowed to run commands.
+	permission auth.Permission
+
+	run func(ctx context.Context, s *Server, c *client.Client, args []string) error
+}
+
+// commandRegistry maps the names of chat commands to their implementations.
+type commandRegistry map[string]*command
+
+func (r commandRegistry) register(cmd *command) {
+	r[cmd.name] = cmd
+}
+
+// defaultCommands returns a registry containing every command supported by the
+// block server. New commands only need to be registered here.
+func defaultCommands() commandRegistry {
+	r := make(commandRegistry)
+	r.register(&command{
+		name:        "help",
+		description: "List the available commands",
+		run:         runHelpCommand,
+	})
+	r.register(&command{
+		name:        "kick",
+		usage:       "<guildcard|name>",
+		description: "Disconnect a player (by guildcard if they're on another block or ship)",
+		permission:  auth.PermissionKick,
+		run:         runKickCommand,
+	})
+	r.register(&command{
+		name:        "ban",
+		usage:       "<guildcard|name> [duration] [reason]",
+		description: "Ban a player's account, permanently or for a duration like 72h",
+		permission:  auth.PermissionBan,
+		run:         runBanCommand,
+	})
+	r.register(&command{
+		name:        "announce",
+		usage:       "<message>",
+		description: "Send a message to everyone on every ship",
+		permission:  auth.PermissionAnnounce,
+		run:         runAnnounceCommand,
+	})
+	r.register(&command{
+		name:        "event",
+		usage:       "[event|auto]",
+		description: "Change the lobby event on the block (auto restores the calendar)",
+		permission:  auth.PermissionLobbyEvent,
+		run:         runEventCommand,
+	})
+	r.register(&command{
+		name:        "warp",
+		usage:       "<block> [lobby] [guildcard|name]",
+		description: "Move yourself or another player to a lobby on any block of the ship",
+		permission:  auth.PermissionWarp,
+		run:         runWarpCommand,
+	})
+	r.register(&command{
+		name:        "teleport",
+		usage:       "<guildcard|name>",
+		description: "Move yourself to the lobby of another player on the ship",
+		permission:  auth.PermissionWarp,
+		run:         runTeleportCommand,
+	})
+	r.register(&command{
+		name:        "item",
+		usage:       "<item data in hex>",
+		description: "Create an item in your inventory while in a game",
+		permission:  auth.PermissionSpawnItem,
+		run:         runItemCommand,
+	})
+	r.register(&command{
+		name:        "violations",
+		usage:       "[guildcard|name]",
+		description: "List recent anti-cheat violations, optionally by one player",
+		permission:  auth.PermissionReviewViolations,
+		run:         runViolationsCommand,
+	})
+	return r
+}
+
+var errMissingArguments = errors.New("missing arguments")
+
+// runCommand parses a line of chat (minus the prefix) and runs the corresponding
+// command if the player's role allows it. Any errors returned by the command are
+// sent back to the player as a message rather than ending their session.
+func (s *Server) runCommand(ctx context.Context, c *client.Client, line string) error {
+	args := strings.Fields(line)
+	if len(args) == 0 {
+		return nil
+	}
+
+	cmd, ok := s.commands[strings.ToLower(args[0])]
+	if !ok {
+		return s.sendTextMessage(c, fmt.Sprintf("Unknown command: %s", args[0]))
+	}
+	if !s.canRun(c, cmd) {
+		return s.sendTextMessage(c, "You do not have permission to use that command.")
+	}
+
+	s.Logger.Infof("[%s] %s ran command: %s", s.Name, c.Account.Username, line)
+	if err := cmd.run(ctx, s, c, args[1:]); err != nil {
+		if errors.Is(err, errMissingArguments) {
+			return s.sendTextMessage(c, fmt.Sprintf("Usage: %s%s %s", s.Config.BlockServer.CommandPrefix, cmd.name, cmd.usage))
+		}
+		return s.sendTextMessage(c, fmt.Sprintf("Error: %v", err))
+	}
+
+	if cmd.permission != "" {
+		s.recordAuditEntry(ctx, c, cmd.name, strings.Join(args[1:], " "))
+	}
+	return nil
+}
+
+// canRun returns whether the player's role allows them to run cmd.
+func (s *Server) canRun(c *client.Client, cmd *command) bool {
+	return cmd.permission == "" || s.roles.ForAccount(c.Account).Can(cmd.permission)
+}
+
+// recordAuditEntry saves a record of a privileged action taken by the player.
+func (s *Server) recordAuditEntry(ctx context.Context, c *client.Client, action, target string) {
+	if _, err := s.shipgateClient.RecordAuditEntry(ctx, &shipgate.AuditEntry{
+		ActorAccountId: c.Account.Id,
+		Action:         action,
+		Target:         target,
+		Source:         s.Name,
+	}); err != nil {
+		s.Logger.Errorf("[%s] error recording audit entry for %s: %v", s.Name, action, err)
+	}
+}
+
+func runHelpCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	var names []string
+	for name, cmd := range s.commands {
+		if s.canRun(c, cmd) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var help strings.Builder
+	for _, name := range names {
+		cmd := s.commands[name]
+		help.WriteString(fmt.Sprintf("%s%s %s - %s\n", s.Config.BlockServer.CommandPrefix, cmd.name, cmd.usage, cmd.description))
+	}
+	return s.sendTextMessage(c, help.String())
+}
+
+func runKickCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errMissingArguments
+	}
+
+	target := s.findPlayer(args[0])
+	if target == nil {
+		return kickRemotePlayer(ctx, s, c, args[0])
+	}
+	if err := s.kick(target, ""); err != nil {
+		return fmt.Errorf("error disconnecting player: %w", err)
+	}
+	return s.sendTextMessage(c, fmt.Sprintf("Kicked %s", target.Character.ReadableName))
+}
+
+// kickRemotePlayer has the shipgate kick the player with the guildcard number
+// if they're somewhere other than this block.
+func kickRemotePlayer(ctx context.Context, s *Server, c *client.Client, target string) error {
+	location, err := findRemotePlayer(ctx, s, target)
+	if err != nil {
+		return err
+	}
+	if _, err := s.shipgateClient.KickPlayer(ctx, &shipgate.KickPlayerRequest{
+		ActorAccountId: c.Account.Id,
+		AccountId:      location.AccountId,
+	}); err != nil {
+		return fmt.Errorf("error disconnecting player: %w", err)
+	}
+	return s.sendTextMessage(c, fmt.Sprintf("Kicked %s from %s", location.CharacterName, location.Ship))
+}
+
+// findRemotePlayer asks the shipgate where the player with the guildcard number
+// is, for commands targeting players who aren't on this block.
+func findRemotePlayer(ctx context.Context, s *Server, target string) (*shipgate.PlayerLocation, error) {
+	guildcard, err := strconv.ParseUint(target, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("no player found matching %s", target)
+	}
+	resp, err := s.shipgateClient.FindPlayer(ctx, &shipgate.FindPlayerRequest{Guildcard: uint32(guildcard)})
+	if err != nil {
+		return nil, fmt.Errorf("error finding player: %w", err)
+	} else if !resp.Found {
+		return nil, fmt.Errorf("no player found matching %s", target)
+	}
+	return resp.Location, nil
+}
+
+// Message shown to players banned with the ban command before they're disconnected.
+const bannedMessage = "You have been banned."
+
+func runBanCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errMissingArguments
+	}
+
+	ban := &shipgate.Ban{Type: data.BanTypeAccount}
+	reason := args[1:]
+	if len(reason) > 0 {
+		if duration, err := time.ParseDuration(reason[0]); err == nil && duration > 0 {
+			ban.ExpiresAt = time.Now().Add(duration).Unix()
+			reason = reason[1:]
+		}
+	}
+	ban.Reason = strings.Join(reason, " ")
+
+	// Players on this block are disconnected directly, anyone else through the shipgate.
+	var name string
+	target := s.findPlayer(args[0])
+	if target != nil {
+		ban.AccountId, name = target.Account.Id, target.Character.ReadableName
+	} else {
+		location, err := findRemotePlayer(ctx, s, args[0])
+		if err != nil {
+			return err
+		}
+		ban.AccountId, name = location.AccountId, location.CharacterName
+	}
+	if ban.AccountId == c.Account.Id {
+		return errors.New("you can't ban yourself")
+	}
+
+	if _, err := s.shipgateClient.BanPlayer(ctx, &shipgate.BanRequest{ActorAccountId: c.Account.Id, Ban: ban}); err != nil {
+		return fmt.Errorf("error banning player: %w", err)
+	}
+	// Bans only keep players from logging in again, so they're disconnected as well.
+	var err error
+	if target != nil {
+		err = s.kick(target, bannedMessage)
+	} else {
+		_, err = s.shipgateClient.KickPlayer(ctx, &shipgate.KickPlayerRequest{
+			ActorAccountId: c.Account.Id,
+			AccountId:      ban.AccountId,
+			Message:        bannedMessage,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("banned %s but couldn't disconnect them: %w", name, err)
+	}
+	return s.sendTextMessage(c, fmt.Sprintf("Banned %s", name))
+}
+
+func runAnnounceCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errMissingArguments
+	}
+
+	// Announcements go through the shipgate so that they reach every ship,
+	// including this one.
+	if _, err := s.shipgateClient.Broadcast(ctx, &shipgate.BroadcastRequest{
+		ActorAccountId: c.Account.Id,
+		Message:        strings.Join(args, " "),
+	}); err != nil {
+		return fmt.Errorf("error sending announcement: %w", err)
+	}
+	return nil
+}
+
+func runEventCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return s.sendTextMessage(c, fmt.Sprintf("Current lobby event: %s", s.lobbyEvent()))
+	}
+
+	if strings.EqualFold(args[0], "auto") {
+		s.setLobbyEventOverride(nil)
+	} else {
+		event, err := parseLobbyEvent(args[0])
+		if err != nil {
+			return err
+		}
+		s.setLobbyEventOverride(&event)
+	}
+	return s.sendTextMessage(c, fmt.Sprintf("Lobby event set to %s", s.lobbyEvent()))
+}
+
+func runWarpCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errMissingArguments
+	}
+
+	blockID, err := strconv.Atoi(args[0])
+	if err != nil || blockID < 1 || blockID > s.Config.ShipServer.NumBlocks {
+		return fmt.Errorf("block must be between 1 and %d", s.Config.ShipServer.NumBlocks)
+	}
+	lobbyID := 1
+	if len(args) > 1 {
+		lobbyID, err = strconv.Atoi(args[1])
+		if err != nil || lobbyID < 1 || lobbyID > s.Config.BlockServer.NumLobbies {
+			return fmt.Errorf("lobby must be between 1 and %d", s.Config.BlockServer.NumLobbies)
+		}
+	}
+	target := c
+	if len(args) > 2 {
+		if target = s.findPlayer(args[2]); target == nil {
+			return fmt.Errorf("no player found matching %s", args[2])
+		}
+	}
+
+	if err := s.warpPlayer(ctx, target, s.Config.BlockAddress(blockID), lobbyID-1); err != nil {
+		return fmt.Errorf("error warping player: %w", err)
+	}
+	if target != c {
+		return s.sendTextMessage(c, fmt.Sprintf("Warped %s to block %d, lobby %d", target.Character.ReadableName, blockID, lobbyID))
+	}
+	return nil
+}
+
+func runTeleportCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errMissingArguments
+	}
+
+	if target := s.findPlayer(args[0]); target != nil {
+		if target == c {
+			return errors.New("you can't teleport to yourself")
+		}
+		// The player may have moved into a game or left since they were found.
+		l := s.lobbyOf(target)
+		if l == nil {
+			return fmt.Errorf("%s isn't in a lobby", target.Character.ReadableName)
+		}
+		return s.changeLobby(ctx, c, l.id)
+	}
+
+	// Players elsewhere on the ship are joined in their block's lobby, even if
+	// they're in a game.
+	location, err := findRemotePlayer(ctx, s, args[0])
+	if err != nil {
+		return err
+	} else if location.Ship != s.Config.ShipServer.Name {
+		return fmt.Errorf("%s is on %s", location.CharacterName, location.Ship)
+	}
+	if err := s.warpPlayer(ctx, c, location.BlockAddress, int(location.LobbyId)); err != nil {
+		return fmt.Errorf("error teleporting: %w", err)
+	}
+	return nil
+}
+
+// Size of the item data (including the mag data) accepted by the item command.
+const maxItemDataSize = 16
+
+func runItemCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	if len(args) == 0 {
+		return errMissingArguments
+	}
+	itemData, err := hex.DecodeString(strings.Join(args, ""))
+	if err != nil || len(itemData) > maxItemDataSize {
+		return fmt.Errorf("item data must be up to %d bytes of hex", maxItemDataSize)
+	}
+
+	g := s.gameOf(c)
+	if g == nil {
+		return errors.New("items can only be created in a game")
+	}
+	clientID, ok := g.clientID(c)
+	if !ok {
+		return errors.New("items can only be created in a game")
+	}
+
+	item := packets.Item{ItemID: g.createItem(clientID)}
+	copy(item.Data[:], itemData)
+	if len(itemData) > len(item.Data) {
+		var magData [4]uint8
+		copy(magData[:], itemData[len(item.Data):])
+		item.MagData = binary.LittleEndian.Uint32(magData[:])
+	}
+	s.sendToGame(g, &packets.CreateItemCommand{
+		Header:     packets.BBHeader{Type: packets.GameCommandType},
+		Subcommand: packets.SubcommandHeader{Type: subcmdCreateItem, Size: 7, ClientID: uint16(clientID)},
+		Item:       item,
+	})
+	return nil
+}
+
+// createItem returns the ID of a new item for the player with clientID, who's
+// allowed to use it as if they'd picked it up.
+func (g *game) createItem(clientID int) uint32 {
+	g.mu.Lock()
+	itemID := uint32(createdItemIDBase) + g.createdItems
+	g.createdItems++
+	g.mu.Unlock()
+
+	g.recordPickUp(clientID, itemID)
+	return itemID
+}
+
+// Number of violations listed by the violations command.
+const violationsListed = 10
+
+func runViolationsCommand(ctx context.Context, s *Server, c *client.Client, args []string) error {
+	req := &shipgate.ListViolationsRequest{ActorAccountId: c.Account.Id, Limit: violationsListed}
+	if len(args) > 0 {
+		target := s.findPlayer(args[0])
+		if target == nil {
+			return fmt.Errorf("no player found matching %s", args[0])
+		}
+		req.AccountId = target.Account.Id
+	}
+
+	resp, err := s.shipgateClient.ListViolations(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error retrieving violations: %w", err)
+	}
+	if len(resp.Violations) == 0 {
+		return s.sendTextMessage(c, "No violations found.")
+	}
+
+	var list strings.Builder
+	for _, v := range resp.Violations {
+		list.WriteString(fmt.Sprintf("%s %s (%s): %s - %s\n",
+			time.Unix(v.CreatedAt, 0).Format("01/02 15:04"), v.CharacterName, v.Source, v.Rule, v.Action))
+	}
+	return s.sendTextMessage(c, list.String())
+}
+
+// findPlayer looks up a player in one of the block's lobbies or games by guildcard
+// number or character name.
+func (s *Server) findPlayer(target string) *client.Client {
+	guildcard, _ := strconv.ParseUint(target, 10, 32)
+	matches := func(player *client.Client) bool {
+		if player == nil || player.Character == nil {
+			return false
+		}
+		return (guildcard != 0 && uint64(player.Guildcard) == guildcard) || strings.EqualFold(player.Character.ReadableName, target)
+	}
+
+	for _, l := range s.lobbies {
+		for _, player := range l.players() {
+			if matches(player) {
+				return player
+			}
+		}
+	}
+
+	s.gamesMu.RLock()
+	defer s.gamesMu.RUnlock()
+	for _, g := range s.games {
+		for _, player := range g.players() {
+			if matches(player) {
+				return player
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/data"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// chatPacket returns the bytes of a chat packet sent by the client with text.
+func chatPacket(text string) []byte {
+	data, _ := bytes.BytesFromStruct(&packets.Chat{
+		Header:  packets.BBHeader{Type: packets.ChatType},
+		Message: bytes.ConvertToUtf16(languageMarker + text + "\x00"),
+	})
+	binary.LittleEndian.PutUint16(data, uint16(len(data)))
+	return data
+}
+
+func TestHandle_ChatCommand(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.BlockServer.CommandPrefix = "/"
+	roles, err := auth.NewRoles(cfg)
+	if err != nil {
+		t.Fatalf("error loading roles: %v", err)
+	}
+
+	var ran []string
+	s := &Server{Name: "BLOCK01", Config: cfg, Logger: zap.NewNop().Sugar(), roles: roles, commands: make(commandRegistry)}
+	s.commands.register(&command{
+		name: "test",
+		run: func(_ context.Context, _ *Server, _ *client.Client, args []string) error {
+			ran = args
+			return nil
+		},
+	})
+
+	c := &client.Client{
+		Account:   &proto.Account{Id: 1, Username: "gm", Gm: true},
+		Character: &proto.Character{},
+	}
+	if err := s.Handle(context.Background(), c, chatPacket("/test one two")); err != nil {
+		t.Fatalf("error handling chat packet: %v", err)
+	}
+	if len(ran) != 2 || ran[0] != "one" || ran[1] != "two" {
+		t.Errorf("expected test command to run with [one two], got %v", ran)
+	}
+}
+
+func TestHandle_ChatInGame(t *testing.T) {
+	s := newCommandServer(t, &commandShipgate{})
+	sender, senderConn := newTestPlayer(t, 1, "sonic", false)
+	other, otherConn := newTestPlayer(t, 2, "tails", false)
+	s.games[1] = &game{id: 1, clients: [maxGamePlayers]*client.Client{sender, other}}
+
+	if err := s.Handle(context.Background(), sender, chatPacket("hello")); err != nil {
+		t.Fatalf("error handling chat packet: %v", err)
+	}
+	for _, conn := range []*testConn{senderConn, otherConn} {
+		if header, _ := conn.next(); header.Type != packets.ChatType {
+			t.Errorf("expected the chat to be relayed to the game, got packet %02x", header.Type)
+		}
+	}
+}
+
+func TestParseChat(t *testing.T) {
+	pkt, err := parseChat(chatPacket("hello"))
+	if err != nil {
+		t.Fatalf("error parsing chat packet: %v", err)
+	}
+	if got := bytes.ConvertFromUtf16(pkt.Message); got != languageMarker+"hello" {
+		t.Errorf("expected message %q, got %q", languageMarker+"hello", got)
+	}
+
+	if _, err := parseChat(make([]byte, packets.BBHeaderSize)); err == nil {
+		t.Errorf("expected an error for a truncated chat packet")
+	}
+}
+
+// commandShipgate records the requests that commands make to the shipgate. FindPlayer
+// returns location, if set.
+type commandShipgate struct {
+	shipgate.Shipgate
+	location *shipgate.PlayerLocation
+	bans     []*shipgate.BanRequest
+	kicks    []*shipgate.KickPlayerRequest
+	warps    []*shipgate.WarpRequest
+}
+
+func (sg *commandShipgate) FindPlayer(context.Context, *shipgate.FindPlayerRequest) (*shipgate.FindPlayerResponse, error) {
+	return &shipgate.FindPlayerResponse{Found: sg.location != nil, Location: sg.location}, nil
+}
+
+func (sg *commandShipgate) BanPlayer(_ context.Context, req *shipgate.BanRequest) (*shipgate.Ban, error) {
+	sg.bans = append(sg.bans, req)
+	return req.Ban, nil
+}
+
+func (sg *commandShipgate) KickPlayer(_ context.Context, req *shipgate.KickPlayerRequest) (*emptypb.Empty, error) {
+	sg.kicks = append(sg.kicks, req)
+	return &emptypb.Empty{}, nil
+}
+
+func (sg *commandShipgate) ScheduleWarp(_ context.Context, req *shipgate.WarpRequest) (*emptypb.Empty, error) {
+	sg.warps = append(sg.warps, req)
+	return &emptypb.Empty{}, nil
+}
+
+func (sg *commandShipgate) SetPlayerLocation(context.Context, *shipgate.PlayerLocation) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (sg *commandShipgate) RecordAuditEntry(context.Context, *shipgate.AuditEntry) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// newCommandServer returns a block with three lobbies that runs the default commands.
+func newCommandServer(t *testing.T, sg shipgate.Shipgate) *Server {
+	cfg := &core.Config{ExternalIP: "127.0.0.1"}
+	cfg.ShipServer.Name = "Archon"
+	cfg.BlockServer.Port = 15000
+	cfg.BlockServer.CommandPrefix = "/"
+	roles, err := auth.NewRoles(cfg)
+	if err != nil {
+		t.Fatalf("error loading roles: %v", err)
+	}
+
+	s := &Server{
+		Name:           "BLOCK01",
+		ID:             1,
+		Config:         cfg,
+		Logger:         zap.NewNop().Sugar(),
+		roles:          roles,
+		commands:       defaultCommands(),
+		shipgateClient: sg,
+		games:          make(map[int]*game),
+		warpLobbies:    make(map[*client.Client]int),
+	}
+	for i := 0; i < 3; i++ {
+		s.lobbies = append(s.lobbies, newLobby(i))
+	}
+	return s
+}
+
+// newTestPlayer returns a connected client logged in with a character.
+func newTestPlayer(t *testing.T, accountID uint64, name string, gm bool) (*client.Client, *testConn) {
+	c, conn := newTestClient(t)
+	c.Account = &proto.Account{Id: accountID, Username: strings.ToLower(name), Gm: gm}
+	c.Character = &proto.Character{ReadableName: name}
+	c.Guildcard = 42000000 + uint32(accountID)
+	return c, conn
+}
+
+// expectClosed fails the test unless the server closed the connection.
+func (tc *testConn) expectClosed() {
+	tc.t.Helper()
+	tc.conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := tc.conn.Read(make([]byte, 1)); err != io.EOF {
+		tc.t.Errorf("expected the connection to be closed, got %v", err)
+	}
+}
+
+func TestCommands_Permissions(t *testing.T) {
+	s := newCommandServer(t, &commandShipgate{})
+	player := &client.Client{Account: &proto.Account{Id: 1}}
+	gm := &client.Client{Account: &proto.Account{Id: 2, Gm: true}}
+
+	for _, name := range []string{"kick", "ban", "warp", "teleport", "item"} {
+		if s.canRun(player, s.commands[name]) {
+			t.Errorf("expected players not to be allowed to run %s", name)
+		}
+		if !s.canRun(gm, s.commands[name]) {
+			t.Errorf("expected GMs to be allowed to run %s", name)
+		}
+	}
+}
+
+// Ships can only record privileged commands in the audit log if the shipgate
+// knows which permission each one requires.
+func TestCommands_AuditPermissions(t *testing.T) {
+	for name, cmd := range defaultCommands() {
+		if cmd.permission == "" {
+			continue
+		}
+		if got := shipgate.ShipAuditPermissions[name]; got != cmd.permission {
+			t.Errorf("expected the shipgate to require %s to record %s, got %q", cmd.permission, name, got)
+		}
+	}
+}
+
+func TestBanCommand(t *testing.T) {
+	t.Run("player on the block", func(t *testing.T) {
+		sg := &commandShipgate{}
+		s := newCommandServer(t, sg)
+		gm, gmConn := newTestPlayer(t, 1, "gm", true)
+		target, targetConn := newTestPlayer(t, 2, "sonic", false)
+		s.lobbies[0].add(gm)
+		s.lobbies[0].add(target)
+
+		if err := s.runCommand(context.Background(), gm, "ban sonic 72h Duping items"); err != nil {
+			t.Fatalf("error running ban command: %v", err)
+		}
+		if len(sg.bans) != 1 {
+			t.Fatalf("expected 1 ban, got %d", len(sg.bans))
+		}
+		req := sg.bans[0]
+		if req.ActorAccountId != 1 || req.Ban.Type != data.BanTypeAccount || req.Ban.AccountId != 2 || req.Ban.Reason != "Duping items" {
+			t.Errorf("unexpected ban request: %v", req)
+		}
+		if expires := time.Unix(req.Ban.ExpiresAt, 0); time.Until(expires) < 71*time.Hour || time.Until(expires) > 73*time.Hour {
+			t.Errorf("expected the ban to expire in 72h, got %v", expires)
+		}
+
+		if header, _ := targetConn.next(); header.Type != packets.TextMessageType {
+			t.Errorf("expected the banned player to be told why, got packet %02x", header.Type)
+		}
+		targetConn.expectClosed()
+		if header, _ := gmConn.next(); header.Type != packets.TextMessageType {
+			t.Errorf("expected a confirmation, got packet %02x", header.Type)
+		}
+	})
+
+	t.Run("player elsewhere", func(t *testing.T) {
+		sg := &commandShipgate{location: &shipgate.PlayerLocation{AccountId: 3, CharacterName: "tails", Ship: "Archon"}}
+		s := newCommandServer(t, sg)
+		gm, _ := newTestPlayer(t, 1, "gm", true)
+
+		if err := s.runCommand(context.Background(), gm, "ban 42000003"); err != nil {
+			t.Fatalf("error running ban command: %v", err)
+		}
+		if len(sg.bans) != 1 || sg.bans[0].Ban.AccountId != 3 || sg.bans[0].Ban.ExpiresAt != 0 {
+			t.Errorf("expected a permanent ban on account 3, got %v", sg.bans)
+		}
+		if len(sg.kicks) != 1 || sg.kicks[0].ActorAccountId != 1 || sg.kicks[0].AccountId != 3 || sg.kicks[0].Message != bannedMessage {
+			t.Errorf("expected account 3 to be kicked by the GM, got %v", sg.kicks)
+		}
+	})
+
+	t.Run("themselves", func(t *testing.T) {
+		sg := &commandShipgate{}
+		s := newCommandServer(t, sg)
+		gm, _ := newTestPlayer(t, 1, "gm", true)
+		s.lobbies[0].add(gm)
+
+		if err := s.runCommand(context.Background(), gm, "ban gm"); err != nil {
+			t.Fatalf("error running ban command: %v", err)
+		}
+		if len(sg.bans) != 0 {
+			t.Errorf("expected GMs not to be able to ban themselves")
+		}
+	})
+}
+
+func TestTeleportCommand(t *testing.T) {
+	t.Run("player on the block", func(t *testing.T) {
+		s := newCommandServer(t, &commandShipgate{})
+		gm, gmConn := newTestPlayer(t, 1, "gm", true)
+		target, _ := newTestPlayer(t, 2, "sonic", false)
+		s.lobbies[0].add(gm)
+		s.lobbies[2].add(target)
+
+		if err := s.runCommand(context.Background(), gm, "teleport sonic"); err != nil {
+			t.Fatalf("error running teleport command: %v", err)
+		}
+		if l := s.lobbyOf(gm); l == nil || l.id != 2 {
+			t.Errorf("expected to be moved to lobby 2, got %v", l)
+		}
+		if header, _ := gmConn.next(); header.Type != packets.LobbyJoinType {
+			t.Errorf("expected to join the lobby, got packet %02x", header.Type)
+		}
+	})
+
+	t.Run("player in a game", func(t *testing.T) {
+		s := newCommandServer(t, &commandShipgate{})
+		gm, gmConn := newTestPlayer(t, 1, "gm", true)
+		target, _ := newTestPlayer(t, 2, "sonic", false)
+		s.lobbies[0].add(gm)
+		s.games[1] = &game{id: 1, clients: [maxGamePlayers]*client.Client{target}}
+
+		if err := s.runCommand(context.Background(), gm, "teleport sonic"); err != nil {
+			t.Fatalf("error running teleport command: %v", err)
+		}
+		if l := s.lobbyOf(gm); l == nil || l.id != 0 {
+			t.Errorf("expected to stay in lobby 0, got %v", l)
+		}
+		if header, _ := gmConn.next(); header.Type != packets.TextMessageType {
+			t.Errorf("expected an error message, got packet %02x", header.Type)
+		}
+	})
+
+	t.Run("player on another block", func(t *testing.T) {
+		sg := &commandShipgate{location: &shipgate.PlayerLocation{
+			AccountId:     2,
+			CharacterName: "sonic",
+			Ship:          "Archon",
+			BlockAddress:  "127.0.0.1:15002",
+			LobbyId:       1,
+		}}
+		s := newCommandServer(t, sg)
+		gm, gmConn := newTestPlayer(t, 1, "gm", true)
+		s.lobbies[0].add(gm)
+
+		if err := s.runCommand(context.Background(), gm, "teleport 42000002"); err != nil {
+			t.Fatalf("error running teleport command: %v", err)
+		}
+		if len(sg.warps) != 1 || sg.warps[0].BlockAddress != "127.0.0.1:15002" || sg.warps[0].LobbyId != 1 {
+			t.Errorf("expected a warp to lobby 1 on the other block, got %v", sg.warps)
+		}
+		if header, _ := gmConn.next(); header.Type != packets.RedirectType {
+			t.Errorf("expected to be redirected, got packet %02x", header.Type)
+		}
+	})
+
+	t.Run("player on another ship", func(t *testing.T) {
+		sg := &commandShipgate{location: &shipgate.PlayerLocation{
+			AccountId:     2,
+			CharacterName: "sonic",
+			Ship:          "Elsewhere",
+			BlockAddress:  "127.0.0.1:16001",
+		}}
+		s := newCommandServer(t, sg)
+		gm, _ := newTestPlayer(t, 1, "gm", true)
+		s.lobbies[0].add(gm)
+
+		if err := s.runCommand(context.Background(), gm, "teleport 42000002"); err != nil {
+			t.Fatalf("error running teleport command: %v", err)
+		}
+		if len(sg.warps) != 0 {
+			t.Errorf("expected no warp to another ship, got %v", sg.warps)
+		}
+	})
+}
+
+func TestItemCommand(t *testing.T) {
+	s := newCommandServer(t, &commandShipgate{})
+	gm, gmConn := newTestPlayer(t, 1, "gm", true)
+	g := &game{id: 1, clients: [maxGamePlayers]*client.Client{nil, gm}}
+	s.games[g.id] = g
+
+	if err := s.runCommand(context.Background(), gm, "item 000500 000000000000000000 01020304"); err != nil {
+		t.Fatalf("error running item command: %v", err)
+	}
+	_, pkt := gmConn.next()
+	var cmd packets.CreateItemCommand
+	bytes.StructFromBytes(pkt, &cmd)
+	if cmd.Subcommand.Type != subcmdCreateItem || cmd.Subcommand.ClientID != 1 {
+		t.Errorf("expected an item created for client 1, got subcommand %02x for client %d", cmd.Subcommand.Type, cmd.Subcommand.ClientID)
+	}
+	if cmd.Item.ItemID != createdItemIDBase || cmd.Item.Data[1] != 0x05 || cmd.Item.MagData != 0x04030201 {
+		t.Errorf("unexpected item: %+v", cmd.Item)
+	}
+	// The player can use the item without tripping the item ID rule.
+	if !g.pickedUp(1, cmd.Item.ItemID) {
+		t.Errorf("expected the item to belong to client 1")
+	}
+	if itemID := g.createItem(1); itemID != createdItemIDBase+1 {
+		t.Errorf("expected the next item to have ID %#x, got %#x", createdItemIDBase+1, itemID)
+	}
+
+	// Items can only be created in games.
+	g.remove(gm)
+	if err := s.runCommand(context.Background(), gm, "item 000500"); err != nil {
+		t.Fatalf("error running item command: %v", err)
+	}
+	if header, _ := gmConn.next(); header.Type != packets.TextMessageType {
+		t.Errorf("expected an error message outside of a game, got packet %02x", header.Type)
+	}
+}
+
+func TestFindPlayer(t *testing.T) {
+	s := newCommandServer(t, &commandShipgate{})
+	inLobby, _ := newTestPlayer(t, 1, "sonic", false)
+	inGame, _ := newTestPlayer(t, 2, "tails", false)
+	s.lobbies[1].add(inLobby)
+	s.games[1] = &game{id: 1, clients: [maxGamePlayers]*client.Client{nil, inGame}}
+
+	if got := s.findPlayer("SONIC"); got != inLobby {
+		t.Errorf("expected to find the player in the lobby by name")
+	}
+	if got := s.findPlayer("42000002"); got != inGame {
+		t.Errorf("expected to find the player in the game by guildcard")
+	}
+	if got := s.findPlayer("knuckles"); got != nil {
+		t.Errorf("expected no player to match, got %v", got)
+	}
+}
@@ -0,0 +1,248 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dcrodman/archon/internal/character"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/maps"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// Percentage of an enemy's experience given to players who hit it but didn't land the killing blow.
+const assistExperiencePercent = 80
+
+var errEnemyDead = errors.New("enemy is already dead")
+
+// Names of the embedded battle parameter files for each episode. Solo games use
+// the offline versions and everything else uses the "_on" variants.
+var battleParamFiles = map[int]string{
+	1: "BattleParamEntry",
+	2: "BattleParamEntry_lab",
+	4: "BattleParamEntry_ep4",
+}
+
+// loadBattleParams parses the online and offline battle parameters for each
+// episode, keyed by file name.
+func loadBattleParams() (map[string]*maps.BattleParams, error) {
+	params := make(map[string]*maps.BattleParams)
+	for _, name := range battleParamFiles {
+		for _, file := range []string{name + ".dat", name + "_on.dat"} {
+			data, err := character.ReadParameterFile(file)
+			if err != nil {
+				return nil, err
+			}
+			if params[file], err = maps.ParseBattleParams(data); err != nil {
+				return nil, fmt.Errorf("error parsing %s: %w", file, err)
+			}
+		}
+	}
+	return params, nil
+}
+
+// battleParamFile returns the name of the battle parameter file used by a game.
+func battleParamFile(episode int, solo bool) string {
+	if solo {
+		return battleParamFiles[episode] + ".dat"
+	}
+	return battleParamFiles[episode] + "_on.dat"
+}
+
+// enemyState tracks what has happened to one of the enemies in a game.
+type enemyState struct {
+	dead bool
+	// Players that have hit the enemy, indexed by client ID.
+	hitBy [maxGamePlayers]bool
+}
+
+// checkEnemy returns an error if the enemy doesn't exist, isn't on the same floor
+// as the player, or has already died. Callers must hold g.mu.
+func (g *game) checkEnemy(clientID, enemyID int) error {
+	if enemyID < 0 || enemyID >= len(g.enemies) {
+		return fmt.Errorf("enemy %d does not exist", enemyID)
+	}
+	if floor := g.floors[clientID]; g.enemies[enemyID].Floor != floor {
+		return fmt.Errorf("enemy %d is not on floor %d", enemyID, floor)
+	}
+	if g.enemyStates[enemyID].dead {
+		return errEnemyDead
+	}
+	return nil
+}
+
+// setFloor records the floor the player with clientID is on.
+func (g *game) setFloor(clientID, floor int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.floors[clientID] = floor
+}
+
+// hitEnemy records that the player with clientID has hit the enemy.
+func (g *game) hitEnemy(clientID, enemyID int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.checkEnemy(clientID, enemyID); err != nil {
+		return err
+	}
+	g.enemyStates[enemyID].hitBy[clientID] = true
+	return nil
+}
+
+// killEnemy marks the enemy as dead and returns it along with the client IDs of
+// every player that hit it, including the killer.
+func (g *game) killEnemy(clientID, enemyID int) (maps.Enemy, [maxGamePlayers]bool, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.checkEnemy(clientID, enemyID); err != nil {
+		return maps.Enemy{}, [maxGamePlayers]bool{}, err
+	}
+	state := &g.enemyStates[enemyID]
+	state.dead = true
+	state.hitBy[clientID] = true
+	return g.enemies[enemyID], state.hitBy, nil
+}
+
+// handleFloorChange keeps track of which floor the player is on so that reports
+// about enemies elsewhere can be rejected.
+func (s *Server) handleFloorChange(c *client.Client, data []byte) {
+	var cmd packets.FloorChangeCommand
+	if !decodeGameCommand(data, &cmd) {
+		return
+	}
+	if g := s.gameOf(c); g != nil {
+		if clientID, ok := g.clientID(c); ok {
+			g.setFloor(clientID, int(cmd.Floor))
+		}
+	}
+}
+
+// handleEnemyHit records that the player hit an enemy so that they're given a
+// share of its experience when it dies.
+func (s *Server) handleEnemyHit(c *client.Client, data []byte) {
+	var cmd packets.EnemyHitCommand
+	if !decodeGameCommand(data, &cmd) {
+		return
+	}
+	g := s.gameOf(c)
+	if g == nil || len(g.enemies) == 0 {
+		return
+	}
+	clientID, ok := g.clientID(c)
+	if !ok {
+		return
+	}
+	if err := g.hitEnemy(clientID, int(cmd.EnemyID)); err != nil && err != errEnemyDead {
+		s.Logger.Debugf("[%s] ignoring hit from %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
+// handleEnemyKill validates a player's report that an enemy died and gives
+// experience to everyone who hit it.
+func (s *Server) handleEnemyKill(c *client.Client, data []byte) {
+	var cmd packets.EnemyExperienceRequest
+	if !decodeGameCommand(data, &cmd) {
+		return
+	}
+	g := s.gameOf(c)
+	if g == nil || len(g.enemies) == 0 {
+		return
+	}
+	clientID, ok := g.clientID(c)
+	if !ok {
+		return
+	}
+
+	enemy, hitBy, err := g.killEnemy(clientID, int(cmd.EnemyID))
+	switch {
+	case err == errEnemyDead:
+		// Every player who hit the enemy reports its death, but only the first report counts.
+		return
+	case err != nil:
+		s.Logger.Warnf("[%s] rejected kill report from %s: %v", s.Name, c.IPAddr(), err)
+		return
+	}
+
+	stats := g.battleParams.Stats(int(g.difficulty), enemy.BPIndex)
+	if stats == nil || stats.Experience == 0 {
+		return
+	}
+	players := g.players()
+	for id, hit := range hitBy {
+		if !hit || players[id] == nil || players[id].Character == nil {
+			continue
+		}
+		amount := stats.Experience
+		if id != clientID {
+			amount = amount * assistExperiencePercent / 100
+		}
+		s.giveExperience(g, players[id], id, amount)
+	}
+}
+
+// giveExperience adds amount to the player's experience, levelling them up as
+// needed, and lets everyone in the game know.
+func (s *Server) giveExperience(g *game, c *client.Client, clientID int, amount uint32) {
+	class := int(c.Character.Class)
+	if class < 0 || class >= character.NumCharacterClasses {
+		return
+	}
+	c.Character.Experience += amount
+	g.recordExperience(c)
+
+	s.sendToGame(g, &packets.GiveExperienceCommand{
+		Header:     packets.BBHeader{Type: packets.GameCommandType},
+		Subcommand: packets.SubcommandHeader{Type: subcmdGiveExperience, Size: 2, ClientID: uint16(clientID)},
+		Amount:     amount,
+	})
+
+	level := s.levelTable.Level(class, c.Character.Experience)
+	if level <= int(c.Character.Level) {
+		return
+	}
+	s.levelTable.SetLevel(c.Character, level)
+
+	s.sendToGame(g, &packets.LevelUpCommand{
+		Header:     packets.BBHeader{Type: packets.GameCommandType},
+		Subcommand: packets.SubcommandHeader{Type: subcmdLevelUp, Size: 5, ClientID: uint16(clientID)},
+		ATP:        uint16(c.Character.Atp),
+		MST:        uint16(c.Character.Mst),
+		EVP:        uint16(c.Character.Evp),
+		HP:         uint16(c.Character.Hp),
+		DFP:        uint16(c.Character.Dfp),
+		ATA:        uint16(c.Character.Ata),
+		Level:      c.Character.Level,
+	})
+}
+
+// saveProgress persists the experience and levels the player earned in g. Only
+// those are saved so that changes made elsewhere (e.g. to the info board) are kept.
+// Challenge mode characters are temporary, so nothing is saved for them.
+func (s *Server) saveProgress(ctx context.Context, g *game, c *client.Client) {
+	if g.mode == gameModeChallenge || !g.earnedExperience(c) || c.Character == nil {
+		return
+	}
+	if _, err := s.shipgateClient.UpdateCharacterStats(ctx, &shipgate.UpdateCharacterStatsRequest{
+		AccountId: c.Account.Id,
+		Slot:      c.Character.Slot,
+		Character: c.Character,
+	}); err != nil {
+		s.Logger.Warnf("[%s] error saving progress for %s: %v", s.Name, c.Character.ReadableName, err)
+	}
+}
+
+// sendToGame sends pkt to every player in g.
+func (s *Server) sendToGame(g *game, pkt interface{}) {
+	for _, player := range g.players() {
+		if player == nil {
+			continue
+		}
+		if err := player.Send(pkt); err != nil {
+			s.Logger.Warnf("[%s] error sending to %s: %v", s.Name, player.IPAddr(), err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/maps"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+func TestEnemyKills(t *testing.T) {
+	g := &game{
+		enemies: []maps.Enemy{
+			{Name: "Hildebear", Floor: 1, BPIndex: 0x49},
+			{Name: "Booma", Floor: 1, BPIndex: 0x4B},
+			{Name: "Evil Shark", Floor: 3, BPIndex: 0x4F},
+		},
+		enemyStates: make([]enemyState, 3),
+	}
+	killer, helper, bystander := &client.Client{}, &client.Client{}, &client.Client{}
+	for _, c := range []*client.Client{killer, helper, bystander} {
+		id, _ := g.add(c)
+		g.setFloor(id, 1)
+	}
+
+	if err := g.hitEnemy(1, 0); err != nil {
+		t.Fatalf("unexpected error hitting enemy: %v", err)
+	}
+	_, hitBy, err := g.killEnemy(0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error killing enemy: %v", err)
+	}
+	if want := [maxGamePlayers]bool{true, true, false, false}; hitBy != want {
+		t.Errorf("got players %v, want %v", hitBy, want)
+	}
+
+	if _, _, err := g.killEnemy(1, 0); err != errEnemyDead {
+		t.Errorf("expected errEnemyDead for a second kill report, got %v", err)
+	}
+	if _, _, err := g.killEnemy(0, 2); err == nil {
+		t.Error("expected an error killing an enemy on another floor")
+	}
+	if _, _, err := g.killEnemy(0, 3); err == nil {
+		t.Error("expected an error killing an enemy that doesn't exist")
+	}
+
+	// Players joining in someone else's slot don't inherit their hits.
+	g.hitEnemy(2, 1)
+	g.remove(bystander)
+	g.add(&client.Client{})
+	if g.enemyStates[1].hitBy[2] {
+		t.Error("expected hits to be cleared when a new player takes the slot")
+	}
+}
+
+func TestLoadBattleParams(t *testing.T) {
+	params, err := loadBattleParams()
+	if err != nil {
+		t.Fatalf("error loading battle params: %v", err)
+	}
+	for _, episode := range []int{1, 2, 4} {
+		for _, solo := range []bool{true, false} {
+			if params[battleParamFile(episode, solo)] == nil {
+				t.Errorf("missing battle params for episode %d (solo: %v)", episode, solo)
+			}
+		}
+	}
+
+	// Ultimate Hildebear.
+	if got := params[battleParamFile(1, false)].Stats(3, 0x49).Experience; got != 300 {
+		t.Errorf("got %d experience for Ultimate Hildebear, want 300", got)
+	}
+}
+
+// progressShipgate records the characters saved through the shipgate.
+type progressShipgate struct {
+	shipgate.Shipgate
+	saved []*shipgate.UpdateCharacterStatsRequest
+}
+
+func (sg *progressShipgate) UpdateCharacterStats(_ context.Context, req *shipgate.UpdateCharacterStatsRequest) (*emptypb.Empty, error) {
+	sg.saved = append(sg.saved, req)
+	return &emptypb.Empty{}, nil
+}
+
+func TestSaveProgress(t *testing.T) {
+	sg := &progressShipgate{}
+	s := &Server{Name: "BLOCK01", Logger: zap.NewNop().Sugar(), shipgateClient: sg}
+	c := &client.Client{
+		Account:   &proto.Account{Id: 1},
+		Character: &proto.Character{Slot: 2, Level: 10, Experience: 5000},
+	}
+	g := &game{experienced: make(map[*client.Client]bool)}
+	g.add(c)
+
+	// Nothing is saved for players who didn't gain experience.
+	s.saveProgress(context.Background(), g, c)
+	if len(sg.saved) != 0 {
+		t.Fatalf("expected nothing to be saved, got %v", sg.saved)
+	}
+
+	g.recordExperience(c)
+	s.saveProgress(context.Background(), g, c)
+	if len(sg.saved) != 1 {
+		t.Fatalf("expected the player's progress to be saved once, got %v", sg.saved)
+	}
+	if req := sg.saved[0]; req.AccountId != 1 || req.Slot != 2 || req.Character.Experience != 5000 {
+		t.Errorf("unexpected save request: %v", req)
+	}
+
+	g.mode = gameModeChallenge
+	s.saveProgress(context.Background(), g, c)
+	if len(sg.saved) != 1 {
+		t.Errorf("expected nothing to be saved for challenge mode characters")
+	}
+}
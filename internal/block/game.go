@@ -0,0 +1,444 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/core/maps"
+	"github.com/dcrodman/archon/internal/packets"
+)
+
+// Maximum number of players allowed in one game.
+const maxGamePlayers = 4
+
+// Menu "prefix" OR'd with the game IDs in the game list.
+const gameMenuType = 0x40000000
+
+type gameMode int
+
+const (
+	gameModeNormal gameMode = iota
+	gameModeBattle
+	gameModeChallenge
+	gameModeSolo
+)
+
+func (m gameMode) String() string {
+	switch m {
+	case gameModeBattle:
+		return "battle"
+	case gameModeChallenge:
+		return "challenge"
+	case gameModeSolo:
+		return "solo"
+	default:
+		return "normal"
+	}
+}
+
+// game is a team of players that has left the lobby to play together.
+type game struct {
+	id         int
+	name       string
+	password   string
+	mode       gameMode
+	episode    uint8
+	difficulty uint8
+	sectionID  uint8
+	rareSeed   uint32
+	// Rules the game is played under if it's a battle mode game.
+	battleRules *battleRules
+	// Map variations of each floor and the enemies on them, indexed by enemy ID.
+	variations [32]uint32
+	enemies    []maps.Enemy
+	// Stats of the game's enemies on each difficulty.
+	battleParams *maps.BattleParams
+
+	mu       sync.RWMutex
+	leaderID int
+	// Players are indexed by their client ID within the game.
+	clients [maxGamePlayers]*client.Client
+	// Floor each player is on, indexed by client ID.
+	floors [maxGamePlayers]int
+	// State of each enemy, indexed by enemy ID.
+	enemyStates []enemyState
+	// Players who have gained experience since joining the game.
+	experienced map[*client.Client]bool
+	// Original characters of the players in a challenge mode game.
+	savedCharacters map[*client.Client]*savedCharacter
+	// Players allowed to use items outside of their own range of item IDs, such as
+	// ones they picked up from the floor or were offered in a trade.
+	itemHolders map[itemHolder]bool
+	// Number of items the server has created in the game.
+	createdItems uint32
+	// Challenge stage being played and when it was started, if one has been selected.
+	challengeStage *challengeStage
+	challengeStart time.Time
+}
+
+// add places c in the first open slot in the game and returns its client ID,
+// or false if the game is full.
+func (g *game) add(c *client.Client) (int, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for i, existing := range g.clients {
+		if existing == nil {
+			g.clients[i] = c
+			// Players start on Pioneer 2 and get no credit for what was hit before they joined.
+			g.floors[i] = 0
+			for j := range g.enemyStates {
+				g.enemyStates[j].hitBy[i] = false
+			}
+			// Nor do they hold anything picked up by whoever last had their client ID.
+			for holder := range g.itemHolders {
+				if holder.clientID == i {
+					delete(g.itemHolders, holder)
+				}
+			}
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// remove takes c out of the game and returns whether there are any players
+// left. Leadership passes to the next player if c was the leader.
+func (g *game) remove(c *client.Client) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	empty := true
+	for i, existing := range g.clients {
+		if existing == c {
+			g.clients[i] = nil
+		} else if existing != nil {
+			empty = false
+		}
+	}
+	if g.clients[g.leaderID] == nil {
+		for i, existing := range g.clients {
+			if existing != nil {
+				g.leaderID = i
+				break
+			}
+		}
+	}
+	return !empty
+}
+
+// contains returns whether c is in the game.
+func (g *game) contains(c *client.Client) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, existing := range g.clients {
+		if existing == c {
+			return true
+		}
+	}
+	return false
+}
+
+// clientID returns the client ID of c within the game.
+func (g *game) clientID(c *client.Client) (int, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for i, existing := range g.clients {
+		if existing == c {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// recordExperience notes that c has gained experience in the game.
+func (g *game) recordExperience(c *client.Client) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.experienced[c] = true
+}
+
+// earnedExperience returns whether c has gained experience since joining the game.
+func (g *game) earnedExperience(c *client.Client) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.experienced[c]
+}
+
+// players returns all of the clients currently in the game, indexed by client ID.
+func (g *game) players() [maxGamePlayers]*client.Client {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.clients
+}
+
+func (g *game) leader() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.leaderID
+}
+
+// handleCreateGame creates a game with the options chosen by the player and moves them into it.
+func (s *Server) handleCreateGame(ctx context.Context, c *client.Client, pkt *packets.CreateGame) error {
+	if c.Character == nil {
+		return fmt.Errorf("received create game request from %s before character was loaded", c.IPAddr())
+	}
+
+	g := &game{
+		name:       bytes.ConvertFromUtf16(pkt.Name[:]),
+		mode:       gameModeNormal,
+		password:   bytes.ConvertFromUtf16(pkt.Password[:]),
+		episode:    pkt.Episode,
+		difficulty: pkt.Difficulty,
+		sectionID:  uint8(c.Character.SectionId),
+		rareSeed:   rand.Uint32(),
+
+		experienced:     make(map[*client.Client]bool),
+		savedCharacters: make(map[*client.Client]*savedCharacter),
+	}
+	switch {
+	case pkt.BattleMode != 0:
+		g.mode = gameModeBattle
+		g.battleRules = s.defaultBattleRules
+		if g.battleRules == nil {
+			return s.sendTextMessage(c, "Battle mode is not available on this server.")
+		}
+	case pkt.ChallengeMode != 0:
+		g.mode = gameModeChallenge
+		if len(s.challenge.episodeStages(int(g.episode))) == 0 {
+			return s.sendTextMessage(c, "Challenge mode is not available for this episode.")
+		}
+	case pkt.SoloMode != 0:
+		g.mode = gameModeSolo
+	}
+
+	// Seeding the map from the rare seed keeps the game's layout reproducible.
+	gameMap := s.gameMaps.Generate(episodeNumber(g.episode), rand.New(rand.NewSource(int64(g.rareSeed))))
+	g.variations = gameMap.Variations
+	g.enemies = gameMap.Enemies
+	g.enemyStates = make([]enemyState, len(g.enemies))
+	g.battleParams = s.battleParams[battleParamFile(episodeNumber(g.episode), g.mode == gameModeSolo)]
+	if g.battleParams == nil {
+		return s.sendTextMessage(c, "This episode is not available on this server.")
+	}
+
+	s.gamesMu.Lock()
+	s.nextGameID++
+	g.id = s.nextGameID
+	s.games[g.id] = g
+	s.gamesMu.Unlock()
+
+	s.Logger.Infof("[%s] %s created %s game %s with %d enemies", s.Name, c.Character.ReadableName, g.mode, g.name, len(g.enemies))
+	if err := s.joinGame(ctx, c, g); err != nil {
+		s.removeGame(g)
+		return s.sendTextMessage(c, fmt.Sprintf("Unable to create game: %v", err))
+	}
+	return nil
+}
+
+// joinGame moves the player from their lobby into g if they meet the game's requirements.
+func (s *Server) joinGame(ctx context.Context, c *client.Client, g *game) error {
+	if g.battleRules != nil {
+		if err := g.battleRules.validatePlayer(c); err != nil {
+			return err
+		}
+	}
+
+	clientID, ok := g.add(c)
+	if !ok {
+		return fmt.Errorf("game is full")
+	}
+	if l := s.lobbyOf(c); l != nil {
+		l.remove(c)
+		s.updateLocation(ctx, c, l.id, g.name)
+	}
+	if g.mode == gameModeChallenge {
+		// Everyone starts from scratch in challenge mode, beginning with the first stage.
+		g.startChallenge(c, s.challenge.episodeStages(int(g.episode))[0])
+	}
+
+	if err := s.sendGameJoin(c, g, clientID); err != nil {
+		return err
+	}
+	if g.battleRules != nil {
+		if err := s.sendBattleRules(c, g, clientID); err != nil {
+			return err
+		}
+		return s.sendTextMessage(c, g.battleRules.summary())
+	}
+	return nil
+}
+
+// sendBattleRules sends the rules of a battle mode game to a player joining it.
+func (s *Server) sendBattleRules(c *client.Client, g *game, clientID int) error {
+	pkt := &packets.StartBattleCommand{
+		Header: packets.BBHeader{Type: packets.GameCommandType},
+		Rules:  g.battleRules.packet(),
+	}
+	pkt.Subcommand = packets.SubcommandHeader{
+		Type:     subcmdStartBattle,
+		Size:     uint8((binary.Size(pkt.Subcommand) + binary.Size(pkt.Rules)) / 4),
+		ClientID: uint16(clientID),
+	}
+	return c.Send(pkt)
+}
+
+// sendGameList sends the player the games on the block that they can join.
+func (s *Server) sendGameList(c *client.Client) error {
+	pkt := &packets.GameList{Header: packets.BBHeader{Type: packets.GameListType}}
+	block := packets.GameListEntry{MenuID: gameMenuType}
+	copy(block.Name[:], bytes.ConvertToUtf16(s.Name))
+	pkt.Entries = append(pkt.Entries, block)
+
+	s.gamesMu.RLock()
+	var games []*game
+	for _, g := range s.games {
+		games = append(games, g)
+	}
+	s.gamesMu.RUnlock()
+	sort.Slice(games, func(i, j int) bool { return games[i].id < games[j].id })
+
+	for _, g := range games {
+		entry := packets.GameListEntry{
+			MenuID:        gameMenuType,
+			GameID:        gameMenuType | uint32(g.id),
+			DifficultyTag: 0x22 + g.difficulty,
+			Episode:       0x40 + g.episode,
+		}
+		for _, player := range g.players() {
+			if player != nil {
+				entry.NumPlayers++
+			}
+		}
+		if g.password != "" {
+			entry.Flags |= packets.GameListFlagPassword
+		}
+		switch g.mode {
+		case gameModeBattle:
+			entry.Flags |= packets.GameListFlagBattle
+		case gameModeChallenge:
+			entry.Flags |= packets.GameListFlagChallenge
+		case gameModeSolo:
+			entry.Flags |= packets.GameListFlagSolo
+		}
+		copy(entry.Name[:], bytes.ConvertToUtf16(g.name))
+		pkt.Entries = append(pkt.Entries, entry)
+	}
+	pkt.Header.Flags = uint32(len(games))
+	return c.Send(pkt)
+}
+
+// gamePassword returns the password entered by the player when selecting a game
+// from the game list, or an empty string if there isn't one.
+func gamePassword(data []byte) string {
+	var pkt packets.GameSelection
+	if !decodeGameCommand(data, &pkt) {
+		return ""
+	}
+	return bytes.ConvertFromUtf16(pkt.Password[:])
+}
+
+// handleGameSelection moves the player into the game they picked from the game
+// list if the game allows it and they meet its requirements.
+func (s *Server) handleGameSelection(ctx context.Context, c *client.Client, gameID int, password string) error {
+	if c.Character == nil {
+		return fmt.Errorf("received game selection from %s before character was loaded", c.IPAddr())
+	}
+
+	s.gamesMu.RLock()
+	g := s.games[gameID]
+	s.gamesMu.RUnlock()
+	switch {
+	case s.gameOf(c) != nil:
+		return fmt.Errorf("%s selected a game while already in one", c.IPAddr())
+	case g == nil:
+		return s.sendTextMessage(c, "That game no longer exists.")
+	case g.mode == gameModeSolo:
+		return s.sendTextMessage(c, "Solo games cannot be joined.")
+	case g.password != "" && g.password != password:
+		return s.sendTextMessage(c, "Incorrect password.")
+	}
+
+	if err := s.joinGame(ctx, c, g); err != nil {
+		return s.sendTextMessage(c, fmt.Sprintf("Unable to join game: %v", err))
+	}
+	return nil
+}
+
+// leaveGame removes the player from whichever game they're in, saving their
+// progress and cleaning up the game if they were the last one in it.
+func (s *Server) leaveGame(ctx context.Context, c *client.Client) {
+	if g := s.gameOf(c); g != nil {
+		s.saveProgress(ctx, g, c)
+		g.endChallenge(c)
+		if !g.remove(c) {
+			s.removeGame(g)
+		}
+	}
+}
+
+func (s *Server) removeGame(g *game) {
+	s.gamesMu.Lock()
+	defer s.gamesMu.Unlock()
+	delete(s.games, g.id)
+}
+
+// gameOf returns the game the client is in or nil if they are not in one.
+func (s *Server) gameOf(c *client.Client) *game {
+	s.gamesMu.RLock()
+	defer s.gamesMu.RUnlock()
+
+	for _, g := range s.games {
+		if g.contains(c) {
+			return g
+		}
+	}
+	return nil
+}
+
+func (s *Server) sendGameJoin(c *client.Client, g *game, clientID int) error {
+	pkt := &packets.GameJoin{
+		Header:     packets.BBHeader{Type: packets.GameJoinType},
+		Variations: g.variations,
+		ClientID:   uint8(clientID),
+		LeaderID:   uint8(g.leader()),
+		DisableUDP: 1,
+		Difficulty: g.difficulty,
+		Event:      uint8(s.lobbyEvent()),
+		SectionID:  g.sectionID,
+		RareSeed:   g.rareSeed,
+		Episode:    g.episode,
+		Unused:     1,
+	}
+	switch g.mode {
+	case gameModeBattle:
+		pkt.BattleMode = 1
+	case gameModeChallenge:
+		pkt.ChallengeMode = 1
+	case gameModeSolo:
+		pkt.SoloMode = 1
+	}
+
+	for i, player := range g.players() {
+		if player == nil || player.Character == nil {
+			continue
+		}
+		pkt.Players[i] = packets.GamePlayer{
+			PlayerTag: 0x00010000,
+			Guildcard: player.Guildcard,
+			ClientID:  uint32(i),
+			HideHelp:  1,
+		}
+		copy(pkt.Players[i].Name[:], player.Character.Name)
+	}
+	return c.Send(pkt)
+}
@@ -0,0 +1,93 @@
+package block
+
+import (
+	"sync"
+
+	"github.com/dcrodman/archon/internal/core/client"
+)
+
+// Maximum number of players the client allows in one lobby.
+const maxLobbyPlayers = 12
+
+// lobby is one of the chat lobbies on a block. Players are placed into a
+// lobby once their character data has been loaded.
+type lobby struct {
+	id int
+
+	mu sync.RWMutex
+	// Players are indexed by their client ID within the lobby.
+	clients [maxLobbyPlayers]*client.Client
+}
+
+func newLobby(id int) *lobby {
+	return &lobby{id: id}
+}
+
+// add places c in the first open slot in the lobby and returns its client ID,
+// or false if the lobby is full. Clients already in the lobby keep their slot.
+func (l *lobby) add(c *client.Client) (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, existing := range l.clients {
+		if existing == c {
+			return i, true
+		}
+	}
+	for i, existing := range l.clients {
+		if existing == nil {
+			l.clients[i] = c
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// remove takes c out of the lobby (no-op if they aren't in it).
+func (l *lobby) remove(c *client.Client) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, existing := range l.clients {
+		if existing == c {
+			l.clients[i] = nil
+		}
+	}
+}
+
+// contains returns whether c is in the lobby.
+func (l *lobby) contains(c *client.Client) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, existing := range l.clients {
+		if existing == c {
+			return true
+		}
+	}
+	return false
+}
+
+// slots returns the clients in the lobby indexed by their client ID, with
+// nil for any open slots.
+func (l *lobby) slots() []*client.Client {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	slots := l.clients
+	return slots[:]
+}
+
+// players returns all of the clients currently in the lobby.
+func (l *lobby) players() []*client.Client {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var players []*client.Client
+	for _, c := range l.clients {
+		if c != nil {
+			players = append(players, c)
+		}
+	}
+	return players
+}
@@ -0,0 +1,212 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/packets"
+)
+
+// lobbyEvent is one of the seasonal decorations the client can display in the lobbies.
+type lobbyEvent uint16
+
+const (
+	eventNone        lobbyEvent = 0x00
+	eventChristmas   lobbyEvent = 0x01
+	eventValentines  lobbyEvent = 0x03
+	eventEaster      lobbyEvent = 0x04
+	eventHalloween   lobbyEvent = 0x05
+	eventSonic       lobbyEvent = 0x06
+	eventNewYear     lobbyEvent = 0x07
+	eventSummer      lobbyEvent = 0x08
+	eventWhiteDay    lobbyEvent = 0x09
+	eventWedding     lobbyEvent = 0x0A
+	eventAutumn      lobbyEvent = 0x0B
+	eventSpringFlags lobbyEvent = 0x0C
+	eventSummerFlags lobbyEvent = 0x0D
+	eventSpring      lobbyEvent = 0x0E
+)
+
+var lobbyEventNames = map[string]lobbyEvent{
+	"none":         eventNone,
+	"christmas":    eventChristmas,
+	"valentines":   eventValentines,
+	"easter":       eventEaster,
+	"halloween":    eventHalloween,
+	"sonic":        eventSonic,
+	"newyear":      eventNewYear,
+	"summer":       eventSummer,
+	"whiteday":     eventWhiteDay,
+	"wedding":      eventWedding,
+	"autumn":       eventAutumn,
+	"spring_flags": eventSpringFlags,
+	"summer_flags": eventSummerFlags,
+	"spring":       eventSpring,
+}
+
+func parseLobbyEvent(name string) (lobbyEvent, error) {
+	if name == "" {
+		return eventNone, nil
+	}
+	event, ok := lobbyEventNames[strings.ToLower(name)]
+	if !ok {
+		return eventNone, fmt.Errorf("unknown lobby event: %s", name)
+	}
+	return event, nil
+}
+
+func (e lobbyEvent) String() string {
+	for name, event := range lobbyEventNames {
+		if event == e {
+			return name
+		}
+	}
+	return fmt.Sprintf("event %d", uint16(e))
+}
+
+// How often the block checks whether the calendar has switched to a different event.
+const eventCheckInterval = time.Minute
+
+type calendarEntry struct {
+	event lobbyEvent
+	// Dates encoded as month*100 + day so that they can be compared directly.
+	start, end int
+}
+
+// contains returns whether date (in the same encoding) falls within the entry. Entries
+// whose end comes before their start wrap around the end of the year.
+func (e calendarEntry) contains(date int) bool {
+	if e.start <= e.end {
+		return date >= e.start && date <= e.end
+	}
+	return date >= e.start || date <= e.end
+}
+
+// eventSchedule determines which lobby event a block should display on a given date.
+type eventSchedule struct {
+	defaultEvent lobbyEvent
+	calendar     []calendarEntry
+}
+
+// newEventSchedule builds the schedule for a block from the configured
+// default event, per-block overrides, and calendar.
+func newEventSchedule(cfg *core.Config, blockID int) (*eventSchedule, error) {
+	eventsCfg := cfg.BlockServer.LobbyEvents
+
+	defaultName := eventsCfg.Default
+	for _, blockCfg := range eventsCfg.Blocks {
+		if blockCfg.Block == blockID {
+			defaultName = blockCfg.Event
+		}
+	}
+	defaultEvent, err := parseLobbyEvent(defaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &eventSchedule{defaultEvent: defaultEvent}
+	for _, entryCfg := range eventsCfg.Calendar {
+		event, err := parseLobbyEvent(entryCfg.Event)
+		if err != nil {
+			return nil, err
+		}
+		start, err := parseCalendarDate(entryCfg.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date for %s: %w", entryCfg.Event, err)
+		}
+		end, err := parseCalendarDate(entryCfg.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end date for %s: %w", entryCfg.Event, err)
+		}
+		schedule.calendar = append(schedule.calendar, calendarEntry{event: event, start: start, end: end})
+	}
+	return schedule, nil
+}
+
+// parseCalendarDate parses a date in MM-DD format.
+func parseCalendarDate(date string) (int, error) {
+	t, err := time.Parse("01-02", date)
+	if err != nil {
+		return 0, err
+	}
+	return int(t.Month())*100 + t.Day(), nil
+}
+
+// at returns the event for the date of t.
+func (s *eventSchedule) at(t time.Time) lobbyEvent {
+	date := int(t.Month())*100 + t.Day()
+	for _, entry := range s.calendar {
+		if entry.contains(date) {
+			return entry.event
+		}
+	}
+	return s.defaultEvent
+}
+
+// lobbyEvent returns the event currently being displayed on the block.
+func (s *Server) lobbyEvent() lobbyEvent {
+	s.eventMu.Lock()
+	defer s.eventMu.Unlock()
+	return s.currentEvent
+}
+
+// setLobbyEventOverride forces the block to display event until the override is
+// cleared with a nil event, after which the schedule takes over again.
+func (s *Server) setLobbyEventOverride(event *lobbyEvent) {
+	s.eventMu.Lock()
+	s.eventOverride = event
+	s.eventMu.Unlock()
+
+	s.refreshLobbyEvent()
+}
+
+// refreshLobbyEvent recalculates the block's event and notifies every player in
+// the lobbies if it has changed.
+func (s *Server) refreshLobbyEvent() {
+	s.eventMu.Lock()
+	event := s.eventSchedule.at(time.Now())
+	if s.eventOverride != nil {
+		event = *s.eventOverride
+	}
+	changed := event != s.currentEvent
+	s.currentEvent = event
+	s.eventMu.Unlock()
+
+	if !changed {
+		return
+	}
+	s.Logger.Infof("[%s] lobby event changed to %s", s.Name, event)
+	for _, l := range s.lobbies {
+		for _, player := range l.players() {
+			if err := s.sendLobbyEvent(player, event); err != nil {
+				s.Logger.Warnf("[%s] error sending lobby event to %s: %v", s.Name, player.IPAddr(), err)
+			}
+		}
+	}
+}
+
+// watchLobbyEvents switches the block's event as the calendar changes until ctx is cancelled.
+func (s *Server) watchLobbyEvents(ctx context.Context) {
+	ticker := time.NewTicker(eventCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refreshLobbyEvent()
+		}
+	}
+}
+
+func (s *Server) sendLobbyEvent(c *client.Client, event lobbyEvent) error {
+	return c.Send(&packets.BBHeader{
+		Type:  packets.LobbyEventType,
+		Flags: uint32(event),
+	})
+}
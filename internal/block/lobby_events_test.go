@@ -0,0 +1,56 @@
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dcrodman/archon/internal/core"
+)
+
+func TestEventSchedule(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.BlockServer.LobbyEvents.Default = "none"
+	cfg.BlockServer.LobbyEvents.Blocks = []core.BlockEventConfig{{Block: 2, Event: "sonic"}}
+	cfg.BlockServer.LobbyEvents.Calendar = []core.EventCalendarEntry{
+		{Event: "halloween", Start: "10-24", End: "10-31"},
+		{Event: "newyear", Start: "12-31", End: "01-02"},
+	}
+
+	tests := []struct {
+		name    string
+		blockID int
+		date    time.Time
+		want    lobbyEvent
+	}{
+		{name: "default event", blockID: 1, date: time.Date(2022, 6, 1, 0, 0, 0, 0, time.Local), want: eventNone},
+		{name: "block default event", blockID: 2, date: time.Date(2022, 6, 1, 0, 0, 0, 0, time.Local), want: eventSonic},
+		{name: "calendar event", blockID: 2, date: time.Date(2022, 10, 31, 23, 0, 0, 0, time.Local), want: eventHalloween},
+		{name: "calendar event wrapping the year", blockID: 1, date: time.Date(2023, 1, 2, 0, 0, 0, 0, time.Local), want: eventNewYear},
+		{name: "after calendar event", blockID: 1, date: time.Date(2023, 1, 3, 0, 0, 0, 0, time.Local), want: eventNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := newEventSchedule(cfg, tt.blockID)
+			if err != nil {
+				t.Fatalf("error creating schedule: %v", err)
+			}
+			if got := schedule.at(tt.date); got != tt.want {
+				t.Errorf("at() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventSchedule_InvalidConfig(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.BlockServer.LobbyEvents.Calendar = []core.EventCalendarEntry{{Event: "halloween", Start: "13-01", End: "10-31"}}
+	if _, err := newEventSchedule(cfg, 1); err == nil {
+		t.Errorf("expected an error for an invalid date")
+	}
+
+	cfg.BlockServer.LobbyEvents.Default = "birthday"
+	cfg.BlockServer.LobbyEvents.Calendar = nil
+	if _, err := newEventSchedule(cfg, 1); err == nil {
+		t.Errorf("expected an error for an unknown event")
+	}
+}
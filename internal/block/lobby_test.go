@@ -0,0 +1,53 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/dcrodman/archon/internal/core/client"
+)
+
+func TestLobby_Add(t *testing.T) {
+	l := newLobby(0)
+	first, second := &client.Client{}, &client.Client{}
+
+	if id, ok := l.add(first); !ok || id != 0 {
+		t.Fatalf("expected first client to get slot 0, got %d (%v)", id, ok)
+	}
+	if id, ok := l.add(second); !ok || id != 1 {
+		t.Fatalf("expected second client to get slot 1, got %d (%v)", id, ok)
+	}
+	// Adding a client again keeps them in the slot they already have.
+	if id, ok := l.add(first); !ok || id != 0 {
+		t.Errorf("expected first client to keep slot 0, got %d (%v)", id, ok)
+	}
+	if got := len(l.players()); got != 2 {
+		t.Errorf("expected 2 players in the lobby, got %d", got)
+	}
+}
+
+func TestJoinLobby_Rejoin(t *testing.T) {
+	s := &Server{warpLobbies: make(map[*client.Client]int)}
+	for i := 0; i < 3; i++ {
+		s.lobbies = append(s.lobbies, newLobby(i))
+	}
+
+	c := &client.Client{}
+	s.warpLobbies[c] = 1
+	if l, _, err := s.joinLobby(c); err != nil || l.id != 1 {
+		t.Fatalf("expected to join lobby 1, got %v (error: %v)", l, err)
+	}
+
+	// Sending character data again moves the player rather than giving them a second slot.
+	if l, _, err := s.joinLobby(c); err != nil || l.id != 0 {
+		t.Fatalf("expected to join lobby 0, got %v (error: %v)", l, err)
+	}
+	for _, l := range s.lobbies {
+		want := 0
+		if l.id == 0 {
+			want = 1
+		}
+		if got := len(l.players()); got != want {
+			t.Errorf("expected %d players in lobby %d, got %d", want, l.id, got)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package block
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/dcrodman/archon/internal/core/maps"
+)
+
+// loadMaps reads the map layouts from the file configured for the block server and
+// parses the enemy files they refer to. Servers without a maps file generate games
+// without any enemies.
+func (s *Server) loadMaps() (*maps.Maps, error) {
+	var mapsFile struct {
+		// Directory (relative to the config directory) containing the map data files.
+		Directory string                `mapstructure:"directory"`
+		Episodes  []*maps.EpisodeLayout `mapstructure:"episodes"`
+	}
+
+	path := s.Config.QualifiedPath(s.Config.BlockServer.MapsFile)
+	if _, err := os.Stat(path); s.Config.BlockServer.MapsFile != "" && !os.IsNotExist(err) {
+		v := viper.New()
+		v.SetConfigFile(path)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("error reading map layouts from %s: %w", path, err)
+		}
+		if err := v.Unmarshal(&mapsFile); err != nil {
+			return nil, fmt.Errorf("error parsing map layouts from %s: %w", path, err)
+		}
+	}
+
+	dir := s.Config.QualifiedPath(mapsFile.Directory)
+	gameMaps, missing, err := maps.Load(dir, mapsFile.Episodes)
+	if err != nil {
+		return nil, fmt.Errorf("error loading maps: %w", err)
+	}
+	if len(missing) > 0 {
+		s.Logger.Warnf("[%s] %d map enemy files are missing from %s; games will not have enemies on those floors",
+			s.Name, len(missing), dir)
+	}
+	return gameMaps, nil
+}
+
+// episodeNumber converts the episode value used by the client (where Episode 4
+// is 3) into the actual episode number.
+func episodeNumber(episode uint8) int {
+	if episode == 3 {
+		return 4
+	}
+	return int(episode)
+}
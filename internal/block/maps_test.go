@@ -0,0 +1,36 @@
+package block
+
+import (
+	"math/rand"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/dcrodman/archon/internal/core"
+)
+
+func TestLoadMaps(t *testing.T) {
+	cfg := &core.Config{BaseDir: "../../server"}
+	cfg.BlockServer.MapsFile = "maps.yaml"
+	s := &Server{Name: "BLOCK", Config: cfg, Logger: zap.NewNop().Sugar()}
+
+	gameMaps, err := s.loadMaps()
+	if err != nil {
+		t.Fatalf("error loading maps: %v", err)
+	}
+
+	// Enemy files aren't distributed with the server, but the variations are still chosen.
+	for _, episode := range []uint8{1, 2, 3} {
+		gm := gameMaps.Generate(episodeNumber(episode), rand.New(rand.NewSource(1)))
+		if len(gm.Enemies) != 0 {
+			t.Errorf("episode %d: expected no enemies without map files, got %d", episodeNumber(episode), len(gm.Enemies))
+		}
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		gm := gameMaps.Generate(1, rand.New(rand.NewSource(seed)))
+		// Forest 1 only varies its second variation.
+		if gm.Variations[2] != 0 || gm.Variations[3] > 4 {
+			t.Errorf("invalid Forest 1 variation: %d %d", gm.Variations[2], gm.Variations[3])
+		}
+	}
+}
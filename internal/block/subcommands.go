@@ -0,0 +1,107 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/packets"
+)
+
+// Subcommands within game commands that the server acts on. Everything else
+// is only relayed to the other players.
+const (
+	subcmdEnemyHit           = 0x0A
+	subcmdFloorChange        = 0x1F
+	subcmdFloorWarp          = 0x21
+	subcmdChallengeRecords   = 0x7C
+	subcmdEnemyExperienceReq = 0xC8
+)
+
+// Subcommands sent by the server.
+const (
+	subcmdLevelUp        = 0x30
+	subcmdCreateItem     = 0xBE
+	subcmdGiveExperience = 0xBF
+	subcmdStartBattle    = 0xCF
+)
+
+// handleGameCommand processes a game command sent by the client and relays it
+// to the other players in their lobby or game.
+func (s *Server) handleGameCommand(ctx context.Context, c *client.Client, header *packets.BBHeader, data []byte) error {
+	if len(data) < packets.BBHeaderSize+4 {
+		return nil
+	}
+	var subcommand packets.SubcommandHeader
+	bytes.StructFromBytes(data[packets.BBHeaderSize:packets.BBHeaderSize+4], &subcommand)
+	if !s.validateGameCommand(ctx, c, header, &subcommand, data) {
+		return nil
+	}
+
+	var err error
+	switch subcommand.Type {
+	case subcmdEnemyHit:
+		s.handleEnemyHit(c, data)
+	case subcmdFloorChange, subcmdFloorWarp:
+		s.handleFloorChange(c, data)
+	case subcmdChallengeRecords:
+		err = s.handleChallengeRecords(ctx, c, data)
+	case subcmdItemPickUp:
+		s.handleItemPickUp(c, data)
+	case subcmdEnemyExperienceReq:
+		// Experience is handed out by the server, so the other players don't need to see these.
+		s.handleEnemyKill(c, data)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.relayGameCommand(c, header, data)
+	return nil
+}
+
+// decodeGameCommand fills cmd with the contents of a game command, returning
+// false if the command is too short to contain it.
+func decodeGameCommand(data []byte, cmd interface{}) bool {
+	if len(data) < binary.Size(cmd) {
+		return false
+	}
+	bytes.StructFromBytes(data, cmd)
+	return true
+}
+
+// relayGameCommand sends a game command to the other players in the client's lobby
+// or game, or only to the targeted player for the targeted variants.
+func (s *Server) relayGameCommand(c *client.Client, header *packets.BBHeader, data []byte) {
+	targeted := header.Type == packets.GameCommandTargetType || header.Type == packets.GameCommandLargeTargetType
+	pkt := &packets.GameCommand{Header: *header, Data: data[packets.BBHeaderSize:]}
+
+	for clientID, player := range s.peers(c) {
+		if player == nil || (targeted && uint32(clientID) != header.Flags) {
+			continue
+		}
+		if err := player.Send(pkt); err != nil {
+			s.Logger.Warnf("[%s] error relaying game command to %s: %v", s.Name, player.IPAddr(), err)
+		}
+	}
+}
+
+// peers returns the other players in the client's game or lobby, indexed by client ID.
+func (s *Server) peers(c *client.Client) []*client.Client {
+	var players []*client.Client
+	if g := s.gameOf(c); g != nil {
+		gamePlayers := g.players()
+		players = gamePlayers[:]
+	} else if l := s.lobbyOf(c); l != nil {
+		players = l.slots()
+	}
+
+	for i, player := range players {
+		if player == c {
+			players[i] = nil
+		}
+	}
+	return players
+}
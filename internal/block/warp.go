@@ -0,0 +1,187 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/packets"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// Menu ID of the lobbies in the lobby list.
+const lobbyMenuID = 0x001A0001
+
+// address returns the address clients use to connect to this block.
+func (s *Server) address() string {
+	return s.Config.BlockAddress(s.ID)
+}
+
+// warpPlayer sends the player to a lobby on the block at blockAddress. Players
+// sent to another block are redirected there and admitted directly into the
+// lobby, without going back through the ship.
+func (s *Server) warpPlayer(ctx context.Context, c *client.Client, blockAddress string, lobbyID int) error {
+	if blockAddress == s.address() {
+		return s.changeLobby(ctx, c, lobbyID)
+	}
+
+	redirect, err := redirectTo(blockAddress)
+	if err != nil {
+		return err
+	}
+	if err := s.scheduleWarp(ctx, c, blockAddress, lobbyID); err != nil {
+		return err
+	}
+	s.leaveGame(ctx, c)
+	return c.Send(redirect)
+}
+
+// scheduleWarp has the shipgate hold onto the lobby the player is being sent
+// to until they arrive at the block at blockAddress with their session token.
+func (s *Server) scheduleWarp(ctx context.Context, c *client.Client, blockAddress string, lobbyID int) error {
+	if _, err := s.shipgateClient.ScheduleWarp(ctx, &shipgate.WarpRequest{
+		SessionToken: c.Config.SessionToken(),
+		Slot:         c.ActiveSlot,
+		BlockAddress: blockAddress,
+		LobbyId:      uint32(lobbyID),
+	}); err != nil {
+		return fmt.Errorf("error scheduling warp: %w", err)
+	}
+	return nil
+}
+
+// setWarpLobby saves the lobby a warped player is placed in when they enter the block.
+func (s *Server) setWarpLobby(c *client.Client, lobbyID int) {
+	s.warpMu.Lock()
+	defer s.warpMu.Unlock()
+	s.warpLobbies[c] = lobbyID
+}
+
+// takeWarpLobby returns the lobby the player was warped to, if any.
+func (s *Server) takeWarpLobby(c *client.Client) (int, bool) {
+	s.warpMu.Lock()
+	defer s.warpMu.Unlock()
+
+	lobbyID, ok := s.warpLobbies[c]
+	delete(s.warpLobbies, c)
+	return lobbyID, ok
+}
+
+// changeLobby moves the player to another lobby on this block.
+func (s *Server) changeLobby(ctx context.Context, c *client.Client, lobbyID int) error {
+	if lobbyID < 0 || lobbyID >= len(s.lobbies) {
+		return fmt.Errorf("lobby %d does not exist", lobbyID+1)
+	}
+	s.leaveGame(ctx, c)
+	if l := s.lobbyOf(c); l != nil {
+		l.remove(c)
+	}
+
+	l := s.lobbies[lobbyID]
+	clientID, ok := l.add(c)
+	if !ok {
+		return fmt.Errorf("lobby %d is full", lobbyID+1)
+	}
+	s.updateLocation(ctx, c, l.id, "")
+	return s.sendPacket67(c, l, clientID)
+}
+
+// updateLocation tells the shipgate where the player is so that others can find them.
+func (s *Server) updateLocation(ctx context.Context, c *client.Client, lobbyID int, gameName string) {
+	if c.Account == nil || c.Character == nil {
+		return
+	}
+	if _, err := s.shipgateClient.SetPlayerLocation(ctx, &shipgate.PlayerLocation{
+		AccountId:     c.Account.Id,
+		Guildcard:     c.Guildcard,
+		CharacterName: c.Character.ReadableName,
+		Ship:          s.Config.ShipServer.Name,
+		Block:         s.Name,
+		BlockAddress:  s.address(),
+		LobbyId:       uint32(lobbyID),
+		Game:          gameName,
+	}); err != nil {
+		s.Logger.Warnf("[%s] error updating location of %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
+// clearLocation tells the shipgate that the player has left the block.
+func (s *Server) clearLocation(ctx context.Context, c *client.Client) {
+	if c.Account == nil {
+		return
+	}
+	if _, err := s.shipgateClient.ClearPlayerLocation(ctx, &shipgate.ClearPlayerLocationRequest{
+		AccountId:    c.Account.Id,
+		BlockAddress: s.address(),
+	}); err != nil {
+		s.Logger.Warnf("[%s] error clearing location of %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
+// handleGuildcardSearch tells the player where the player they searched for is.
+// A warp is scheduled in case the player chooses to meet them.
+func (s *Server) handleGuildcardSearch(ctx context.Context, c *client.Client, pkt *packets.GuildcardSearch) error {
+	resp, err := s.shipgateClient.FindPlayer(ctx, &shipgate.FindPlayerRequest{Guildcard: pkt.TargetGuildcard})
+	if err != nil {
+		return fmt.Errorf("error searching for guildcard %d: %w", pkt.TargetGuildcard, err)
+	}
+	if !resp.Found {
+		// The client tells the player nobody was found if it doesn't get a result.
+		return nil
+	}
+
+	location := resp.Location
+	redirect, err := redirectTo(location.BlockAddress)
+	if err != nil {
+		return err
+	}
+	if err := s.scheduleWarp(ctx, c, location.BlockAddress, int(location.LobbyId)); err != nil {
+		return err
+	}
+
+	result := &packets.GuildcardSearchResult{
+		Header:            packets.BBHeader{Type: packets.GuildcardSearchResultType},
+		PlayerTag:         0x00010000,
+		SearcherGuildcard: c.Guildcard,
+		TargetGuildcard:   pkt.TargetGuildcard,
+		Redirect:          *redirect,
+		MenuID:            lobbyMenuID,
+		LobbyID:           location.LobbyId,
+	}
+	copy(result.Location[:], bytes.ConvertToUtf16(describeLocation(location)))
+	copy(result.Name[:], bytes.ConvertToUtf16(location.CharacterName))
+	return c.Send(result)
+}
+
+// describeLocation returns the location text displayed in guildcard search results.
+func describeLocation(location *shipgate.PlayerLocation) string {
+	place := fmt.Sprintf("Lobby %d", location.LobbyId+1)
+	if location.Game != "" {
+		place = location.Game
+	}
+	return fmt.Sprintf("%s,%s,%s", place, location.Block, location.Ship)
+}
+
+// redirectTo returns a redirect packet that sends the client to address (host:port).
+func redirectTo(address string) (*packets.Redirect, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing block address %s: %w", address, err)
+	}
+	ip := net.ParseIP(host).To4()
+	port, err := strconv.Atoi(portStr)
+	if ip == nil || err != nil {
+		return nil, fmt.Errorf("invalid block address: %s", address)
+	}
+
+	redirect := &packets.Redirect{
+		// The size is filled in explicitly since redirects can be embedded in other packets.
+		Header: packets.BBHeader{Type: packets.RedirectType, Size: 0x10},
+		Port:   uint16(port),
+	}
+	copy(redirect.IPAddr[:], ip)
+	return redirect, nil
+}
@@ -0,0 +1,55 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/dcrodman/archon/internal/core/client"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+func TestRedirectTo(t *testing.T) {
+	redirect, err := redirectTo("192.168.1.5:15002")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if redirect.IPAddr != [4]uint8{192, 168, 1, 5} || redirect.Port != 15002 {
+		t.Errorf("got redirect to %v:%d, want 192.168.1.5:15002", redirect.IPAddr, redirect.Port)
+	}
+
+	for _, address := range []string{"192.168.1.5", "localhost:15002", "192.168.1.5:port"} {
+		if _, err := redirectTo(address); err == nil {
+			t.Errorf("expected an error for address %s", address)
+		}
+	}
+}
+
+func TestDescribeLocation(t *testing.T) {
+	location := &shipgate.PlayerLocation{Ship: "Archon", Block: "BLOCK01", LobbyId: 2}
+	if got, want := describeLocation(location), "Lobby 3,BLOCK01,Archon"; got != want {
+		t.Errorf("got location %q, want %q", got, want)
+	}
+
+	location.Game = "fun times"
+	if got, want := describeLocation(location), "fun times,BLOCK01,Archon"; got != want {
+		t.Errorf("got location %q, want %q", got, want)
+	}
+}
+
+func TestJoinLobby_Warp(t *testing.T) {
+	s := &Server{warpLobbies: make(map[*client.Client]int)}
+	for i := 0; i < 3; i++ {
+		s.lobbies = append(s.lobbies, newLobby(i))
+	}
+
+	c := &client.Client{}
+	s.warpLobbies[c] = 2
+	if l, _, err := s.joinLobby(c); err != nil || l.id != 2 {
+		t.Fatalf("expected to join lobby 2, got %v (error: %v)", l, err)
+	}
+
+	// The warp lobby is only used once.
+	s.lobbies[2].remove(c)
+	if l, _, err := s.joinLobby(c); err != nil || l.id != 0 {
+		t.Errorf("expected to join lobby 0, got %v (error: %v)", l, err)
+	}
+}
@@ -68,6 +68,8 @@ type Server struct {
 	numParameterFiles int
 	kvCache           *Cache
 	shipgateClient    shipgate.Shipgate
+	// Clients that have logged in, so that they can be kicked.
+	sessions *client.Registry
 }
 
 func (s *Server) Identifier() string {
@@ -76,15 +78,71 @@ func (s *Server) Identifier() string {
 
 func (s *Server) Init(ctx context.Context) error {
 	s.kvCache = NewCache()
-	s.shipgateClient = shipgate.NewRPCClient(s.Config)
-
 	var err error
+	if s.shipgateClient, err = shipgate.NewRPCClient(s.Config); err != nil {
+		return fmt.Errorf("error creating shipgate client: %w", err)
+	}
 	if s.numParameterFiles, err = initParameterData(s.Logger); err != nil {
 		return err
 	}
+	s.sessions = client.NewRegistry()
+
+	go shipgate.WatchEvents(ctx, s.shipgateClient, s.Logger, s.Name, s.handleEvent)
 	return nil
 }
 
+// handleEvent disconnects players kicked through the shipgate, which is the
+// only event the CHARACTER server has any use for.
+func (s *Server) handleEvent(event *shipgate.Event) {
+	if kick := event.GetKick(); kick != nil && s.KickPlayer(kick.AccountId, kick.Message) {
+		s.Logger.Infof("[%s] kicked account %d", s.Name, kick.AccountId)
+	}
+}
+
+// KickPlayer disconnects the player with the account if they're connected,
+// returning whether they were.
+func (s *Server) KickPlayer(accountID uint64, message string) bool {
+	c := s.sessions.Find(accountID)
+	if c == nil {
+		return false
+	}
+	if err := s.sendMessage(c, message); err != nil {
+		s.Logger.Warnf("[%s] error notifying kicked player %s: %v", s.Name, c.IPAddr(), err)
+	}
+	if err := c.Close(); err != nil {
+		s.Logger.Warnf("[%s] error kicking %s: %v", s.Name, c.IPAddr(), err)
+	}
+	return true
+}
+
+// HandleDisconnect forgets about the client's session.
+func (s *Server) HandleDisconnect(c *client.Client) {
+	if s.sessions.Remove(c) {
+		s.clearPresence(context.Background(), c)
+	}
+}
+
+// setPresence tells the shipgate that the player is on character select so that their
+// characters aren't edited out from under them.
+func (s *Server) setPresence(ctx context.Context, c *client.Client) {
+	if _, err := s.shipgateClient.SetPlayerPresence(ctx, &shipgate.PlayerPresence{
+		AccountId: c.Account.Id,
+		Server:    s.Name,
+	}); err != nil {
+		s.Logger.Warnf("[%s] error setting presence of %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
+// clearPresence tells the shipgate that the player has left.
+func (s *Server) clearPresence(ctx context.Context, c *client.Client) {
+	if _, err := s.shipgateClient.ClearPlayerPresence(ctx, &shipgate.PlayerPresence{
+		AccountId: c.Account.Id,
+		Server:    s.Name,
+	}); err != nil {
+		s.Logger.Warnf("[%s] error clearing presence of %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
 func (s *Server) SetUpClient(c *client.Client) {
 	c.CryptoSession = client.NewBlueBurstCryptoSession()
 	c.DebugTags["server_type"] = "character"
@@ -157,27 +215,26 @@ func (s *Server) Handle(ctx context.Context, c *client.Client, data []byte) erro
 }
 
 func (s *Server) handleLogin(ctx context.Context, c *client.Client, loginPkt *packets.Login) error {
-	username := string(bytes.StripPadding(loginPkt.Username[:]))
-	password := string(bytes.StripPadding(loginPkt.Password[:]))
+	bytes.StructFromBytes(loginPkt.Security[:], &c.Config)
 
-	account, err := s.shipgateClient.AuthenticateAccount(ctx, &shipgate.AuthenticateAccountRequest{
-		Username: username,
-		Password: password,
+	resp, err := s.shipgateClient.VerifySessionToken(ctx, &shipgate.VerifySessionTokenRequest{
+		Token: c.Config.SessionToken(),
 	})
 	if err != nil {
-		switch err {
-		case shipgate.ErrInvalidCredentials:
-			return s.sendSecurity(c, packets.BBLoginErrorPassword)
-		case shipgate.ErrAccountBanned:
-			return s.sendSecurity(c, packets.BBLoginErrorBanned)
+		var sendErr error
+		switch {
+		case shipgate.IsError(err, shipgate.ErrAccountBanned):
+			sendErr = s.sendSecurity(c, packets.BBLoginErrorBanned)
 		default:
-			sendErr := s.sendMessage(c, cases.Title(language.English).String(err.Error()))
-			if sendErr == nil {
-				return sendErr
-			}
-			return err
+			sendErr = s.sendMessage(c, cases.Title(language.English).String(err.Error()))
+		}
+		if sendErr != nil {
+			s.Logger.Warnf("[%s] error notifying %s of failed login: %v", s.Name, c.IPAddr(), sendErr)
 		}
+		// Players can't do anything without a valid session, so the connection is closed.
+		return fmt.Errorf("error verifying session for %s: %w", c.IPAddr(), err)
 	}
+	account := resp.Account
 
 	if err := s.sendSecurity(c, packets.BBLoginErrorNone); err != nil {
 		return err
@@ -186,6 +243,8 @@ func (s *Server) handleLogin(ctx context.Context, c *client.Client, loginPkt *pa
 	c.Account = account
 	c.TeamID = uint32(account.TeamId)
 	c.Guildcard = uint32(account.Guildcard)
+	s.sessions.Add(c)
+	s.setPresence(ctx, c)
 
 	// At this point, the user has chosen (or created) a character and the
 	// client needs the ship list.
@@ -286,7 +345,7 @@ func (s *Server) sendShipList(ctx context.Context, c *client.Client) error {
 				MenuID: uint16(i + 1),
 				ShipID: uint32(ship.Id),
 			}
-			copy(entry.ShipName[:], bytes.ConvertToUtf16(ship.Name[:]))
+			copy(entry.ShipName[:], bytes.ConvertToUtf16(shipgate.PlayerCountLabel(ship.Name, int(ship.PlayerCount), int(ship.MaxPlayers))))
 			pkt.ShipEntries = append(pkt.ShipEntries, entry)
 		}
 	}
@@ -718,6 +777,12 @@ func (s *Server) handleShipSelection(ctx context.Context, c *client.Client, menu
 	if selectedShip >= uint32(len(shipList.Ships)) {
 		return fmt.Errorf("invalid ship selection: %d", selectedShip)
 	}
+	if shipgate.IsFull(shipList.Ships[selectedShip]) {
+		if err := s.sendMessage(c, fmt.Sprintf("%s is full.", shipList.Ships[selectedShip].Name)); err != nil {
+			return err
+		}
+		return s.sendShipList(ctx, c)
+	}
 
 	ip := net.ParseIP(shipList.Ships[selectedShip].Ip).To4()
 	port, _ := strconv.Atoi(shipList.Ships[selectedShip].Port)
@@ -0,0 +1,104 @@
+package character
+
+import (
+	"fmt"
+
+	"github.com/dcrodman/archon/internal/core/bytes"
+	"github.com/dcrodman/archon/internal/core/proto"
+)
+
+const (
+	// Highest level a character can reach.
+	MaxLevel = 200
+
+	// Offset of the per-level stat increases in the decompressed PlyLevelTbl,
+	// which come after the base stats and a table of offsets to them.
+	levelTableOffset = 0xD8
+	levelUpSize      = 12
+)
+
+// LevelUp contains the stats a character gains upon reaching a level and the
+// total amount of experience needed to get there.
+type LevelUp struct {
+	ATP        uint8
+	MST        uint8
+	EVP        uint8
+	HP         uint8
+	DFP        uint8
+	ATA        uint8
+	LCK        uint8
+	TP         uint8
+	Experience uint32
+}
+
+// LevelTable contains the level progression of every class. Levels are zero-based,
+// matching the character data.
+type LevelTable [NumCharacterClasses][MaxLevel]LevelUp
+
+// LoadLevelTable reads the level progression of each class from PlyLevelTbl.
+func LoadLevelTable() (*LevelTable, error) {
+	data, err := readLevelTableFile()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < levelTableOffset+NumCharacterClasses*MaxLevel*levelUpSize {
+		return nil, fmt.Errorf("PlyLevelTbl is too short to contain the level table")
+	}
+
+	table := &LevelTable{}
+	for class := 0; class < NumCharacterClasses; class++ {
+		for level := 0; level < MaxLevel; level++ {
+			offset := levelTableOffset + (class*MaxLevel+level)*levelUpSize
+			bytes.StructFromBytes(data[offset:offset+levelUpSize], &table[class][level])
+		}
+	}
+	return table, nil
+}
+
+// Level returns the zero-based level of a character of class with experience.
+func (t *LevelTable) Level(class int, experience uint32) int {
+	level := 0
+	for level+1 < MaxLevel && experience >= t[class][level+1].Experience {
+		level++
+	}
+	return level
+}
+
+// SetLevel changes the zero-based level of the character, adding the stats gained
+// (or removing those lost) between its current level and the new one. Stats from
+// anything other than levelling, like materials, are left alone.
+func (t *LevelTable) SetLevel(c *proto.Character, level int) {
+	class := int(c.Class)
+	if class < 0 || class >= NumCharacterClasses || level < 0 || level >= MaxLevel {
+		return
+	}
+	current := int(c.Level)
+	if current >= MaxLevel {
+		current = MaxLevel - 1
+	}
+
+	for l := current + 1; l <= level; l++ {
+		t[class][l].apply(c, 1)
+	}
+	for l := current; l > level; l-- {
+		t[class][l].apply(c, -1)
+	}
+	c.Level = uint32(level)
+}
+
+// apply adds (or with a negative sign, removes) the stats gained at the level.
+func (l *LevelUp) apply(c *proto.Character, sign int) {
+	for _, stat := range []struct {
+		value *uint32
+		gain  uint8
+	}{
+		{&c.Atp, l.ATP}, {&c.Mst, l.MST}, {&c.Evp, l.EVP}, {&c.Hp, l.HP},
+		{&c.Dfp, l.DFP}, {&c.Ata, l.ATA}, {&c.Lck, l.LCK},
+	} {
+		value := int(*stat.value) + sign*int(stat.gain)
+		if value < 0 {
+			value = 0
+		}
+		*stat.value = uint32(value)
+	}
+}
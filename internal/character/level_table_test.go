@@ -0,0 +1,64 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/dcrodman/archon/internal/core/proto"
+)
+
+func TestLoadLevelTable(t *testing.T) {
+	table, err := LoadLevelTable()
+	if err != nil {
+		t.Fatalf("error loading level table: %v", err)
+	}
+
+	if got := table[0][1]; got.Experience != 50 || got.ATP != 7 || got.HP != 3 {
+		t.Errorf("unexpected HUmar level 2 stats: %+v", got)
+	}
+	for _, tt := range []struct {
+		experience uint32
+		want       int
+	}{
+		{experience: 0, want: 0},
+		{experience: 49, want: 0},
+		{experience: 50, want: 1},
+		{experience: 449, want: 2},
+		{experience: 0xFFFFFFFF, want: MaxLevel - 1},
+	} {
+		if got := table.Level(0, tt.experience); got != tt.want {
+			t.Errorf("Level(0, %d) = %d, want %d", tt.experience, got, tt.want)
+		}
+	}
+}
+
+func TestLevelTable_SetLevel(t *testing.T) {
+	table, err := LoadLevelTable()
+	if err != nil {
+		t.Fatalf("error loading level table: %v", err)
+	}
+
+	// HUmar base stats plus a couple of points from materials.
+	base := BaseStats[0]
+	c := &proto.Character{Class: 0, Atp: uint32(base.ATP) + 2, Hp: uint32(base.HP), Mst: uint32(base.MST)}
+
+	table.SetLevel(c, 10)
+	wantATP, wantHP := uint32(base.ATP)+2, uint32(base.HP)
+	for l := 1; l <= 10; l++ {
+		wantATP += uint32(table[0][l].ATP)
+		wantHP += uint32(table[0][l].HP)
+	}
+	if c.Level != 10 || c.Atp != wantATP || c.Hp != wantHP {
+		t.Errorf("got level %d with ATP %d and HP %d, want level 10 with ATP %d and HP %d", c.Level, c.Atp, c.Hp, wantATP, wantHP)
+	}
+
+	// Lowering the level takes away what was gained, but not the materials.
+	table.SetLevel(c, 0)
+	if c.Level != 0 || c.Atp != uint32(base.ATP)+2 || c.Hp != uint32(base.HP) {
+		t.Errorf("got level %d with ATP %d and HP %d after resetting the level", c.Level, c.Atp, c.Hp)
+	}
+
+	table.SetLevel(c, MaxLevel)
+	if c.Level != 0 {
+		t.Errorf("expected levels past the maximum to be ignored, got level %d", c.Level)
+	}
+}
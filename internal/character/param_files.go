@@ -73,23 +73,12 @@ func initParameterData(logger *zap.SugaredLogger) (int, error) {
 		}
 
 		// LoadConfig the base stats for creating new characters.
-		compressedStatsData, err := paramFiles.ReadFile(filepath.Join(parametersDirName, "PlyLevelTbl.prs"))
+		decompressedStatsFile, err := readLevelTableFile()
 		if err != nil {
-			initErr = fmt.Errorf("error loading PlyLevelTbl.prs: %w", err)
+			initErr = err
 			return
 		}
 
-		decompressedSize, err := prs.DecompressSize(compressedStatsData)
-		if err != nil {
-			initErr = fmt.Errorf("error decompressing size of PlyLevelTbl.prs: %w", err)
-			return
-		}
-
-		decompressedStatsFile, err := prs.Decompress(compressedStatsData, decompressedSize)
-		if err != nil {
-			initErr = fmt.Errorf("error decompressing PlyLevelTbl.prs: %w", err)
-		}
-
 		// Base character class stats are stored sequentially, each 14 bytes long.
 		for i := 0; i < NumCharacterClasses; i++ {
 			bytes.StructFromBytes(decompressedStatsFile[i*14:], &BaseStats[i])
@@ -99,6 +88,34 @@ func initParameterData(logger *zap.SugaredLogger) (int, error) {
 	return numFilesLoaded, initErr
 }
 
+// readLevelTableFile returns the decompressed contents of PlyLevelTbl.prs.
+func readLevelTableFile() ([]byte, error) {
+	compressedStatsData, err := paramFiles.ReadFile(filepath.Join(parametersDirName, "PlyLevelTbl.prs"))
+	if err != nil {
+		return nil, fmt.Errorf("error loading PlyLevelTbl.prs: %w", err)
+	}
+
+	decompressedSize, err := prs.DecompressSize(compressedStatsData)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing size of PlyLevelTbl.prs: %w", err)
+	}
+
+	decompressedStatsFile, err := prs.Decompress(compressedStatsData, decompressedSize)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing PlyLevelTbl.prs: %w", err)
+	}
+	return decompressedStatsFile, nil
+}
+
+// ReadParameterFile returns the contents of one of the embedded parameter files.
+func ReadParameterFile(name string) ([]byte, error) {
+	data, err := paramFiles.ReadFile(fmt.Sprintf("%s/%s", parametersDirName, name))
+	if err != nil {
+		return nil, fmt.Errorf("error reading parameter file %s: %w", name, err)
+	}
+	return data, nil
+}
+
 // LoadConfig the PSOBB parameter files, build the parameter header,
 // and init/cache the param file chunks for the EB packets.
 func loadParameterFiles(logger *zap.SugaredLogger) (int, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/url"
 	"sync"
 	"time"
 
@@ -17,6 +18,18 @@ import (
 	"github.com/dcrodman/archon/internal/patch"
 	"github.com/dcrodman/archon/internal/ship"
 	"github.com/dcrodman/archon/internal/shipgate"
+	"github.com/dcrodman/archon/internal/web"
+)
+
+// Component is a group of servers that can be run by a Controller.
+type Component string
+
+const (
+	ComponentShipgate Component = "shipgate"
+	// The PATCH, DATA, LOGIN, and CHARACTER servers.
+	ComponentLogin Component = "login"
+	// The SHIP server and its blocks.
+	ComponentShip Component = "ship"
 )
 
 // Controller is the main entrypoint for archon. It's responsible for initializing
@@ -24,11 +37,14 @@ import (
 // launching everything.
 type Controller struct {
 	Config *core.Config
+	// Components to run in this process. Everything is run if none are set.
+	Components []Component
 
 	logger *zap.SugaredLogger
 	wg     sync.WaitGroup
 
 	shipgateServer *shipgate.Server
+	webServer      *web.Server
 	servers        []*frontend
 }
 
@@ -48,62 +64,103 @@ func (c *Controller) Start(ctx context.Context) {
 		debug.StartPprofServer(c.logger, c.Config.Debugging.PprofPort)
 	}
 
-	// Start the shipgate RPC service and make sure it launches before the other servers start.
-	c.shipgateServer = &shipgate.Server{Config: c.Config, Logger: c.logger}
-	c.shipgateServer.Start(ctx)
+	// Start the shipgate RPC service (and the public API backed by it) if it's run by this process.
+	if c.runs(ComponentShipgate) {
+		levelTable, err := character.LoadLevelTable()
+		if err != nil {
+			c.logger.Errorf("error loading level table: %v", err)
+			return
+		}
+		c.shipgateServer = &shipgate.Server{Config: c.Config, Logger: c.logger, LevelTable: levelTable}
+		c.shipgateServer.Start(ctx)
 
-	shipgateAddr, err := net.ResolveTCPAddr("tcp4", fmt.Sprintf(":%d", c.Config.ShipgateServer.Port))
-	if err != nil {
-		c.logger.Errorf("error resolving shipgate address: %v", err)
+		c.webServer = &web.Server{Config: c.Config, Logger: c.logger}
+		if err := c.webServer.Start(ctx); err != nil {
+			c.logger.Errorf("error starting web server: %v", err)
+		}
+	}
+
+	// Configure, initialize, run all of our servers.
+	c.declareServers()
+	if len(c.servers) == 0 {
+		// Only the shipgate is running, so keep it up until we're told to shut down.
+		<-ctx.Done()
 		return
 	}
+
+	// Make sure the shipgate (local or remote) is up before the other servers start.
+	if err := c.waitForShipgate(ctx); err != nil {
+		c.logger.Error(err)
+		return
+	}
+	c.run(ctx)
+}
+
+// runs returns whether the Controller is running component.
+func (c *Controller) runs(component Component) bool {
+	if len(c.Components) == 0 {
+		return true
+	}
+	for _, running := range c.Components {
+		if running == component {
+			return true
+		}
+	}
+	return false
+}
+
+// waitForShipgate blocks until the shipgate accepts connections.
+func (c *Controller) waitForShipgate(ctx context.Context) error {
+	shipgateURL, err := url.Parse(c.Config.ShipgateAddress())
+	if err != nil {
+		return fmt.Errorf("error parsing shipgate address: %v", err)
+	}
+
 	t := time.NewTimer(30 * time.Second)
+	defer t.Stop()
 	for {
+		conn, err := net.Dial("tcp", dialAddress(shipgateURL))
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+
 		select {
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-t.C:
-			c.logger.Errorf("timed out waiting for shipgate to initialize")
-			return
-		default:
+			return fmt.Errorf("timed out waiting for shipgate at %s", shipgateURL.Host)
+		case <-time.After(time.Second):
 		}
+	}
+}
 
-		conn, err := net.DialTCP("tcp", nil, shipgateAddr)
-		if err == nil {
-			conn.Close()
-			break
+// dialAddress returns the host:port to connect to for u, falling back to the
+// default port of its scheme if it doesn't have one.
+func dialAddress(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
 		}
-		time.Sleep(time.Second)
 	}
-
-	// Configure, initialize, run all of our servers.
-	c.declareServers()
-	c.run(ctx)
+	return net.JoinHostPort(u.Hostname(), port)
 }
 
 // Set up all of the servers we want to run.
 func (c *Controller) declareServers() {
-	// Automatically configure the block servers based on the number of
-	// ship blocks requested.
-	var blocks []ship.Block
-	var blockServers []*frontend
-	for i := 1; i <= c.Config.ShipServer.NumBlocks; i++ {
-		name := fmt.Sprintf("BLOCK%02d", i)
-		address := fmt.Sprintf("%s:%v", c.Config.ExternalIP, c.Config.BlockServer.Port+i)
-
-		blocks = append(blocks, ship.Block{
-			Name: name, Address: address, ID: i,
-		})
-		blockServer := &frontend{
-			Address: address,
-			Backend: &block.Server{
-				Name:   name,
-				Config: c.Config,
-				Logger: c.logger,
-			},
-		}
-		blockServers = append(blockServers, blockServer)
+	if c.runs(ComponentLogin) {
+		c.servers = append(c.servers, c.loginServers()...)
+	}
+	if c.runs(ComponentShip) {
+		c.servers = append(c.servers, c.shipServers()...)
 	}
+}
 
-	c.servers = []*frontend{
+// loginServers returns the servers players go through before picking a ship.
+func (c *Controller) loginServers() []*frontend {
+	return []*frontend{
 		{
 			Address: c.buildAddress(c.Config.PatchServer.PatchPort),
 			Backend: &patch.Server{
@@ -136,20 +193,45 @@ func (c *Controller) declareServers() {
 				Logger: c.logger,
 			},
 		},
-		// Note: Eventually the ship and block servers should be able to be run
-		// independently of the other four servers
-		{
-			Address: c.buildAddress(c.Config.ShipServer.Port),
-			Backend: &ship.Server{
-				Name:   "SHIP",
-				Config: c.Config,
-				Blocks: blocks,
-				Logger: c.logger,
-			},
-		},
 	}
+}
 
-	c.servers = append(c.servers, blockServers...)
+// shipServers returns the SHIP server and its blocks.
+func (c *Controller) shipServers() []*frontend {
+	// Automatically configure the block servers based on the number of
+	// ship blocks requested.
+	var blocks []ship.Block
+	var blockServers []*frontend
+	for i := 1; i <= c.Config.ShipServer.NumBlocks; i++ {
+		name := fmt.Sprintf("BLOCK%02d", i)
+		address := c.Config.BlockAddress(i)
+
+		blockBackend := &block.Server{
+			Name:   name,
+			ID:     i,
+			Config: c.Config,
+			Logger: c.logger,
+		}
+		blocks = append(blocks, ship.Block{
+			Name: name, Address: address, ID: i, Announcer: blockBackend, Players: blockBackend, Manager: blockBackend,
+		})
+		blockServer := &frontend{
+			Address: address,
+			Backend: blockBackend,
+		}
+		blockServers = append(blockServers, blockServer)
+	}
+
+	shipServer := &frontend{
+		Address: c.buildAddress(c.Config.ShipServer.Port),
+		Backend: &ship.Server{
+			Name:   "SHIP",
+			Config: c.Config,
+			Blocks: blocks,
+			Logger: c.logger,
+		},
+	}
+	return append([]*frontend{shipServer}, blockServers...)
 }
 
 func (c *Controller) run(ctx context.Context) {
@@ -180,5 +262,10 @@ func (c *Controller) Shutdown(ctx context.Context) {
 	// Stop the shipgate after all of the other servers have stopped in order to avoid
 	// errors from any shipgate calls during the shutdown process.
 	c.wg.Wait()
-	c.shipgateServer.Shutdown(ctx)
+	if c.webServer != nil {
+		c.webServer.Shutdown(ctx)
+	}
+	if c.shipgateServer != nil {
+		c.shipgateServer.Shutdown(ctx)
+	}
 }
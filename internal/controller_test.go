@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDialAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{address: "http://127.0.0.1:13000", want: "127.0.0.1:13000"},
+		{address: "https://shipgate.example.com:13000", want: "shipgate.example.com:13000"},
+		{address: "https://shipgate.example.com", want: "shipgate.example.com:443"},
+		{address: "http://shipgate.example.com", want: "shipgate.example.com:80"},
+		{address: "https://[::1]", want: "[::1]:443"},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.address)
+		if err != nil {
+			t.Fatalf("error parsing %s: %v", tt.address, err)
+		}
+		if got := dialAddress(u); got != tt.want {
+			t.Errorf("dialAddress(%s) = %s, want %s", tt.address, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package auth defines the roles and permissions that control which accounts are
+// allowed to perform privileged actions (GM commands, account management, etc).
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/proto"
+)
+
+// Permission grants an account access to one type of privileged action.
+type Permission string
+
+const (
+	PermissionKick             Permission = "kick"
+	PermissionBan              Permission = "ban"
+	PermissionAnnounce         Permission = "announce"
+	PermissionLobbyEvent       Permission = "lobby_event"
+	PermissionWarp             Permission = "warp"
+	PermissionSpawnItem        Permission = "spawn_item"
+	PermissionReviewViolations Permission = "review_violations"
+	PermissionManageAccounts   Permission = "manage_accounts"
+	PermissionManageRoles      Permission = "manage_roles"
+)
+
+// Privilege levels of the built-in roles, as stored on each account.
+const (
+	LevelPlayer    byte = 0
+	LevelModerator byte = 1
+	LevelGM        byte = 2
+	LevelAdmin     byte = 3
+)
+
+// Role is a named set of permissions granted to every account with its privilege level.
+type Role struct {
+	Name        string
+	Level       byte
+	Permissions []Permission
+	// All grants every permission, including ones that don't exist yet.
+	All bool
+}
+
+// Can returns whether the role grants permission p.
+func (r *Role) Can(p Permission) bool {
+	if r.All {
+		return true
+	}
+	for _, permission := range r.Permissions {
+		if permission == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Privileged returns whether the role grants any permissions at all.
+func (r *Role) Privileged() bool {
+	return r.All || len(r.Permissions) > 0
+}
+
+var builtinRoles = []*Role{
+	{Name: "player", Level: LevelPlayer},
+	{Name: "moderator", Level: LevelModerator, Permissions: []Permission{PermissionKick, PermissionAnnounce}},
+	{Name: "gm", Level: LevelGM, Permissions: []Permission{PermissionKick, PermissionBan, PermissionAnnounce, PermissionLobbyEvent, PermissionWarp, PermissionSpawnItem, PermissionReviewViolations}},
+	{Name: "admin", Level: LevelAdmin, All: true},
+}
+
+// Roles is the set of built-in and configured roles, indexed by privilege level.
+type Roles struct {
+	// Sorted in ascending order of privilege level.
+	roles []*Role
+}
+
+// NewRoles returns the built-in roles along with any custom roles defined in the
+// config. Custom roles may not reuse the name or level of another role.
+func NewRoles(cfg *core.Config) (*Roles, error) {
+	r := &Roles{roles: append([]*Role{}, builtinRoles...)}
+
+	for _, roleCfg := range cfg.Permissions.Roles {
+		if roleCfg.Level < 0 || roleCfg.Level > 0xFF {
+			return nil, fmt.Errorf("role %s has invalid privilege level %d", roleCfg.Name, roleCfg.Level)
+		}
+		role := &Role{Name: strings.ToLower(roleCfg.Name), Level: byte(roleCfg.Level)}
+		for _, permission := range roleCfg.Permissions {
+			role.Permissions = append(role.Permissions, Permission(strings.ToLower(permission)))
+		}
+
+		for _, existing := range r.roles {
+			if existing.Name == role.Name || existing.Level == role.Level {
+				return nil, fmt.Errorf("role %s conflicts with existing role %s (level %d)", role.Name, existing.Name, existing.Level)
+			}
+		}
+		r.roles = append(r.roles, role)
+	}
+
+	sort.Slice(r.roles, func(i, j int) bool { return r.roles[i].Level < r.roles[j].Level })
+	return r, nil
+}
+
+// ForLevel returns the role with the given privilege level. Levels that don't
+// correspond to a role are treated as regular players.
+func (r *Roles) ForLevel(level byte) *Role {
+	for _, role := range r.roles {
+		if role.Level == level {
+			return role
+		}
+	}
+	return r.roles[0]
+}
+
+// ForAccount returns the role of an account.
+func (r *Roles) ForAccount(account *proto.Account) *Role {
+	return r.ForLevel(AccountLevel(account))
+}
+
+// ByName looks up a role by its name, returning false if no such role exists.
+func (r *Roles) ByName(name string) (*Role, bool) {
+	for _, role := range r.roles {
+		if strings.EqualFold(role.Name, name) {
+			return role, true
+		}
+	}
+	return nil, false
+}
+
+// Names returns the names of all roles in ascending order of privilege level.
+func (r *Roles) Names() []string {
+	var names []string
+	for _, role := range r.roles {
+		names = append(names, role.Name)
+	}
+	return names
+}
+
+// AccountLevel returns the privilege level of an account. Accounts flagged as GMs
+// (which predates privilege levels) are treated as having at least the GM role.
+func AccountLevel(account *proto.Account) byte {
+	if account == nil {
+		return LevelPlayer
+	}
+
+	var level byte
+	if len(account.PrivilegeLevel) > 0 {
+		level = account.PrivilegeLevel[0]
+	}
+	return Level(level, account.Gm)
+}
+
+// Level returns the effective privilege level given an account's stored level and GM flag.
+func Level(privilegeLevel byte, gm bool) byte {
+	if gm && privilegeLevel < LevelGM {
+		return LevelGM
+	}
+	return privilegeLevel
+}
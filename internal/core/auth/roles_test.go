@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/proto"
+)
+
+func TestNewRoles(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.Permissions.Roles = []core.RoleConfig{
+		{Name: "EventMaster", Level: 10, Permissions: []string{"announce"}},
+	}
+
+	roles, err := NewRoles(cfg)
+	if err != nil {
+		t.Fatalf("NewRoles() returned an unexpected error: %v", err)
+	}
+
+	role := roles.ForLevel(10)
+	if role.Name != "eventmaster" {
+		t.Errorf("ForLevel(10) = %s, want eventmaster", role.Name)
+	}
+	if !role.Can(PermissionAnnounce) || role.Can(PermissionKick) {
+		t.Errorf("custom role has the wrong permissions: %v", role.Permissions)
+	}
+
+	if role := roles.ForLevel(42); role.Name != "player" {
+		t.Errorf("ForLevel(42) = %s, want player", role.Name)
+	}
+	if role := roles.ForLevel(LevelAdmin); !role.Can(PermissionManageRoles) {
+		t.Errorf("admin role should be granted every permission")
+	}
+}
+
+func TestNewRoles_Conflicts(t *testing.T) {
+	tests := []struct {
+		name string
+		role core.RoleConfig
+	}{
+		{name: "duplicate name", role: core.RoleConfig{Name: "GM", Level: 10}},
+		{name: "duplicate level", role: core.RoleConfig{Name: "helper", Level: int(LevelModerator)}},
+		{name: "invalid level", role: core.RoleConfig{Name: "helper", Level: 256}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &core.Config{}
+			cfg.Permissions.Roles = []core.RoleConfig{tt.role}
+			if _, err := NewRoles(cfg); err == nil {
+				t.Errorf("NewRoles() expected an error for role %v", tt.role)
+			}
+		})
+	}
+}
+
+func TestAccountLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		account *proto.Account
+		want    byte
+	}{
+		{name: "no account", account: nil, want: LevelPlayer},
+		{name: "no privilege level", account: &proto.Account{}, want: LevelPlayer},
+		{name: "privilege level", account: &proto.Account{PrivilegeLevel: []byte{LevelAdmin}}, want: LevelAdmin},
+		{name: "legacy gm flag", account: &proto.Account{Gm: true}, want: LevelGM},
+		{name: "gm flag with higher level", account: &proto.Account{Gm: true, PrivilegeLevel: []byte{LevelAdmin}}, want: LevelAdmin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AccountLevel(tt.account); got != tt.want {
+				t.Errorf("AccountLevel() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
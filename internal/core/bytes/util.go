@@ -23,6 +23,20 @@ func ConvertToUtf16(str string) []byte {
 	return expanded
 }
 
+// ConvertFromUtf16 converts UTF-16 LE encoded bytes to a UTF-8 string, stopping
+// at the first null character (if any).
+func ConvertFromUtf16(b []byte) string {
+	encoded := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		v := uint16(b[i]) | uint16(b[i+1])<<8
+		if v == 0 {
+			break
+		}
+		encoded = append(encoded, v)
+	}
+	return string(utf16.Decode(encoded))
+}
+
 // StripPadding returns a slice of b without the trailing 0s.
 func StripPadding(b []byte) []byte {
 	for i := len(b) - 1; i >= 0; i-- {
@@ -41,6 +41,46 @@ func TestConvertToUtf16(t *testing.T) {
 	}
 }
 
+func TestConvertFromUtf16(t *testing.T) {
+	type args struct {
+		b []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "empty bytes",
+			args: args{
+				b: []byte{},
+			},
+			want: "",
+		},
+		{
+			name: "arbitrary text",
+			args: args{
+				b: []byte{65, 0, 114, 0, 99, 0, 104, 0, 111, 0, 110, 0},
+			},
+			want: "Archon",
+		},
+		{
+			name: "stops at null character",
+			args: args{
+				b: []byte{65, 0, 114, 0, 0, 0, 104, 0, 0, 0},
+			},
+			want: "Ar",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertFromUtf16(tt.args.b); got != tt.want {
+				t.Errorf("ConvertFromUtf16() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestStripPadding(t *testing.T) {
 	type args struct {
 		b []byte
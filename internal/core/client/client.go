@@ -2,6 +2,7 @@ package client
 
 import (
 	"bufio"
+	"encoding/binary"
 	"fmt"
 	"net"
 	"os"
@@ -10,6 +11,7 @@ import (
 	"github.com/dcrodman/archon/internal/core/bytes"
 	"github.com/dcrodman/archon/internal/core/debug"
 	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/packets"
 )
 
 type ClientConfig struct {
@@ -24,6 +26,36 @@ type ClientConfig struct {
 	Unused2      [2]uint32
 }
 
+// Size of the session tokens carried in the unused portion of the client config.
+const SessionTokenSize = 24
+
+// SessionToken returns the session token issued at LOGIN that the client echoes
+// back in its config, or nil if there isn't one.
+func (cfg *ClientConfig) SessionToken() []byte {
+	token := make([]byte, SessionTokenSize)
+	empty := true
+	for i, v := range append(cfg.Unused[:], cfg.Unused2[:]...) {
+		binary.LittleEndian.PutUint32(token[i*4:], v)
+		empty = empty && v == 0
+	}
+	if empty {
+		return nil
+	}
+	return token
+}
+
+// SetSessionToken places token in the config, clearing any existing token if it's nil.
+func (cfg *ClientConfig) SetSessionToken(token []byte) {
+	padded := make([]byte, SessionTokenSize)
+	copy(padded, token)
+	for i := range cfg.Unused {
+		cfg.Unused[i] = binary.LittleEndian.Uint32(padded[i*4:])
+	}
+	for i := range cfg.Unused2 {
+		cfg.Unused2[i] = binary.LittleEndian.Uint32(padded[len(cfg.Unused)*4+i*4:])
+	}
+}
+
 // Client represents a user connected through a PSOBB game client.
 type Client struct {
 	connection *net.TCPConn
@@ -35,6 +67,10 @@ type Client struct {
 
 	// Account associated with the player.
 	Account *proto.Account
+	// Character the player is currently playing, once one has been selected.
+	Character *proto.Character
+	// Items held by the character, as reported by the client when it enters a block.
+	Inventory []packets.InventoryItem
 
 	// Client information shared amongst most Backend implementations.
 	Config ClientConfig
@@ -211,3 +211,24 @@ func Test_adjustPacketLength(t *testing.T) {
 		})
 	}
 }
+
+func TestClientConfig_SessionToken(t *testing.T) {
+	var cfg ClientConfig
+	if token := cfg.SessionToken(); token != nil {
+		t.Fatalf("expected no token in an empty config, got %v", token)
+	}
+
+	token := make([]byte, SessionTokenSize)
+	for i := range token {
+		token[i] = byte(i + 1)
+	}
+	cfg.SetSessionToken(token)
+	if diff := cmp.Diff(token, cfg.SessionToken()); diff != "" {
+		t.Errorf("SessionToken() returned the wrong token; diff:\n%s", diff)
+	}
+
+	cfg.SetSessionToken(nil)
+	if got := cfg.SessionToken(); got != nil {
+		t.Errorf("expected token to be cleared, got %v", got)
+	}
+}
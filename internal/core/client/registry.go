@@ -0,0 +1,55 @@
+package client
+
+import "sync"
+
+// Registry keeps track of the live clients connected to a server by account ID
+// so that players can be found (e.g. in order to kick them) without having to
+// know where on the server they are.
+type Registry struct {
+	mu      sync.RWMutex
+	clients map[uint64]*Client
+}
+
+func NewRegistry() *Registry {
+	return &Registry{clients: make(map[uint64]*Client)}
+}
+
+// Add registers c under its account, replacing any other client logged in
+// with the same account.
+func (r *Registry) Add(c *Client) {
+	if c.Account == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clients[c.Account.Id] = c
+}
+
+// Remove unregisters c if it's still the client registered for its account and
+// returns whether it was.
+func (r *Registry) Remove(c *Client) bool {
+	if c.Account == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.clients[c.Account.Id] != c {
+		return false
+	}
+	delete(r.clients, c.Account.Id)
+	return true
+}
+
+// Find returns the client logged in with the account, or nil if there isn't one.
+func (r *Registry) Find(accountID uint64) *Client {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.clients[accountID]
+}
+
+// Len returns the number of registered clients.
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.clients)
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/dcrodman/archon/internal/core/proto"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	first := &Client{Account: &proto.Account{Id: 1}}
+	second := &Client{Account: &proto.Account{Id: 1}}
+	other := &Client{Account: &proto.Account{Id: 2}}
+
+	r.Add(first)
+	r.Add(other)
+	r.Add(&Client{})
+	if got := r.Find(1); got != first {
+		t.Errorf("expected to find the first client for account 1")
+	}
+	if r.Len() != 2 {
+		t.Errorf("expected 2 registered clients, got %d", r.Len())
+	}
+
+	// Logging in again replaces the old client, which can no longer remove the new one.
+	r.Add(second)
+	if r.Remove(first) {
+		t.Errorf("expected the first client not to be removed")
+	}
+	if got := r.Find(1); got != second {
+		t.Errorf("expected to find the second client for account 1")
+	}
+
+	if !r.Remove(second) {
+		t.Errorf("expected the second client to be removed")
+	}
+	if got := r.Find(1); got != nil {
+		t.Errorf("expected no client for account 1 after removing it")
+	}
+	if got := r.Find(2); got != other {
+		t.Errorf("expected to find the client for account 2")
+	}
+	if got := r.Find(3); got != nil {
+		t.Errorf("expected no client for an unknown account")
+	}
+}
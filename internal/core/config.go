@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -24,6 +25,45 @@ type Config struct {
 
 	Web struct {
 		HTTPPort int `mapstructure:"http_port"`
+		// Base URL players use to reach the API, used for links in emails.
+		PublicURL string `mapstructure:"public_url"`
+
+		Registration struct {
+			Enabled bool `mapstructure:"enabled"`
+			// Whether new accounts have to verify their email address before logging in.
+			VerifyEmail bool `mapstructure:"verify_email"`
+			// Registrations allowed from one IP address per window.
+			IPLimit  int           `mapstructure:"ip_limit"`
+			IPWindow time.Duration `mapstructure:"ip_window"`
+		} `mapstructure:"registration"`
+
+		PasswordReset struct {
+			// Whether players can request password reset emails. Tokens issued by
+			// admins can always be redeemed.
+			Enabled bool `mapstructure:"enabled"`
+			// Reset emails that can be requested from one IP address per window.
+			IPLimit  int           `mapstructure:"ip_limit"`
+			IPWindow time.Duration `mapstructure:"ip_window"`
+		} `mapstructure:"password_reset"`
+
+		Admin struct {
+			// Whether to serve the admin console under /admin.
+			Enabled bool `mapstructure:"enabled"`
+		} `mapstructure:"admin"`
+
+		Mail struct {
+			// How emails are sent: spool, log, or smtp.
+			Sender string `mapstructure:"sender"`
+			From   string `mapstructure:"from"`
+			// Directory that the spool sender writes emails to, one file each.
+			SpoolDir string `mapstructure:"spool_dir"`
+			SMTP     struct {
+				Host     string `mapstructure:"host"`
+				Port     int    `mapstructure:"port"`
+				Username string `mapstructure:"username"`
+				Password string `mapstructure:"password"`
+			} `mapstructure:"smtp"`
+		} `mapstructure:"mail"`
 	} `mapstructure:"web"`
 
 	Database struct {
@@ -41,6 +81,44 @@ type Config struct {
 
 	ShipgateServer struct {
 		Port int `mapstructure:"port"`
+		// Address (host:port or URL) other servers use to reach the shipgate. Defaults
+		// to the hostname and port, for when everything runs in one process.
+		Address string `mapstructure:"address"`
+		// Key used to sign session tokens. A random key is generated at startup if
+		// unset, which means sessions don't survive shipgate restarts.
+		SessionSecret string        `mapstructure:"session_secret"`
+		SessionTTL    time.Duration `mapstructure:"session_ttl"`
+		// bcrypt work factor used when hashing account passwords.
+		PasswordCost int `mapstructure:"password_cost"`
+		// How long a ship can go without sending a heartbeat before it's deregistered.
+		ShipTimeout time.Duration `mapstructure:"ship_timeout"`
+
+		LoginLockout struct {
+			Threshold   int           `mapstructure:"threshold"`
+			IPThreshold int           `mapstructure:"ip_threshold"`
+			Duration    time.Duration `mapstructure:"duration"`
+			MaxDuration time.Duration `mapstructure:"max_duration"`
+		} `mapstructure:"login_lockout"`
+
+		// Certificate and key the shipgate serves RPCs with. RPCs are sent over plain
+		// HTTP if unset. CAFile is used by the other servers to verify the certificate
+		// when it's signed by a local CA.
+		TLS struct {
+			CertFile string `mapstructure:"cert_file"`
+			KeyFile  string `mapstructure:"key_file"`
+			CAFile   string `mapstructure:"ca_file"`
+		} `mapstructure:"tls"`
+		// Name and key the servers in this process use to authenticate with the shipgate.
+		APIKey struct {
+			Name string `mapstructure:"name"`
+			Key  string `mapstructure:"key"`
+		} `mapstructure:"api_key"`
+		// Servers allowed to call the shipgate, keyed by name. Without any, only servers
+		// on the same machine as the shipgate can call it.
+		Callers map[string]struct {
+			Key  string `mapstructure:"key"`
+			Role string `mapstructure:"role"`
+		} `mapstructure:"callers"`
 	} `mapstructure:"shipgate_server"`
 
 	PatchServer struct {
@@ -61,16 +139,42 @@ type Config struct {
 	} `mapstructure:"character_server"`
 
 	ShipServer struct {
-		Port      int    `mapstructure:"port"`
-		Name      string `mapstructure:"name"`
-		NumBlocks int    `mapstructure:"num_blocks"`
+		Port              int           `mapstructure:"port"`
+		Name              string        `mapstructure:"name"`
+		NumBlocks         int           `mapstructure:"num_blocks"`
+		MaxPlayers        int           `mapstructure:"max_players"`
+		HeartbeatInterval time.Duration `mapstructure:"heartbeat_interval"`
 	} `mapstructure:"ship_server"`
 
 	BlockServer struct {
-		Port       int `mapstructure:"port"`
-		NumLobbies int `mapstructure:"num_lobbies"`
+		Port          int    `mapstructure:"port"`
+		NumLobbies    int    `mapstructure:"num_lobbies"`
+		MaxPlayers    int    `mapstructure:"max_players"`
+		CommandPrefix string `mapstructure:"command_prefix"`
+		// File in the config directory defining the battle mode rule presets.
+		BattleRulesFile    string `mapstructure:"battle_rules_file"`
+		DefaultBattleRules string `mapstructure:"default_battle_rules"`
+		// File in the config directory defining the challenge mode stages and titles.
+		ChallengeFile string `mapstructure:"challenge_file"`
+		// File in the config directory listing the map data files for each episode.
+		MapsFile string `mapstructure:"maps_file"`
+
+		AntiCheat struct {
+			DefaultAction string            `mapstructure:"default_action"`
+			Rules         map[string]string `mapstructure:"rules"`
+		} `mapstructure:"anti_cheat"`
+
+		LobbyEvents struct {
+			Default  string               `mapstructure:"default"`
+			Blocks   []BlockEventConfig   `mapstructure:"blocks"`
+			Calendar []EventCalendarEntry `mapstructure:"calendar"`
+		} `mapstructure:"lobby_events"`
 	} `mapstructure:"block_server"`
 
+	Permissions struct {
+		Roles []RoleConfig `mapstructure:"roles"`
+	} `mapstructure:"permissions"`
+
 	Logging struct {
 		LogFilePath   string `mapstructure:"log_file_path"`
 		LogLevel      string `mapstructure:"log_level"`
@@ -87,6 +191,27 @@ type Config struct {
 	cachedIPBytes [4]byte
 }
 
+// RoleConfig defines a custom role in addition to the built-in ones.
+type RoleConfig struct {
+	Name        string   `mapstructure:"name"`
+	Level       int      `mapstructure:"level"`
+	Permissions []string `mapstructure:"permissions"`
+}
+
+// BlockEventConfig overrides the default lobby event for one block.
+type BlockEventConfig struct {
+	Block int    `mapstructure:"block"`
+	Event string `mapstructure:"event"`
+}
+
+// EventCalendarEntry enables a lobby event between two dates (inclusive) every
+// year. Dates are formatted as MM-DD.
+type EventCalendarEntry struct {
+	Event string `mapstructure:"event"`
+	Start string `mapstructure:"start"`
+	End   string `mapstructure:"end"`
+}
+
 // LoadConfig initializes Viper with the contents of the config file under configPath.
 func LoadConfig(configPath string) *Config {
 	viper.SetConfigType("yaml")
@@ -144,12 +269,23 @@ func (c *Config) DatabaseURL() string {
 	)
 }
 
-// ShipgateAddress returns the fully qualified address of the ship server.
-// TODO: Since the current expectation is that the ship server is running alongside
-// the other servers, this just uses the hostname. This should be fixed at some point
-// to use an actual configurable address and the ship server given its own listen config.
+// ShipgateAddress returns the fully qualified address of the shipgate.
 func (c *Config) ShipgateAddress() string {
-	return fmt.Sprintf("http://%s:%v", c.Hostname, c.ShipgateServer.Port)
+	address := c.ShipgateServer.Address
+	if address == "" {
+		address = fmt.Sprintf("%s:%v", c.Hostname, c.ShipgateServer.Port)
+	}
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	return address
+}
+
+// BlockAddress returns the address clients connect to in order to reach the
+// block with the given ID. Blocks listen on consecutive ports after the block
+// server's base port.
+func (c *Config) BlockAddress(id int) string {
+	return fmt.Sprintf("%s:%v", c.ExternalIP, c.BlockServer.Port+id)
 }
 
 // BroadcastIP converts the configured broadcast IP string into 4 bytes to be used
@@ -35,19 +35,25 @@ func TestConfig_DatabaseURL(t *testing.T) {
 }
 
 func TestConfig_ShipgateAddress(t *testing.T) {
-	cfg := &Config{
-		Hostname: "127.0.0.1",
-		ShipgateServer: struct {
-			Port int `mapstructure:"port"`
-		}{
-			Port: 12345,
-		},
+	tests := []struct {
+		name    string
+		address string
+		want    string
+	}{
+		{name: "hostname", want: "http://127.0.0.1:12345"},
+		{name: "host and port", address: "shipgate.example.com:13000", want: "http://shipgate.example.com:13000"},
+		{name: "URL", address: "https://shipgate.example.com", want: "https://shipgate.example.com"},
 	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Hostname: "127.0.0.1"}
+			cfg.ShipgateServer.Port = 12345
+			cfg.ShipgateServer.Address = tt.address
 
-	addr := cfg.ShipgateAddress()
-	expected := "http://127.0.0.1:12345"
-	if addr != expected {
-		t.Errorf("ShipgateAddress() want = %s, got = %s", expected, addr)
+			if addr := cfg.ShipgateAddress(); addr != tt.want {
+				t.Errorf("ShipgateAddress() want = %s, got = %s", tt.want, addr)
+			}
+		})
 	}
 }
 
@@ -60,3 +66,14 @@ func TestConfig_BroadcastIP(t *testing.T) {
 		t.Errorf("BroadcastIP() generated the wrong IP; diff:\n%s", diff)
 	}
 }
+
+func TestConfig_BlockAddress(t *testing.T) {
+	cfg := &Config{ExternalIP: "192.168.1.5"}
+	cfg.BlockServer.Port = 15000
+
+	addr := cfg.BlockAddress(2)
+	expected := "192.168.1.5:15002"
+	if addr != expected {
+		t.Errorf("BlockAddress() want = %s, got = %s", expected, addr)
+	}
+}
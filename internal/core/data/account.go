@@ -2,6 +2,7 @@ package data
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -73,11 +74,72 @@ func FindUnscopedAccount(db *gorm.DB, username string) (*Account, error) {
 	return &account, nil
 }
 
+// FindAccountByEmail searches for a potentially soft-deleted account with the
+// email address, returning nil if there is no match.
+func FindAccountByEmail(db *gorm.DB, email string) (*Account, error) {
+	var account Account
+	err := db.Unscoped().Where("email = ?", email).First(&account).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// likeEscaper escapes the characters that are wildcards in LIKE patterns, since
+// usernames can contain underscores.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// FindAccounts returns up to limit accounts ordered by username, starting at
+// offset, whose usernames start with prefix.
+func FindAccounts(db *gorm.DB, prefix string, limit, offset int) ([]Account, error) {
+	query := db.Order("username").Offset(offset)
+	if prefix != "" {
+		query = query.Where(`username LIKE ? ESCAPE '\'`, likeEscaper.Replace(prefix)+"%")
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var accounts []Account
+	if err := query.Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
 // CreateAccount persists the Account record to the database.
 func CreateAccount(db *gorm.DB, account *Account) error {
 	return db.Create(account).Error
 }
 
+// CreateUnverifiedAccount persists an inactive Account record along with the
+// token that activates it, so that the account is never active without being
+// verified.
+func CreateUnverifiedAccount(db *gorm.DB, account *Account, token *AccountToken) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(account).Error; err != nil {
+			return err
+		}
+		// gorm doesn't insert false for fields that default to true.
+		account.Active = false
+		if err := tx.Model(account).Update("active", false).Error; err != nil {
+			return err
+		}
+		token.AccountID = account.ID
+		return tx.Create(token).Error
+	})
+}
+
+// UpdateAccount saves all of the fields on an existing Account record.
+func UpdateAccount(db *gorm.DB, account *Account) error {
+	return db.Save(account).Error
+}
+
 // DeleteAccount soft-deletes an Account record from the database.
 func DeleteAccount(db *gorm.DB, account *Account) error {
 	return db.Delete(account).Error
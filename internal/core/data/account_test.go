@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"gorm.io/gorm"
@@ -124,6 +125,29 @@ func TestFindAccountByUsername(t *testing.T) {
 	}
 }
 
+func TestUpdateAccount(t *testing.T) {
+	db := setUpDatabase(t)
+
+	testAccount := generateAccount(t)
+	if err := CreateAccount(db, testAccount); err != nil {
+		t.Fatalf("error creating test account: %v", err)
+	}
+
+	testAccount.PrivilegeLevel = 3
+	testAccount.Banned = true
+	if err := UpdateAccount(db, testAccount); err != nil {
+		t.Fatalf("UpdateAccount() returned an unexpected error: %v", err)
+	}
+
+	account, err := FindAccountByID(db, uint(testAccount.ID))
+	if err != nil {
+		t.Fatalf("FindAccountByID() returned an unexpected error: %v", err)
+	}
+	if account.PrivilegeLevel != 3 || !account.Banned {
+		t.Errorf("UpdateAccount() did not save the changes: %v", account)
+	}
+}
+
 func TestFindUnscopedAccount(t *testing.T) {
 	db := setUpDatabase(t)
 
@@ -159,3 +183,99 @@ func TestFindUnscopedAccount(t *testing.T) {
 		t.Fatalf("FindUnscopedAccount() returned an account unexpectedly: %v", account)
 	}
 }
+
+func TestFindAccountByEmail(t *testing.T) {
+	db := setUpDatabase(t)
+	seedRandomAccounts(t, db)
+
+	testAccount := generateAccount(t)
+	if err := CreateAccount(db, testAccount); err != nil {
+		t.Fatalf("error creating test account: %v", err)
+	}
+	if err := DeleteAccount(db, testAccount); err != nil {
+		t.Fatalf("error deleting test account: %v", err)
+	}
+
+	// Deleted accounts still hold on to their email address.
+	account, err := FindAccountByEmail(db, testAccount.Email)
+	if err != nil {
+		t.Fatalf("FindAccountByEmail() returned an unexpected error: %v", err)
+	} else if account == nil || account.ID != testAccount.ID {
+		t.Errorf("FindAccountByEmail() returned %v, want account %d", account, testAccount.ID)
+	}
+
+	account, err = FindAccountByEmail(db, "nobody@example.com")
+	if err != nil || account != nil {
+		t.Errorf("FindAccountByEmail() returned %v, %v; want no account", account, err)
+	}
+}
+
+func TestFindAccounts(t *testing.T) {
+	db := setUpDatabase(t)
+
+	for _, username := range []string{"sonic", "tails", "sonia", "knuckles", "a_b", "axb"} {
+		if err := CreateAccount(db, &Account{Username: username, Password: "password", Email: username + "@example.com"}); err != nil {
+			t.Fatalf("error creating test account: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		prefix string
+		limit  int
+		offset int
+		want   []string
+	}{
+		{name: "all", want: []string{"a_b", "axb", "knuckles", "sonia", "sonic", "tails"}},
+		{name: "prefix", prefix: "son", want: []string{"sonia", "sonic"}},
+		{name: "prefix with wildcard characters", prefix: "a_", want: []string{"a_b"}},
+		{name: "prefix with percent sign", prefix: "%", want: nil},
+		{name: "limit", limit: 2, want: []string{"a_b", "axb"}},
+		{name: "offset", limit: 2, offset: 2, want: []string{"knuckles", "sonia"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accounts, err := FindAccounts(db, tt.prefix, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("FindAccounts() returned an unexpected error: %v", err)
+			}
+			var got []string
+			for _, account := range accounts {
+				got = append(got, account.Username)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindAccounts() returned the wrong accounts; diff:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateUnverifiedAccount(t *testing.T) {
+	db := setUpDatabase(t)
+	account := generateAccount(t)
+	token := &AccountToken{Purpose: TokenPurposeVerifyEmail, Hash: "abc123", ExpiresAt: time.Now().Add(time.Hour)}
+
+	if err := CreateUnverifiedAccount(db, account, token); err != nil {
+		t.Fatalf("CreateUnverifiedAccount() returned an unexpected error: %v", err)
+	}
+	saved, err := FindAccountByID(db, uint(account.ID))
+	if err != nil || saved == nil {
+		t.Fatalf("error finding account: %v", err)
+	}
+	if saved.Active {
+		t.Errorf("expected the account to be inactive")
+	}
+	if found, err := FindAccountToken(db, TokenPurposeVerifyEmail, "abc123"); err != nil || found == nil || found.AccountID != account.ID {
+		t.Errorf("expected the token to belong to the account, got %+v (error: %v)", found, err)
+	}
+
+	// Nothing is saved if the token can't be.
+	other := generateAccount(t)
+	duplicate := &AccountToken{Purpose: TokenPurposeVerifyEmail, Hash: "abc123", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := CreateUnverifiedAccount(db, other, duplicate); err == nil {
+		t.Fatalf("expected an error saving a duplicate token")
+	}
+	if found, err := FindAccountByUsername(db, other.Username); err != nil || found != nil {
+		t.Errorf("expected the account not to be saved, got %+v (error: %v)", found, err)
+	}
+}
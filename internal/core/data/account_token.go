@@ -0,0 +1,52 @@
+package data
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Things account tokens can be used for.
+const (
+	TokenPurposeVerifyEmail   = "verify_email"
+	TokenPurposeResetPassword = "reset_password"
+)
+
+// AccountToken is a single-use secret given to a player (usually by email) in
+// order to prove that they own the account, such as when resetting a password.
+type AccountToken struct {
+	ID uint64 `gorm:"primaryKey"`
+
+	AccountID uint64 `gorm:"index;not null"`
+	Purpose   string `gorm:"not null"`
+	// SHA-256 hash of the token, so that tokens can't be used by anyone able to
+	// read the database.
+	Hash      string `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time
+
+	CreatedAt time.Time
+}
+
+// CreateAccountToken persists an AccountToken record to the database.
+func CreateAccountToken(db *gorm.DB, token *AccountToken) error {
+	return db.Create(token).Error
+}
+
+// FindAccountToken returns the token for purpose with hash or nil if it doesn't
+// exist. Expired tokens are still returned.
+func FindAccountToken(db *gorm.DB, purpose, hash string) (*AccountToken, error) {
+	var token AccountToken
+	if err := db.Where("purpose = ? AND hash = ?", purpose, hash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// DeleteAccountTokens removes all of the account's tokens for purpose.
+func DeleteAccountTokens(db *gorm.DB, accountID uint64, purpose string) error {
+	return db.Where("account_id = ? AND purpose = ?", accountID, purpose).Delete(&AccountToken{}).Error
+}
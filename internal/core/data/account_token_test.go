@@ -0,0 +1,40 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccountTokens(t *testing.T) {
+	db := setUpDatabase(t)
+
+	expires := time.Now().Add(time.Hour)
+	tokens := []*AccountToken{
+		{AccountID: 1, Purpose: TokenPurposeVerifyEmail, Hash: "a", ExpiresAt: expires},
+		{AccountID: 1, Purpose: TokenPurposeVerifyEmail, Hash: "b", ExpiresAt: expires},
+		{AccountID: 2, Purpose: TokenPurposeVerifyEmail, Hash: "c", ExpiresAt: expires},
+	}
+	for _, token := range tokens {
+		if err := CreateAccountToken(db, token); err != nil {
+			t.Fatalf("CreateAccountToken() returned an unexpected error: %v", err)
+		}
+	}
+
+	token, err := FindAccountToken(db, TokenPurposeVerifyEmail, "b")
+	if err != nil || token == nil || token.ID != tokens[1].ID {
+		t.Fatalf("FindAccountToken() returned %+v, %v; want token %d", token, err, tokens[1].ID)
+	}
+	if token, err := FindAccountToken(db, "other", "b"); err != nil || token != nil {
+		t.Errorf("FindAccountToken() returned %+v, %v; want no token for another purpose", token, err)
+	}
+
+	if err := DeleteAccountTokens(db, 1, TokenPurposeVerifyEmail); err != nil {
+		t.Fatalf("DeleteAccountTokens() returned an unexpected error: %v", err)
+	}
+	if token, err := FindAccountToken(db, TokenPurposeVerifyEmail, "a"); err != nil || token != nil {
+		t.Errorf("expected account 1's tokens to be deleted, got %+v (error: %v)", token, err)
+	}
+	if token, err := FindAccountToken(db, TokenPurposeVerifyEmail, "c"); err != nil || token == nil {
+		t.Errorf("expected account 2's token to remain, got %+v (error: %v)", token, err)
+	}
+}
@@ -0,0 +1,39 @@
+package data
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AuditEntry is a record of a privileged action taken by a player or administrator.
+type AuditEntry struct {
+	ID uint64 `gorm:"primaryKey"`
+
+	// Account that performed the action. Zero for actions taken outside of the game
+	// (such as through the CLI).
+	ActorAccountID uint64 `gorm:"index"`
+	// Short name for the action taken, e.g. "kick" or "set_privilege_level".
+	Action string `gorm:"not null"`
+	// Whatever the action was applied to (username, guildcard, etc).
+	Target  string
+	Details string
+	// The server component or tool from which the action was taken.
+	Source string
+
+	CreatedAt time.Time
+}
+
+// CreateAuditEntry persists an AuditEntry record to the database.
+func CreateAuditEntry(db *gorm.DB, entry *AuditEntry) error {
+	return db.Create(entry).Error
+}
+
+// FindAuditEntries returns up to limit of the most recent AuditEntry records.
+func FindAuditEntries(db *gorm.DB, limit int) ([]AuditEntry, error) {
+	var entries []AuditEntry
+	if err := db.Order("created_at desc, id desc").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,26 @@
+package data
+
+import (
+	"testing"
+)
+
+func TestFindAuditEntries(t *testing.T) {
+	db := setUpDatabase(t)
+
+	for _, action := range []string{"kick", "ban", "announce"} {
+		if err := CreateAuditEntry(db, &AuditEntry{ActorAccountID: 1, Action: action}); err != nil {
+			t.Fatalf("CreateAuditEntry() returned an unexpected error: %v", err)
+		}
+	}
+
+	entries, err := FindAuditEntries(db, 2)
+	if err != nil {
+		t.Fatalf("FindAuditEntries() returned an unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("FindAuditEntries() returned %d entries, want 2", len(entries))
+	}
+	if entries[0].Action != "announce" || entries[1].Action != "ban" {
+		t.Errorf("FindAuditEntries() returned entries in the wrong order: %v", entries)
+	}
+}
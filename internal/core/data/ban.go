@@ -0,0 +1,135 @@
+package data
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Kinds of things that can be banned.
+const (
+	BanTypeAccount  = "account"
+	BanTypeIP       = "ip"
+	BanTypeHardware = "hardware"
+)
+
+// Length of the hardware IDs sent by the client when it logs in.
+const hardwareIDSize = 8
+
+// Ban prevents an account, IP address (or range), or client hardware ID from
+// connecting until it expires or is lifted.
+type Ban struct {
+	ID uint64 `gorm:"primaryKey"`
+
+	// One of BanTypeAccount, BanTypeIP, or BanTypeHardware.
+	Type string `gorm:"index;not null"`
+	// The banned account, for account bans.
+	AccountID uint64 `gorm:"index"`
+	// The banned IP address or CIDR range for IP bans, or the hex-encoded
+	// hardware ID for hardware bans.
+	Value  string
+	Reason string
+	// Account of the admin who issued the ban, or 0 if it was issued by the server.
+	IssuedBy uint64
+	// Bans without an expiry are permanent.
+	ExpiresAt *time.Time
+	// Set once the ban has been lifted by an admin.
+	LiftedAt *time.Time
+
+	CreatedAt time.Time
+}
+
+// Validate checks that the ban's value makes sense for its type and normalizes it.
+func (b *Ban) Validate() error {
+	switch b.Type {
+	case BanTypeAccount:
+		if b.AccountID == 0 {
+			return errors.New("account bans require an account")
+		}
+	case BanTypeIP:
+		if ip := net.ParseIP(b.Value); ip != nil {
+			b.Value = ip.String()
+		} else if _, network, err := net.ParseCIDR(b.Value); err == nil {
+			b.Value = network.String()
+		} else {
+			return fmt.Errorf("invalid IP address or CIDR range: %s", b.Value)
+		}
+	case BanTypeHardware:
+		b.Value = strings.ToLower(b.Value)
+		if id, err := hex.DecodeString(b.Value); err != nil || len(id) != hardwareIDSize {
+			return fmt.Errorf("invalid hardware ID (expected %d hex-encoded bytes): %s", hardwareIDSize, b.Value)
+		}
+	default:
+		return fmt.Errorf("unknown ban type: %s", b.Type)
+	}
+	return nil
+}
+
+// Active returns whether the ban is in effect at time t.
+func (b *Ban) Active(t time.Time) bool {
+	return b.LiftedAt == nil && (b.ExpiresAt == nil || t.Before(*b.ExpiresAt))
+}
+
+// Matches returns whether the ban applies to a player with the account, IP
+// address, and hardware ID. Empty values never match.
+func (b *Ban) Matches(accountID uint64, ip string, hardwareID string) bool {
+	switch b.Type {
+	case BanTypeAccount:
+		return accountID != 0 && b.AccountID == accountID
+	case BanTypeIP:
+		addr := net.ParseIP(ip)
+		if addr == nil {
+			return false
+		}
+		if _, network, err := net.ParseCIDR(b.Value); err == nil {
+			return network.Contains(addr)
+		}
+		return addr.Equal(net.ParseIP(b.Value))
+	case BanTypeHardware:
+		return hardwareID != "" && strings.EqualFold(b.Value, hardwareID)
+	}
+	return false
+}
+
+// CreateBan persists a Ban record to the database.
+func CreateBan(db *gorm.DB, ban *Ban) error {
+	return db.Create(ban).Error
+}
+
+// FindBan returns the Ban with id or nil if it doesn't exist.
+func FindBan(db *gorm.DB, id uint64) (*Ban, error) {
+	var ban Ban
+	if err := db.First(&ban, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ban, nil
+}
+
+// FindActiveBans returns the bans in effect at time t, optionally only those of
+// the given types.
+func FindActiveBans(db *gorm.DB, t time.Time, types ...string) ([]Ban, error) {
+	query := db.Order("id").
+		Where("lifted_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", t)
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	var bans []Ban
+	if err := query.Find(&bans).Error; err != nil {
+		return nil, err
+	}
+	return bans, nil
+}
+
+// LiftBan ends the ban with id.
+func LiftBan(db *gorm.DB, id uint64) error {
+	return db.Model(&Ban{}).Where("id = ?", id).Update("lifted_at", time.Now()).Error
+}
@@ -0,0 +1,102 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBan_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		ban       Ban
+		wantValue string
+		wantErr   bool
+	}{
+		{name: "account", ban: Ban{Type: BanTypeAccount, AccountID: 1}},
+		{name: "account without ID", ban: Ban{Type: BanTypeAccount}, wantErr: true},
+		{name: "IP address", ban: Ban{Type: BanTypeIP, Value: "10.0.0.1"}, wantValue: "10.0.0.1"},
+		{name: "CIDR range", ban: Ban{Type: BanTypeIP, Value: "10.0.0.7/24"}, wantValue: "10.0.0.0/24"},
+		{name: "invalid IP address", ban: Ban{Type: BanTypeIP, Value: "10.0.0"}, wantErr: true},
+		{name: "hardware ID", ban: Ban{Type: BanTypeHardware, Value: "0011AABBCCDDEEFF"}, wantValue: "0011aabbccddeeff"},
+		{name: "short hardware ID", ban: Ban{Type: BanTypeHardware, Value: "0011"}, wantErr: true},
+		{name: "unknown type", ban: Ban{Type: "character"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ban.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() returned error %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.ban.Value != tt.wantValue {
+				t.Errorf("got value %s, want %s", tt.ban.Value, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestBan_Matches(t *testing.T) {
+	tests := []struct {
+		name       string
+		ban        Ban
+		accountID  uint64
+		ip         string
+		hardwareID string
+		want       bool
+	}{
+		{name: "account", ban: Ban{Type: BanTypeAccount, AccountID: 1}, accountID: 1, want: true},
+		{name: "other account", ban: Ban{Type: BanTypeAccount, AccountID: 1}, accountID: 2},
+		{name: "IP address", ban: Ban{Type: BanTypeIP, Value: "10.0.0.1"}, ip: "10.0.0.1", want: true},
+		{name: "IP address in range", ban: Ban{Type: BanTypeIP, Value: "10.0.0.0/24"}, ip: "10.0.0.200", want: true},
+		{name: "IP address outside range", ban: Ban{Type: BanTypeIP, Value: "10.0.0.0/24"}, ip: "10.0.1.1"},
+		{name: "no IP address", ban: Ban{Type: BanTypeIP, Value: "10.0.0.0/24"}},
+		{name: "hardware ID", ban: Ban{Type: BanTypeHardware, Value: "0011aabbccddeeff"}, hardwareID: "0011AABBCCDDEEFF", want: true},
+		{name: "no hardware ID", ban: Ban{Type: BanTypeHardware, Value: "0011aabbccddeeff"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ban.Matches(tt.accountID, tt.ip, tt.hardwareID); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindActiveBans(t *testing.T) {
+	db := setUpDatabase(t)
+
+	now := time.Now()
+	expired := now.Add(-time.Hour)
+	expires := now.Add(time.Hour)
+	bans := []*Ban{
+		{Type: BanTypeAccount, AccountID: 1},
+		{Type: BanTypeIP, Value: "10.0.0.1", ExpiresAt: &expires},
+		{Type: BanTypeIP, Value: "10.0.0.2", ExpiresAt: &expired},
+		{Type: BanTypeHardware, Value: "0011aabbccddeeff"},
+	}
+	for _, ban := range bans {
+		if err := CreateBan(db, ban); err != nil {
+			t.Fatalf("CreateBan() returned an unexpected error: %v", err)
+		}
+	}
+	if err := LiftBan(db, bans[3].ID); err != nil {
+		t.Fatalf("LiftBan() returned an unexpected error: %v", err)
+	}
+
+	active, err := FindActiveBans(db, now)
+	if err != nil {
+		t.Fatalf("FindActiveBans() returned an unexpected error: %v", err)
+	}
+	if len(active) != 2 || active[0].ID != bans[0].ID || active[1].ID != bans[1].ID {
+		t.Errorf("expected the account and unexpired IP bans to be active, got %+v", active)
+	}
+
+	active, err = FindActiveBans(db, now, BanTypeIP)
+	if err != nil || len(active) != 1 || active[0].Value != "10.0.0.1" {
+		t.Errorf("FindActiveBans() returned %+v, %v; want the unexpired IP ban", active, err)
+	}
+
+	ban, err := FindBan(db, bans[3].ID)
+	if err != nil || ban == nil || ban.Active(now) {
+		t.Errorf("expected lifted ban to be inactive, got %+v (error: %v)", ban, err)
+	}
+}
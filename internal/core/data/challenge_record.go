@@ -0,0 +1,64 @@
+package data
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ChallengeRecord is a character's best clear of one challenge mode stage.
+type ChallengeRecord struct {
+	ID uint64 `gorm:"primaryKey"`
+
+	CharacterID uint64 `gorm:"uniqueIndex:challenge_record_character_stage"`
+	Episode     uint32 `gorm:"uniqueIndex:challenge_record_character_stage"`
+	Stage       uint32 `gorm:"uniqueIndex:challenge_record_character_stage"`
+	// Clear time in seconds.
+	ClearTime uint32
+	Rank      string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FindChallengeRecords returns all of the ChallengeRecords for a character,
+// ordered by episode and stage.
+func FindChallengeRecords(db *gorm.DB, characterID uint64) ([]ChallengeRecord, error) {
+	var records []ChallengeRecord
+	if err := db.Where("character_id = ?", characterID).Order("episode, stage").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// UpdateChallengeRecords saves any of records that are faster than the character's
+// existing clears of the same stage and sets the character's challenge title.
+func UpdateChallengeRecords(db *gorm.DB, characterID uint64, records []ChallengeRecord, title string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, record := range records {
+			var existing ChallengeRecord
+			err := tx.Where(
+				"character_id = ? AND episode = ? AND stage = ?", characterID, record.Episode, record.Stage,
+			).First(&existing).Error
+
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				record.ID = 0
+				record.CharacterID = characterID
+				if err := tx.Create(&record).Error; err != nil {
+					return err
+				}
+			case err != nil:
+				return err
+			case record.ClearTime < existing.ClearTime:
+				existing.ClearTime = record.ClearTime
+				existing.Rank = record.Rank
+				if err := tx.Save(&existing).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Model(&Character{}).Where("id = ?", characterID).Update("challenge_title", title).Error
+	})
+}
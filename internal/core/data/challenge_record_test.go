@@ -0,0 +1,50 @@
+package data
+
+import (
+	"testing"
+)
+
+func TestUpdateChallengeRecords(t *testing.T) {
+	db := setUpDatabase(t)
+
+	character := &Character{Account: generateAccount(t), Slot: 1}
+	if err := db.Create(character).Error; err != nil {
+		t.Fatalf("error creating test character: %v", err)
+	}
+
+	if err := UpdateChallengeRecords(db, character.ID, []ChallengeRecord{
+		{Episode: 1, Stage: 1, ClearTime: 900, Rank: "A"},
+		{Episode: 1, Stage: 2, ClearTime: 1200, Rank: "B"},
+	}, ""); err != nil {
+		t.Fatalf("error saving challenge records: %v", err)
+	}
+	// Only the faster of the two new clears should replace an existing record.
+	if err := UpdateChallengeRecords(db, character.ID, []ChallengeRecord{
+		{Episode: 1, Stage: 1, ClearTime: 600, Rank: "S"},
+		{Episode: 1, Stage: 2, ClearTime: 1500, Rank: "B"},
+	}, "Challenger"); err != nil {
+		t.Fatalf("error updating challenge records: %v", err)
+	}
+
+	records, err := FindChallengeRecords(db, character.ID)
+	if err != nil {
+		t.Fatalf("error finding challenge records: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 challenge records, got %d", len(records))
+	}
+	if records[0].ClearTime != 600 || records[0].Rank != "S" {
+		t.Errorf("expected stage 1 record to be replaced by the faster clear, got %+v", records[0])
+	}
+	if records[1].ClearTime != 1200 || records[1].Rank != "B" {
+		t.Errorf("expected stage 2 record to keep the faster clear, got %+v", records[1])
+	}
+
+	var updated Character
+	if err := db.First(&updated, character.ID).Error; err != nil {
+		t.Fatalf("error finding character: %v", err)
+	}
+	if updated.ChallengeTitle != "Challenger" {
+		t.Errorf("expected challenge title to be Challenger, got %s", updated.ChallengeTitle)
+	}
+}
@@ -51,12 +51,30 @@ type Character struct {
 	Meseta            uint32
 	HPMaterialsUsed   byte
 	TPMaterialsUsed   byte
+	InfoBoard         []byte
+	ChallengeTitle    string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt gorm.DeletedAt
 }
 
+// FindCharactersByName returns up to limit characters whose names match name,
+// ignoring case.
+func FindCharactersByName(db *gorm.DB, name string, limit int) ([]Character, error) {
+	var characters []Character
+	err := db.
+		Where("LOWER(readable_name) = LOWER(?)", name).
+		Order("id").
+		Limit(limit).
+		Find(&characters).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return characters, nil
+}
+
 // FindCharacter returns the Character associated with the account in
 // the given slot or nil if none exists.
 func FindCharacter(db *gorm.DB, accountID uint, slot uint32) (*Character, error) {
@@ -85,6 +103,24 @@ func UpsertCharacter(db *gorm.DB, character *Character) error {
 	}).Create(&character).Error
 }
 
+// UpdateCharacterStats sets the level, experience, meseta, and stats of the Character
+// associated with the account in the given slot to those of character.
+func UpdateCharacterStats(db *gorm.DB, accountID uint, slot uint32, character *Character) error {
+	return db.Model(&Character{}).
+		Where("slot = ? AND account_id = ?", slot, accountID).
+		Select("level", "experience", "meseta", "atp", "mst", "evp", "hp", "dfp", "ata", "lck").
+		Updates(character).Error
+}
+
+// UpdateInfoBoard sets the info board text of the Character associated with the
+// account in the given slot.
+func UpdateInfoBoard(db *gorm.DB, accountID uint, slot uint32, infoBoard []byte) error {
+	return db.Model(&Character{}).
+		Where("slot = ? AND account_id = ?", slot, accountID).
+		Update("info_board", infoBoard).
+		Error
+}
+
 // DeleteCharacter soft-deletes a character record from the database.
 func DeleteCharacter(db *gorm.DB, accountID uint, slot uint32) error {
 	character, err := FindCharacter(db, accountID, slot)
@@ -98,6 +98,38 @@ func TestUpsertCharacter(t *testing.T) {
 	}
 }
 
+func TestUpdateInfoBoard(t *testing.T) {
+	db := setUpDatabase(t)
+
+	testAccount := generateAccount(t)
+	if err := db.Create(testAccount).Error; err != nil {
+		t.Fatalf("error creating test account: %v", err)
+	}
+	testCharacter := &Character{
+		Account:   testAccount,
+		Slot:      1,
+		Guildcard: 12345,
+		Level:     1,
+	}
+
+	if err := db.Create(testCharacter).Error; err != nil {
+		t.Fatalf("error creating character: %v", err)
+	}
+
+	infoBoard := []byte{'h', 0, 'i', 0}
+	if err := UpdateInfoBoard(db, uint(testAccount.ID), testCharacter.Slot, infoBoard); err != nil {
+		t.Fatalf("UpdateInfoBoard() returned an unexpected error: %s", err)
+	}
+
+	character, err := FindCharacter(db, uint(testAccount.ID), testCharacter.Slot)
+	if err != nil {
+		t.Fatalf("FindCharacter() returned an unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(infoBoard, character.InfoBoard); diff != "" {
+		t.Fatalf("info board did not match expected; diff:\n%s", diff)
+	}
+}
+
 func TestDeleteCharacter(t *testing.T) {
 	db := setUpDatabase(t)
 
@@ -173,3 +205,60 @@ func TestPermanentlyDeleteCharacter(t *testing.T) {
 		t.Fatalf("querying for deleted character returned an unexpected error: %v", err)
 	}
 }
+
+func TestFindCharactersByName(t *testing.T) {
+	db := setUpDatabase(t)
+
+	for slot, name := range []string{"Sonic", "Tails", "sonic"} {
+		if err := UpsertCharacter(db, &Character{AccountID: 1, Slot: uint32(slot), ReadableName: name}); err != nil {
+			t.Fatalf("error creating test character: %v", err)
+		}
+	}
+
+	characters, err := FindCharactersByName(db, "SONIC", 10)
+	if err != nil {
+		t.Fatalf("FindCharactersByName() returned an unexpected error: %v", err)
+	}
+	if len(characters) != 2 || characters[0].ReadableName != "Sonic" || characters[1].ReadableName != "sonic" {
+		t.Errorf("FindCharactersByName() returned %+v; want both characters named sonic", characters)
+	}
+
+	if characters, err := FindCharactersByName(db, "sonic", 1); err != nil || len(characters) != 1 {
+		t.Errorf("FindCharactersByName() returned %d characters (error: %v); want 1", len(characters), err)
+	}
+}
+
+func TestUpdateCharacterStats(t *testing.T) {
+	db := setUpDatabase(t)
+
+	testAccount := generateAccount(t)
+	if err := db.Create(testAccount).Error; err != nil {
+		t.Fatalf("error creating test account: %v", err)
+	}
+	testCharacter := &Character{
+		Account:      testAccount,
+		Slot:         1,
+		Guildcard:    12345,
+		Level:        1,
+		ReadableName: "Sonic",
+	}
+	if err := db.Create(testCharacter).Error; err != nil {
+		t.Fatalf("error creating character: %v", err)
+	}
+
+	update := &Character{Level: 9, Experience: 1200, Meseta: 500, ATP: 80, HP: 60, LCK: 10}
+	if err := UpdateCharacterStats(db, uint(testAccount.ID), testCharacter.Slot, update); err != nil {
+		t.Fatalf("UpdateCharacterStats() returned an unexpected error: %s", err)
+	}
+
+	character, err := FindCharacter(db, uint(testAccount.ID), testCharacter.Slot)
+	if err != nil {
+		t.Fatalf("FindCharacter() returned an unexpected error: %s", err)
+	}
+	if character.Level != 9 || character.Experience != 1200 || character.Meseta != 500 || character.ReadableName != "Sonic" {
+		t.Errorf("got unexpected character after update: %+v", character)
+	}
+	if character.ATP != 80 || character.HP != 60 || character.LCK != 10 || character.MST != 0 {
+		t.Errorf("got unexpected stats after update: %+v", character)
+	}
+}
@@ -24,6 +24,14 @@ func setUpDatabase(t *testing.T) *gorm.DB {
 		&PlayerOptions{},
 		&Character{},
 		&GuildcardEntry{},
+		&AuditEntry{},
+		&ChallengeRecord{},
+		&Violation{},
+		&Session{},
+		&Ban{},
+		&Ship{},
+		&AccountToken{},
+		&ScheduledBroadcast{},
 	); err != nil {
 		t.Fatalf("error auto migrating db: %s", err)
 	}
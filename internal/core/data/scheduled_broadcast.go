@@ -0,0 +1,38 @@
+package data
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ScheduledBroadcast is a message to every player that the shipgate sends at a
+// later time. They're saved so that they're still sent if the shipgate restarts
+// in the meantime.
+type ScheduledBroadcast struct {
+	ID        uint64 `gorm:"primaryKey"`
+	Message   string `gorm:"not null"`
+	DeliverAt time.Time
+
+	CreatedAt time.Time
+}
+
+// CreateScheduledBroadcast persists the ScheduledBroadcast record to the database.
+func CreateScheduledBroadcast(db *gorm.DB, broadcast *ScheduledBroadcast) error {
+	return db.Create(broadcast).Error
+}
+
+// FindScheduledBroadcasts returns the broadcasts that haven't been sent yet,
+// ordered by when they're due.
+func FindScheduledBroadcasts(db *gorm.DB) ([]ScheduledBroadcast, error) {
+	var broadcasts []ScheduledBroadcast
+	if err := db.Order("deliver_at").Find(&broadcasts).Error; err != nil {
+		return nil, err
+	}
+	return broadcasts, nil
+}
+
+// DeleteScheduledBroadcast removes a broadcast once it's been sent.
+func DeleteScheduledBroadcast(db *gorm.DB, id uint64) error {
+	return db.Delete(&ScheduledBroadcast{}, id).Error
+}
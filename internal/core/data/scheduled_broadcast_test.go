@@ -0,0 +1,38 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduledBroadcasts(t *testing.T) {
+	db := setUpDatabase(t)
+
+	now := time.Now()
+	later := &ScheduledBroadcast{Message: "Restarting in 5 minutes", DeliverAt: now.Add(5 * time.Minute)}
+	sooner := &ScheduledBroadcast{Message: "Restarting in 10 minutes", DeliverAt: now.Add(time.Minute)}
+	for _, broadcast := range []*ScheduledBroadcast{later, sooner} {
+		if err := CreateScheduledBroadcast(db, broadcast); err != nil {
+			t.Fatalf("CreateScheduledBroadcast() returned an unexpected error: %v", err)
+		}
+	}
+
+	broadcasts, err := FindScheduledBroadcasts(db)
+	if err != nil {
+		t.Fatalf("FindScheduledBroadcasts() returned an unexpected error: %v", err)
+	}
+	if len(broadcasts) != 2 || broadcasts[0].ID != sooner.ID || broadcasts[1].ID != later.ID {
+		t.Fatalf("expected both broadcasts ordered by delivery time, got %+v", broadcasts)
+	}
+
+	if err := DeleteScheduledBroadcast(db, sooner.ID); err != nil {
+		t.Fatalf("DeleteScheduledBroadcast() returned an unexpected error: %v", err)
+	}
+	broadcasts, err = FindScheduledBroadcasts(db)
+	if err != nil {
+		t.Fatalf("FindScheduledBroadcasts() returned an unexpected error: %v", err)
+	}
+	if len(broadcasts) != 1 || broadcasts[0].Message != later.Message {
+		t.Errorf("expected only the later broadcast to be left, got %+v", broadcasts)
+	}
+}
@@ -0,0 +1,58 @@
+package data
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Session is a login issued to an account by the LOGIN server. The session's ID
+// is carried in the signed token the client presents to the other servers.
+type Session struct {
+	ID uint64 `gorm:"primaryKey"`
+
+	AccountID uint64 `gorm:"index;not null"`
+	ExpiresAt time.Time
+	// Set once the session has been revoked, after which its token is rejected.
+	RevokedAt *time.Time
+
+	CreatedAt time.Time
+}
+
+// Valid returns whether the session can still be used at time t.
+func (s *Session) Valid(t time.Time) bool {
+	return s.RevokedAt == nil && t.Before(s.ExpiresAt)
+}
+
+// CreateSession persists a Session record to the database.
+func CreateSession(db *gorm.DB, session *Session) error {
+	return db.Create(session).Error
+}
+
+// FindSession returns the Session with id or nil if it doesn't exist.
+func FindSession(db *gorm.DB, id uint64) (*Session, error) {
+	var session Session
+	if err := db.First(&session, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+// RevokeSessions revokes every active session of the account with accountID.
+func RevokeSessions(db *gorm.DB, accountID uint64) error {
+	now := time.Now()
+	return db.Model(&Session{}).
+		Where("account_id = ? AND revoked_at IS NULL AND expires_at > ?", accountID, now).
+		Update("revoked_at", now).Error
+}
+
+// RevokeSession revokes the session with id if it's still active.
+func RevokeSession(db *gorm.DB, id uint64) error {
+	return db.Model(&Session{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now()).Error
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRevokeSessions(t *testing.T) {
+	db := setUpDatabase(t)
+
+	expires := time.Now().Add(time.Hour)
+	sessions := []*Session{{AccountID: 1, ExpiresAt: expires}, {AccountID: 1, ExpiresAt: expires}, {AccountID: 2, ExpiresAt: expires}}
+	for _, session := range sessions {
+		if err := CreateSession(db, session); err != nil {
+			t.Fatalf("CreateSession() returned an unexpected error: %v", err)
+		}
+	}
+
+	if err := RevokeSessions(db, 1); err != nil {
+		t.Fatalf("RevokeSessions() returned an unexpected error: %v", err)
+	}
+	for _, s := range sessions {
+		session, err := FindSession(db, s.ID)
+		if err != nil || session == nil {
+			t.Fatalf("FindSession() returned %v, %v", session, err)
+		}
+		if want := s.AccountID != 1; session.Valid(time.Now()) != want {
+			t.Errorf("expected session %d of account %d to have validity %v", s.ID, s.AccountID, want)
+		}
+	}
+
+	if session, err := FindSession(db, 100); err != nil || session != nil {
+		t.Errorf("FindSession() returned %v, %v for a nonexistent session", session, err)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	db := setUpDatabase(t)
+
+	expires := time.Now().Add(time.Hour)
+	sessions := []*Session{{AccountID: 1, ExpiresAt: expires}, {AccountID: 1, ExpiresAt: expires}}
+	for _, session := range sessions {
+		if err := CreateSession(db, session); err != nil {
+			t.Fatalf("CreateSession() returned an unexpected error: %v", err)
+		}
+	}
+
+	if err := RevokeSession(db, sessions[0].ID); err != nil {
+		t.Fatalf("RevokeSession() returned an unexpected error: %v", err)
+	}
+	for i, s := range sessions {
+		session, err := FindSession(db, s.ID)
+		if err != nil || session == nil {
+			t.Fatalf("FindSession() returned %v, %v", session, err)
+		}
+		if want := i != 0; session.Valid(time.Now()) != want {
+			t.Errorf("expected session %d to have validity %v", s.ID, want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package data
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Ship is a ship that has registered with the shipgate. Ships are identified by
+// name so that they keep the same ID when they (or the shipgate) restart.
+type Ship struct {
+	ID   uint64 `gorm:"primaryKey"`
+	Name string `gorm:"unique;not null"`
+
+	CreatedAt time.Time
+}
+
+// FindOrCreateShip returns the Ship named name, creating it if it doesn't exist.
+func FindOrCreateShip(db *gorm.DB, name string) (*Ship, error) {
+	ship := Ship{Name: name}
+	if err := db.Where(Ship{Name: name}).FirstOrCreate(&ship).Error; err != nil {
+		return nil, err
+	}
+	return &ship, nil
+}
@@ -0,0 +1,29 @@
+package data
+
+import (
+	"testing"
+)
+
+func TestFindOrCreateShip(t *testing.T) {
+	db := setUpDatabase(t)
+
+	first, err := FindOrCreateShip(db, "Archon")
+	if err != nil {
+		t.Fatalf("FindOrCreateShip() returned an unexpected error: %v", err)
+	}
+	other, err := FindOrCreateShip(db, "Other")
+	if err != nil {
+		t.Fatalf("FindOrCreateShip() returned an unexpected error: %v", err)
+	}
+	if first.ID == other.ID {
+		t.Errorf("expected ships to have different IDs, both got %d", first.ID)
+	}
+
+	again, err := FindOrCreateShip(db, "Archon")
+	if err != nil {
+		t.Fatalf("FindOrCreateShip() returned an unexpected error: %v", err)
+	}
+	if again.ID != first.ID {
+		t.Errorf("expected ship to keep ID %d, got %d", first.ID, again.ID)
+	}
+}
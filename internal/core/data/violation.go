@@ -0,0 +1,44 @@
+package data
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Violation is a record of a player breaking one of the block servers' anti-cheat rules.
+type Violation struct {
+	ID uint64 `gorm:"primaryKey"`
+
+	AccountID     uint64 `gorm:"index"`
+	CharacterName string
+	// Name of the rule that was broken, e.g. "meseta".
+	Rule    string `gorm:"not null"`
+	Details string
+	// Action taken by the block server (log, drop, kick, or ban).
+	Action string
+	// The block server on which the violation happened.
+	Source string
+
+	CreatedAt time.Time
+}
+
+// CreateViolation persists a Violation record to the database.
+func CreateViolation(db *gorm.DB, violation *Violation) error {
+	return db.Create(violation).Error
+}
+
+// FindViolations returns up to limit of the most recent Violation records,
+// optionally only those of the account with accountID (if non-zero).
+func FindViolations(db *gorm.DB, accountID uint64, limit int) ([]Violation, error) {
+	query := db.Order("created_at desc, id desc").Limit(limit)
+	if accountID != 0 {
+		query = query.Where("account_id = ?", accountID)
+	}
+
+	var violations []Violation
+	if err := query.Find(&violations).Error; err != nil {
+		return nil, err
+	}
+	return violations, nil
+}
@@ -0,0 +1,31 @@
+package data
+
+import (
+	"testing"
+)
+
+func TestFindViolations(t *testing.T) {
+	db := setUpDatabase(t)
+
+	for i, rule := range []string{"meseta", "item_id", "warp_floor"} {
+		if err := CreateViolation(db, &Violation{AccountID: uint64(i%2 + 1), Rule: rule}); err != nil {
+			t.Fatalf("CreateViolation() returned an unexpected error: %v", err)
+		}
+	}
+
+	violations, err := FindViolations(db, 0, 2)
+	if err != nil {
+		t.Fatalf("FindViolations() returned an unexpected error: %v", err)
+	}
+	if len(violations) != 2 || violations[0].Rule != "warp_floor" || violations[1].Rule != "item_id" {
+		t.Errorf("FindViolations() returned the wrong violations: %v", violations)
+	}
+
+	violations, err = FindViolations(db, 1, 10)
+	if err != nil {
+		t.Fatalf("FindViolations() returned an unexpected error: %v", err)
+	}
+	if len(violations) != 2 || violations[0].Rule != "warp_floor" || violations[1].Rule != "meseta" {
+		t.Errorf("FindViolations() returned the wrong violations for account 1: %v", violations)
+	}
+}
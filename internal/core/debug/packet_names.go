@@ -58,6 +58,23 @@ var packetNames = map[uint16]string{
 	packets.BlockListType:               "BlockListType",
 	packets.FullCharacterType:           "FullCharacterType",
 	packets.FullCharacterEndType:        "FullCharacterEndType",
+	packets.InfoBoardRequestType:        "InfoBoardRequestType",
+	packets.InfoBoardUpdateType:         "InfoBoardUpdateType",
+	packets.ChatType:                    "ChatType",
+	packets.TextMessageType:             "TextMessageType",
+	packets.LobbyJoinType:               "LobbyJoinType",
+	packets.LobbyEventType:              "LobbyEventType",
+	packets.CreateGameType:              "CreateGameType",
+	packets.CharacterDataType:           "CharacterDataType",
+	packets.LeaveGameType:               "LeaveGameType",
+	packets.QuestListType:               "QuestListType",
+	packets.GameCommandType:             "GameCommandType",
+	packets.GameCommandTargetType:       "GameCommandTargetType",
+	packets.GameCommandLargeType:        "GameCommandLargeType",
+	packets.GameCommandLargeTargetType:  "GameCommandLargeTargetType",
+	packets.GameJoinType:                "GameJoinType",
+	packets.GuildcardSearchType:         "GuildcardSearchType",
+	packets.GuildcardSearchResultType:   "GuildcardSearchResultType",
 }
 
 func getPacketName(server ServerType, packetType uint16) string {
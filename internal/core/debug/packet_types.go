@@ -70,6 +70,28 @@ var packetTypes = map[uint16]interface{}{
 	packets.BlockListType:               packets.BlockList{},
 	packets.FullCharacterType:           packets.FullCharacter{},
 	packets.FullCharacterEndType:        packets.BBHeader{},
+	packets.InfoBoardRequestType: multiDefinitionPacket{
+		true:  packets.BBHeader{},
+		false: packets.InfoBoard{},
+	},
+	packets.ChatType:          packets.Chat{},
+	packets.TextMessageType:   packets.TextMessage{},
+	packets.LobbyJoinType:     packets.Packet67{},
+	packets.LobbyEventType:    packets.BBHeader{},
+	packets.CreateGameType:    packets.CreateGame{},
+	packets.CharacterDataType: packets.CharacterData{},
+	packets.LeaveGameType:     packets.CharacterData{},
+	packets.QuestListType: multiDefinitionPacket{
+		true:  packets.BBHeader{},
+		false: packets.QuestList{},
+	},
+	packets.GameCommandType:            packets.GameCommand{},
+	packets.GameCommandTargetType:      packets.GameCommand{},
+	packets.GameCommandLargeType:       packets.GameCommand{},
+	packets.GameCommandLargeTargetType: packets.GameCommand{},
+	packets.GameJoinType:               packets.GameJoin{},
+	packets.GuildcardSearchType:        packets.GuildcardSearch{},
+	packets.GuildcardSearchResultType:  packets.GuildcardSearchResult{},
 }
 
 func getPacket(server ServerType, clientPacket bool, packetType uint16) reflect.Value {
@@ -0,0 +1,54 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	numDifficulties = 4
+	// Number of enemy entries per difficulty in a battle parameter file.
+	numBattleParams = 0x60
+	// Size of an enemy's stats entry. Stats for every difficulty come first in
+	// the file, followed by the enemies' attack, resistance, and movement data.
+	battleParamSize = 0x24
+)
+
+// EnemyStats are an enemy's stats on one difficulty, as stored in the
+// BattleParamEntry files.
+type EnemyStats struct {
+	ATP, MST, EVP, HP, DFP, ATA, LCK, ESP uint16
+	Unknown1, Unknown2                    float32
+	Unknown3                              uint32
+	Experience                            uint32
+	Unknown4                              uint32
+}
+
+// BattleParams holds the enemy stats from one of the BattleParamEntry files.
+type BattleParams struct {
+	stats [numDifficulties][numBattleParams]EnemyStats
+}
+
+// ParseBattleParams reads the enemy stats for every difficulty from the contents
+// of a BattleParamEntry file.
+func ParseBattleParams(data []byte) (*BattleParams, error) {
+	if len(data) < numDifficulties*numBattleParams*battleParamSize {
+		return nil, fmt.Errorf("battle parameter file is too short (%d bytes)", len(data))
+	}
+
+	params := &BattleParams{}
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &params.stats); err != nil {
+		return nil, fmt.Errorf("error reading battle parameters: %w", err)
+	}
+	return params, nil
+}
+
+// Stats returns the stats of the enemy with bpIndex on difficulty (0 for Normal
+// through 3 for Ultimate), or nil if either is out of range.
+func (p *BattleParams) Stats(difficulty, bpIndex int) *EnemyStats {
+	if difficulty < 0 || difficulty >= numDifficulties || bpIndex < 0 || bpIndex >= numBattleParams {
+		return nil
+	}
+	return &p.stats[difficulty][bpIndex]
+}
@@ -0,0 +1,216 @@
+package maps
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Chance of a rare variant (e.g. Hildeblue or Al Rappy) spawning in place of an enemy.
+const defaultRareRate = 1.0 / 512
+
+const (
+	// Ep2 lilies are Del Lilies from the Central Control Area onwards.
+	ep2FirstCCAFloor = 0x05
+	// Sinow Zoa entries are Epsilon in the Control Tower.
+	ep2TowerFloor = 0x11
+	// Ep4 enemies have different stats in the desert than in the crater.
+	ep4FirstDesertFloor = 0x06
+)
+
+// Enemy is a single enemy in a game, identified by its position in the game's enemy list.
+type Enemy struct {
+	Name  string
+	Floor int
+	// Index of the enemy's stats in the episode's battle parameter files, or -1
+	// if the enemy type is unknown.
+	BPIndex int
+	Rare    bool
+}
+
+type enemyType struct {
+	name    string
+	bpIndex int
+}
+
+// enemyDef describes how an entry in an enemy file expands into the enemies in a game.
+type enemyDef struct {
+	// Variants selected by the entry's skin. Out of range skins use the first one.
+	variants []enemyType
+	// Variant that occasionally spawns instead of the normal one.
+	rare     *enemyType
+	rareRate float64
+	// Enemies that are part of this one and spawn along with it (e.g. Pan Arms
+	// splitting into Hidoom and Migium).
+	parts []enemyType
+	// Number of children spawned regardless of the count in the entry, and the
+	// type they spawn as if different from the parent.
+	children int
+	child    *enemyType
+}
+
+// Enemies that appear in Episode 1 and the Episode 2 VR areas.
+var ep1Enemies = map[uint16]*enemyDef{
+	0x40: {variants: []enemyType{{"Hildebear", 0x49}}, rare: &enemyType{"Hildeblue", 0x4A}},
+	0x41: {variants: []enemyType{{"Rag Rappy", 0x18}}, rare: &enemyType{"Al Rappy", 0x19}},
+	0x42: {variants: []enemyType{{"Monest", 0x01}}, children: 30, child: &enemyType{"Mothmant", 0x00}},
+	0x43: {variants: []enemyType{{"Savage Wolf", 0x02}, {"Barbarous Wolf", 0x03}}},
+	0x44: {variants: []enemyType{{"Booma", 0x4B}, {"Gobooma", 0x4C}, {"Gigobooma", 0x4D}}},
+	0x60: {variants: []enemyType{{"Grass Assassin", 0x4E}}},
+	0x61: {variants: []enemyType{{"Poison Lily", 0x04}}, rare: &enemyType{"Nar Lily", 0x05}},
+	0x62: {variants: []enemyType{{"Nano Dragon", 0x1A}}},
+	0x63: {variants: []enemyType{{"Evil Shark", 0x4F}, {"Pal Shark", 0x50}, {"Guil Shark", 0x51}}},
+	0x64: {variants: []enemyType{{"Pofuilly Slime", 0x30}}, rare: &enemyType{"Pouilly Slime", 0x30}, children: 4},
+	0x65: {variants: []enemyType{{"Pan Arms", 0x31}}, parts: []enemyType{{"Hidoom", 0x32}, {"Migium", 0x33}}},
+	0x80: {variants: []enemyType{{"Dubchic", 0x1B}, {"Gillchic", 0x1C}}},
+	0x81: {variants: []enemyType{{"Garanz", 0x1D}}},
+	0x82: {variants: []enemyType{{"Sinow Beat", 0x06}, {"Sinow Gold", 0x13}}},
+	0x83: {variants: []enemyType{{"Canadine", 0x07}}},
+	0x84: {variants: []enemyType{{"Canane", 0x09}}, children: 8, child: &enemyType{"Canadine", 0x08}},
+	0xA0: {variants: []enemyType{{"Delsaber", 0x52}}},
+	0xA1: {variants: []enemyType{{"Chaos Sorcerer", 0x0A}}, parts: []enemyType{{"Bee R", 0x0B}, {"Bee L", 0x0C}}},
+	0xA2: {variants: []enemyType{{"Dark Gunner", 0x1E}}},
+	0xA4: {variants: []enemyType{{"Chaos Bringer", 0x0D}}},
+	0xA5: {variants: []enemyType{{"Dark Belra", 0x0E}}},
+	0xA6: {variants: []enemyType{{"Dimenian", 0x53}, {"La Dimenian", 0x54}, {"So Dimenian", 0x55}}},
+	0xA7: {variants: []enemyType{{"Bulclaw", 0x1F}}, child: &enemyType{"Claw", 0x20}},
+	0xA8: {variants: []enemyType{{"Claw", 0x20}}},
+	0xC0: {variants: []enemyType{{"Dragon", 0x12}}},
+	0xC1: {variants: []enemyType{{"De Rol Le", 0x0F}}},
+	0xC2: {variants: []enemyType{{"Vol Opt", 0x25}}},
+	0xC8: {variants: []enemyType{{"Dark Falz", 0x37}}, children: 510, child: &enemyType{"Darvant", 0x35}},
+}
+
+// Enemies that only appear in Episode 2 or have different stats there. Anything
+// else is looked up in the Episode 1 definitions.
+var ep2Enemies = map[uint16]*enemyDef{
+	0x41: {variants: []enemyType{{"Rag Rappy", 0x18}}, rare: &enemyType{"Love Rappy", 0x19}},
+	0xC0: {variants: []enemyType{{"Gal Gryphon", 0x1E}}},
+	0xCA: {variants: []enemyType{{"Olga Flow", 0x2C}}},
+	0xCB: {variants: []enemyType{{"Barba Ray", 0x0F}}, children: 47, child: &enemyType{"Pig Ray", 0x10}},
+	0xCC: {variants: []enemyType{{"Gol Dragon", 0x12}}},
+	0xD4: {variants: []enemyType{{"Sinow Berill", 0x06}, {"Sinow Spigell", 0x13}}},
+	0xD5: {variants: []enemyType{{"Merillia", 0x4B}, {"Meriltas", 0x4C}}},
+	0xD6: {variants: []enemyType{{"Mericarol", 0x3A}, {"Merikle", 0x45}, {"Mericus", 0x46}}},
+	0xD7: {variants: []enemyType{{"Ul Gibbon", 0x3B}, {"Zol Gibbon", 0x3C}}},
+	0xD8: {variants: []enemyType{{"Gibbles", 0x3D}}},
+	0xD9: {variants: []enemyType{{"Gee", 0x07}}},
+	0xDA: {variants: []enemyType{{"Gi Gue", 0x0A}}},
+	0xDB: {variants: []enemyType{{"Deldepth", 0x30}}},
+	0xDC: {variants: []enemyType{{"Delbiter", 0x0D}}},
+	0xDD: {variants: []enemyType{{"Dolmolm", 0x4F}, {"Dolmdarl", 0x50}}},
+	0xDE: {variants: []enemyType{{"Morfos", 0x40}}},
+	0xDF: {variants: []enemyType{{"Recobox", 0x41}}, child: &enemyType{"Recon", 0x42}},
+	0xE0: {variants: []enemyType{{"Sinow Zoa", 0x43}, {"Sinow Zele", 0x44}}},
+	0xE1: {variants: []enemyType{{"Ill Gill", 0x26}}},
+}
+
+var (
+	ep2DelLily = &enemyDef{variants: []enemyType{{"Del Lily", 0x25}}}
+	ep2Epsilon = &enemyDef{variants: []enemyType{{"Epsilon", 0x23}}, children: 4, child: &enemyType{"Epsigard", 0x24}}
+)
+
+// Episode 4 enemies in the Crater.
+var ep4CraterEnemies = map[uint16]*enemyDef{
+	0x41:  {variants: []enemyType{{"Sand Rappy", 0x05}}, rare: &enemyType{"Del Rappy", 0x06}},
+	0x110: {variants: []enemyType{{"Astark", 0x09}}},
+	0x111: {variants: []enemyType{{"Satellite Lizard", 0x0D}, {"Yowie", 0x0E}}},
+	0x112: {variants: []enemyType{{"Merissa A", 0x19}}, rare: &enemyType{"Merissa AA", 0x1A}},
+	0x113: {variants: []enemyType{{"Girtablulu", 0x1F}}},
+	0x114: {variants: []enemyType{{"Zu", 0x07}}, rare: &enemyType{"Pazuzu", 0x08}},
+	0x115: {variants: []enemyType{{"Boota", 0x00}, {"Ze Boota", 0x01}, {"Ba Boota", 0x03}}},
+	0x116: {variants: []enemyType{{"Dorphon", 0x0F}}, rare: &enemyType{"Dorphon Eclair", 0x10}},
+	0x117: {variants: []enemyType{{"Goran", 0x11}, {"Pyro Goran", 0x12}, {"Goran Detonator", 0x13}}},
+	0x119: {
+		variants: []enemyType{{"Saint-Million", 0x22}, {"Shambertin", 0x26}},
+		rare:     &enemyType{"Kondrieu", 0x2A},
+		rareRate: 0.1,
+	},
+}
+
+// Episode 4 enemies in the Desert. Anything else is looked up in the Crater definitions.
+var ep4DesertEnemies = map[uint16]*enemyDef{
+	0x41:  {variants: []enemyType{{"Sand Rappy", 0x17}}, rare: &enemyType{"Del Rappy", 0x18}},
+	0x111: {variants: []enemyType{{"Satellite Lizard", 0x1D}, {"Yowie", 0x1E}}},
+	0x114: {variants: []enemyType{{"Zu", 0x1B}}, rare: &enemyType{"Pazuzu", 0x1C}},
+}
+
+// definition returns how an enemy entry expands on a floor of an episode, or nil
+// if the base type isn't known.
+func definition(episode, floor int, baseType uint16) *enemyDef {
+	switch episode {
+	case 1:
+		return ep1Enemies[baseType]
+	case 2:
+		switch {
+		case baseType == 0x61 && floor >= ep2FirstCCAFloor:
+			return ep2DelLily
+		case baseType == 0xE0 && floor == ep2TowerFloor:
+			return ep2Epsilon
+		}
+		if def, ok := ep2Enemies[baseType]; ok {
+			return def
+		}
+		return ep1Enemies[baseType]
+	case 4:
+		if def, ok := ep4DesertEnemies[baseType]; ok && floor >= ep4FirstDesertFloor {
+			return def
+		}
+		return ep4CraterEnemies[baseType]
+	default:
+		return nil
+	}
+}
+
+// expandEnemies converts the entries from a floor's enemy file into the list of
+// enemies they spawn, in the order the client assigns their IDs.
+func expandEnemies(episode, floor int, entries []EnemyEntry, rng *rand.Rand) []Enemy {
+	var enemies []Enemy
+	for _, entry := range entries {
+		def := definition(episode, floor, entry.BaseType)
+		if def == nil {
+			// Unknown enemies still take up IDs so that the ones after them line up.
+			unknown := Enemy{Name: fmt.Sprintf("Unknown %#x", entry.BaseType), Floor: floor, BPIndex: -1}
+			for i := 0; i <= int(entry.NumChildren); i++ {
+				enemies = append(enemies, unknown)
+			}
+			continue
+		}
+
+		enemies = append(enemies, def.spawn(floor, entry.Skin, rng))
+		for _, part := range def.parts {
+			enemies = append(enemies, Enemy{Name: part.name, Floor: floor, BPIndex: part.bpIndex})
+		}
+
+		children := int(entry.NumChildren)
+		if def.children > 0 {
+			children = def.children
+		}
+		for i := 0; i < children; i++ {
+			if def.child != nil {
+				enemies = append(enemies, Enemy{Name: def.child.name, Floor: floor, BPIndex: def.child.bpIndex})
+			} else {
+				enemies = append(enemies, def.spawn(floor, entry.Skin, rng))
+			}
+		}
+	}
+	return enemies
+}
+
+// spawn picks the variant of the enemy to create, rolling for its rare variant if it has one.
+func (d *enemyDef) spawn(floor int, skin uint32, rng *rand.Rand) Enemy {
+	if d.rare != nil {
+		rate := d.rareRate
+		if rate == 0 {
+			rate = defaultRareRate
+		}
+		if rng.Float64() < rate {
+			return Enemy{Name: d.rare.name, Floor: floor, BPIndex: d.rare.bpIndex, Rare: true}
+		}
+	}
+
+	variant := d.variants[0]
+	if int(skin) < len(d.variants) {
+		variant = d.variants[skin]
+	}
+	return Enemy{Name: variant.name, Floor: floor, BPIndex: variant.bpIndex}
+}
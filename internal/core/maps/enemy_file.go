@@ -0,0 +1,48 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// enemyEntrySize is the size of one enemy entry in a map's enemy file.
+const enemyEntrySize = 0x48
+
+// EnemyEntry is one of the enemy placements in a map's enemy (*e.dat) file.
+type EnemyEntry struct {
+	BaseType uint16
+	Unknown1 uint16
+	Unknown2 uint32
+	// Number of copies of the enemy spawned along with it.
+	NumChildren uint16
+	Floor       uint16
+	EntityID    uint16
+	Section     uint16
+	WaveNumber  uint16
+	WaveNumber2 uint16
+
+	X, Y, Z                float32
+	XAngle, YAngle, ZAngle uint32
+
+	Param1 float32
+	// Selects between the variants of an enemy (e.g. Booma, Gobooma, and Gigobooma).
+	Skin           uint32
+	Param3         float32
+	Param4, Param5 float32
+	Param6, Param7 uint16
+	Unused         uint32
+}
+
+// ParseEnemyFile reads the enemy entries from the contents of an enemy file.
+func ParseEnemyFile(data []byte) ([]EnemyEntry, error) {
+	if len(data)%enemyEntrySize != 0 {
+		return nil, fmt.Errorf("enemy file size %d is not a multiple of %d", len(data), enemyEntrySize)
+	}
+
+	entries := make([]EnemyEntry, len(data)/enemyEntrySize)
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, entries); err != nil {
+		return nil, fmt.Errorf("error reading enemy entries: %w", err)
+	}
+	return entries, nil
+}
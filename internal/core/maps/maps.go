@@ -0,0 +1,138 @@
+// Package maps generates the layout of a game's floors and the enemies on them
+// from the map data files extracted from the client.
+package maps
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Number of floors whose map variations are sent to clients joining a game.
+const maxVariationFloors = 16
+
+// FloorLayout describes the map data files for one floor of an episode.
+type FloorLayout struct {
+	Floor int    `mapstructure:"floor"`
+	Name  string `mapstructure:"name"`
+	// Name of the floor's enemy files. {var1} and {var2} are replaced with the
+	// two-digit numbers of the chosen map variation.
+	Enemies string `mapstructure:"enemies"`
+	// Number of choices for each of the floor's two map variations.
+	Variations []int `mapstructure:"variations"`
+}
+
+// EpisodeLayout lists the floors of an episode that have map data.
+type EpisodeLayout struct {
+	Episode int            `mapstructure:"episode"`
+	Floors  []*FloorLayout `mapstructure:"floors"`
+}
+
+type floor struct {
+	layout *FloorLayout
+	// Enemy entries for each variation of the floor that has an enemy file.
+	enemies map[[2]int][]EnemyEntry
+}
+
+// Maps holds the parsed map data for every episode.
+type Maps struct {
+	episodes map[int][]*floor
+}
+
+// GameMap is the layout generated for a single game.
+type GameMap struct {
+	// Map variation pairs for each floor in the format sent to the client.
+	Variations [maxVariationFloors * 2]uint32
+	// Every enemy in the game, indexed by the enemy ID used by the client.
+	Enemies []Enemy
+}
+
+// Load reads the enemy files for every floor and variation in layouts from dir.
+// Files that don't exist are skipped and their paths returned so that the caller
+// can report them; those floors are generated without enemies.
+func Load(dir string, layouts []*EpisodeLayout) (*Maps, []string, error) {
+	m := &Maps{episodes: make(map[int][]*floor)}
+	var missing []string
+
+	for _, episode := range layouts {
+		switch episode.Episode {
+		case 1, 2, 4:
+		default:
+			return nil, nil, fmt.Errorf("invalid episode in map layout: %d", episode.Episode)
+		}
+
+		for _, layout := range episode.Floors {
+			if err := layout.check(); err != nil {
+				return nil, nil, fmt.Errorf("invalid layout for episode %d floor %d: %w", episode.Episode, layout.Floor, err)
+			}
+
+			f := &floor{layout: layout, enemies: make(map[[2]int][]EnemyEntry)}
+			for var1 := 0; var1 < layout.Variations[0] && layout.Enemies != ""; var1++ {
+				for var2 := 0; var2 < layout.Variations[1]; var2++ {
+					path := filepath.Join(dir, layout.enemyFile(var1, var2))
+					data, err := os.ReadFile(path)
+					if os.IsNotExist(err) {
+						missing = append(missing, path)
+						continue
+					} else if err != nil {
+						return nil, nil, fmt.Errorf("error reading enemy file: %w", err)
+					}
+
+					entries, err := ParseEnemyFile(data)
+					if err != nil {
+						return nil, nil, fmt.Errorf("error parsing %s: %w", path, err)
+					}
+					f.enemies[[2]int{var1, var2}] = entries
+				}
+			}
+			m.episodes[episode.Episode] = append(m.episodes[episode.Episode], f)
+		}
+
+		// Enemy IDs are numbered in floor order.
+		floors := m.episodes[episode.Episode]
+		sort.Slice(floors, func(i, j int) bool { return floors[i].layout.Floor < floors[j].layout.Floor })
+		for i := 1; i < len(floors); i++ {
+			if floors[i].layout.Floor == floors[i-1].layout.Floor {
+				return nil, nil, fmt.Errorf("duplicate layout for episode %d floor %d", episode.Episode, floors[i].layout.Floor)
+			}
+		}
+	}
+	return m, missing, nil
+}
+
+func (l *FloorLayout) check() error {
+	if l.Floor < 0 {
+		return fmt.Errorf("floor cannot be negative")
+	}
+	if len(l.Variations) != 2 || l.Variations[0] < 1 || l.Variations[1] < 1 {
+		return fmt.Errorf("variations must be two positive numbers")
+	}
+	return nil
+}
+
+func (l *FloorLayout) enemyFile(var1, var2 int) string {
+	return strings.NewReplacer(
+		"{var1}", fmt.Sprintf("%02d", var1),
+		"{var2}", fmt.Sprintf("%02d", var2),
+	).Replace(l.Enemies)
+}
+
+// Generate picks a map variation for each floor of episode (1, 2, or 4) and
+// builds the list of enemies in the game. Rare enemies are rolled with rng, so
+// a generator seeded the same way produces the same game.
+func (m *Maps) Generate(episode int, rng *rand.Rand) *GameMap {
+	gm := &GameMap{}
+	for _, f := range m.episodes[episode] {
+		var1 := rng.Intn(f.layout.Variations[0])
+		var2 := rng.Intn(f.layout.Variations[1])
+		if f.layout.Floor < maxVariationFloors {
+			gm.Variations[f.layout.Floor*2] = uint32(var1)
+			gm.Variations[f.layout.Floor*2+1] = uint32(var2)
+		}
+		gm.Enemies = append(gm.Enemies, expandEnemies(episode, f.layout.Floor, f.enemies[[2]int{var1, var2}], rng)...)
+	}
+	return gm
+}
@@ -0,0 +1,171 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeEnemyFile(t *testing.T, path string, entries []EnemyEntry) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, binary.LittleEndian, entries); err != nil {
+		t.Fatalf("error encoding enemy entries: %v", err)
+	}
+	if buf.Len() != len(entries)*enemyEntrySize {
+		t.Fatalf("encoded entries are %d bytes, want %d", buf.Len(), len(entries)*enemyEntrySize)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("error writing enemy file: %v", err)
+	}
+}
+
+func TestParseEnemyFile(t *testing.T) {
+	if _, err := ParseEnemyFile(make([]byte, enemyEntrySize+1)); err == nil {
+		t.Error("expected an error for a truncated file")
+	}
+
+	data := make([]byte, enemyEntrySize*2)
+	data[enemyEntrySize] = 0x44
+	data[enemyEntrySize+0x08] = 2
+	data[enemyEntrySize+0x30] = 1
+	entries, err := ParseEnemyFile(data)
+	if err != nil {
+		t.Fatalf("error parsing enemy file: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if e := entries[1]; e.BaseType != 0x44 || e.NumChildren != 2 || e.Skin != 1 {
+		t.Errorf("got entry %+v, want base type 0x44 with 2 children and skin 1", e)
+	}
+}
+
+func TestExpandEnemies(t *testing.T) {
+	tests := []struct {
+		name    string
+		episode int
+		floor   int
+		entry   EnemyEntry
+		want    []string
+	}{
+		{name: "skin variant", episode: 1, floor: 1, entry: EnemyEntry{BaseType: 0x44, Skin: 2}, want: []string{"Gigobooma"}},
+		{name: "children", episode: 1, floor: 1, entry: EnemyEntry{BaseType: 0x43, NumChildren: 2}, want: []string{"Savage Wolf", "Savage Wolf", "Savage Wolf"}},
+		{name: "parts", episode: 1, floor: 3, entry: EnemyEntry{BaseType: 0x65}, want: []string{"Pan Arms", "Hidoom", "Migium"}},
+		{name: "unknown enemy", episode: 1, floor: 1, entry: EnemyEntry{BaseType: 0x1234, NumChildren: 1}, want: []string{"Unknown 0x1234", "Unknown 0x1234"}},
+		{name: "episode 2 enemy", episode: 2, floor: 6, entry: EnemyEntry{BaseType: 0xD6, Skin: 1}, want: []string{"Merikle"}},
+		{name: "episode 2 VR enemy", episode: 2, floor: 1, entry: EnemyEntry{BaseType: 0x40}, want: []string{"Hildebear"}},
+		{name: "episode 2 floor override", episode: 2, floor: 6, entry: EnemyEntry{BaseType: 0x61}, want: []string{"Del Lily"}},
+		{name: "episode 4 crater", episode: 4, floor: 1, entry: EnemyEntry{BaseType: 0x115, Skin: 1}, want: []string{"Ze Boota"}},
+		{name: "episode 4 desert", episode: 4, floor: 7, entry: EnemyEntry{BaseType: 0x111, Skin: 1}, want: []string{"Yowie"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A source that always rolls 0.5, well above the rare rate.
+			rng := rand.New(constSource(1 << 62))
+			enemies := expandEnemies(tt.episode, tt.floor, []EnemyEntry{tt.entry}, rng)
+			if len(enemies) != len(tt.want) {
+				t.Fatalf("got %d enemies, want %d", len(enemies), len(tt.want))
+			}
+			for i, enemy := range enemies {
+				if enemy.Name != tt.want[i] || enemy.Floor != tt.floor {
+					t.Errorf("enemy %d = %+v, want %s on floor %d", i, enemy, tt.want[i], tt.floor)
+				}
+			}
+		})
+	}
+
+	monest := expandEnemies(1, 1, []EnemyEntry{{BaseType: 0x42}}, rand.New(rand.NewSource(1)))
+	if len(monest) != 31 || monest[0].Name != "Monest" || monest[30].Name != "Mothmant" {
+		t.Errorf("expected a Monest followed by 30 Mothmants, got %d enemies", len(monest))
+	}
+
+	// A source that always rolls 0 spawns the rare variant every time.
+	rare := expandEnemies(4, 7, []EnemyEntry{{BaseType: 0x114}}, rand.New(constSource(0)))
+	if !rare[0].Rare || rare[0].Name != "Pazuzu" || rare[0].BPIndex != 0x1C {
+		t.Errorf("got %+v, want a rare desert Pazuzu", rare[0])
+	}
+}
+
+type constSource int64
+
+func (s constSource) Int63() int64 { return int64(s) }
+func (s constSource) Seed(int64)   {}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	writeEnemyFile(t, filepath.Join(dir, "map_forest01_00_01e.dat"), []EnemyEntry{{BaseType: 0x40}, {BaseType: 0x44}})
+	writeEnemyFile(t, filepath.Join(dir, "map_cave01_00e.dat"), []EnemyEntry{{BaseType: 0x60}})
+
+	m, missing, err := Load(dir, []*EpisodeLayout{{
+		Episode: 1,
+		Floors: []*FloorLayout{
+			// Out of order to make sure enemies are still numbered by floor.
+			{Floor: 3, Name: "Cave 1", Enemies: "map_cave01_{var1}e.dat", Variations: []int{2, 1}},
+			{Floor: 1, Name: "Forest 1", Enemies: "map_forest01_{var1}_{var2}e.dat", Variations: []int{1, 2}},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("error loading maps: %v", err)
+	}
+	if len(missing) != 2 {
+		t.Errorf("got %d missing files, want 2: %v", len(missing), missing)
+	}
+
+	// Try a few seeds to cover both the variations with and without enemy files.
+	for seed := int64(0); seed < 20; seed++ {
+		gm := m.Generate(1, rand.New(rand.NewSource(seed)))
+		var want []string
+		if gm.Variations[3] == 1 {
+			want = append(want, "Hildebear", "Booma")
+		}
+		if gm.Variations[6] == 0 {
+			want = append(want, "Grass Assassin")
+		}
+
+		var got []string
+		for _, enemy := range gm.Enemies {
+			if !enemy.Rare {
+				got = append(got, enemy.Name)
+			}
+		}
+		if len(got) != len(want) {
+			t.Fatalf("seed %d: got enemies %v, want %v", seed, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("seed %d: got enemies %v, want %v", seed, got, want)
+			}
+		}
+	}
+
+	if _, _, err := Load(dir, []*EpisodeLayout{{Episode: 3}}); err == nil {
+		t.Error("expected an error for an invalid episode")
+	}
+	if _, _, err := Load(dir, []*EpisodeLayout{{Episode: 1, Floors: []*FloorLayout{{Floor: 1, Variations: []int{1}}}}}); err == nil {
+		t.Error("expected an error for invalid variations")
+	}
+}
+
+func TestParseBattleParams(t *testing.T) {
+	if _, err := ParseBattleParams(make([]byte, 10)); err == nil {
+		t.Error("expected an error for a truncated file")
+	}
+
+	data := make([]byte, numDifficulties*numBattleParams*battleParamSize)
+	// Ultimate Hildebear experience.
+	binary.LittleEndian.PutUint32(data[(3*numBattleParams+0x49)*battleParamSize+0x1C:], 300)
+	params, err := ParseBattleParams(data)
+	if err != nil {
+		t.Fatalf("error parsing battle params: %v", err)
+	}
+	if got := params.Stats(3, 0x49).Experience; got != 300 {
+		t.Errorf("got experience %d, want 300", got)
+	}
+	if params.Stats(4, 0) != nil || params.Stats(0, -1) != nil {
+		t.Error("expected nil stats for out of range lookups")
+	}
+}
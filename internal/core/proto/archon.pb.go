@@ -30,6 +30,8 @@ type Ship struct {
 	Ip          string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
 	Port        string `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
 	PlayerCount int32  `protobuf:"varint,5,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	// Number of players the ship can hold, or 0 if there's no limit.
+	MaxPlayers int32 `protobuf:"varint,6,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
 }
 
 func (x *Ship) Reset() {
@@ -99,6 +101,13 @@ func (x *Ship) GetPlayerCount() int32 {
 	return 0
 }
 
+func (x *Ship) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
 type Account struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -223,43 +232,46 @@ type Character struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Guildcard         uint64  `protobuf:"varint,2,opt,name=guildcard,proto3" json:"guildcard,omitempty"`
-	GuildcardStr      []byte  `protobuf:"bytes,3,opt,name=guildcard_str,json=guildcardStr,proto3" json:"guildcard_str,omitempty"`
-	Slot              uint32  `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
-	Experience        uint32  `protobuf:"varint,5,opt,name=experience,proto3" json:"experience,omitempty"`
-	Level             uint32  `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
-	NameColor         uint32  `protobuf:"varint,7,opt,name=name_color,json=nameColor,proto3" json:"name_color,omitempty"`
-	ModelType         int32   `protobuf:"varint,8,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
-	NameColorChecksum uint32  `protobuf:"varint,9,opt,name=name_color_checksum,json=nameColorChecksum,proto3" json:"name_color_checksum,omitempty"`
-	SectionId         int32   `protobuf:"varint,10,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
-	Class             int32   `protobuf:"varint,11,opt,name=class,proto3" json:"class,omitempty"`
-	V2Flags           int32   `protobuf:"varint,12,opt,name=v2_flags,json=v2Flags,proto3" json:"v2_flags,omitempty"`
-	Version           int32   `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
-	V1Flags           uint32  `protobuf:"varint,14,opt,name=v1_flags,json=v1Flags,proto3" json:"v1_flags,omitempty"`
-	Costume           uint32  `protobuf:"varint,15,opt,name=costume,proto3" json:"costume,omitempty"`
-	Skin              uint32  `protobuf:"varint,16,opt,name=skin,proto3" json:"skin,omitempty"`
-	Face              uint32  `protobuf:"varint,17,opt,name=face,proto3" json:"face,omitempty"`
-	Head              uint32  `protobuf:"varint,18,opt,name=head,proto3" json:"head,omitempty"`
-	Hair              uint32  `protobuf:"varint,19,opt,name=hair,proto3" json:"hair,omitempty"`
-	HairRed           uint32  `protobuf:"varint,20,opt,name=hair_red,json=hairRed,proto3" json:"hair_red,omitempty"`
-	HairGreen         uint32  `protobuf:"varint,21,opt,name=hair_green,json=hairGreen,proto3" json:"hair_green,omitempty"`
-	HairBlue          uint32  `protobuf:"varint,22,opt,name=hair_blue,json=hairBlue,proto3" json:"hair_blue,omitempty"`
-	ProportionX       float32 `protobuf:"fixed32,23,opt,name=proportion_x,json=proportionX,proto3" json:"proportion_x,omitempty"`
-	ProportionY       float32 `protobuf:"fixed32,24,opt,name=proportion_y,json=proportionY,proto3" json:"proportion_y,omitempty"`
-	ReadableName      string  `protobuf:"bytes,25,opt,name=readable_name,json=readableName,proto3" json:"readable_name,omitempty"`
-	Name              []byte  `protobuf:"bytes,26,opt,name=name,proto3" json:"name,omitempty"`
-	Playtime          uint32  `protobuf:"varint,27,opt,name=playtime,proto3" json:"playtime,omitempty"`
-	Atp               uint32  `protobuf:"varint,28,opt,name=atp,proto3" json:"atp,omitempty"`
-	Mst               uint32  `protobuf:"varint,29,opt,name=mst,proto3" json:"mst,omitempty"`
-	Evp               uint32  `protobuf:"varint,30,opt,name=evp,proto3" json:"evp,omitempty"`
-	Hp                uint32  `protobuf:"varint,31,opt,name=hp,proto3" json:"hp,omitempty"`
-	Dfp               uint32  `protobuf:"varint,32,opt,name=dfp,proto3" json:"dfp,omitempty"`
-	Ata               uint32  `protobuf:"varint,33,opt,name=ata,proto3" json:"ata,omitempty"`
-	Lck               uint32  `protobuf:"varint,34,opt,name=lck,proto3" json:"lck,omitempty"`
-	Meseta            uint32  `protobuf:"varint,35,opt,name=meseta,proto3" json:"meseta,omitempty"`
-	HpMaterialsUsed   int32   `protobuf:"varint,36,opt,name=hp_materials_used,json=hpMaterialsUsed,proto3" json:"hp_materials_used,omitempty"`
-	TpMaterialsUsed   int32   `protobuf:"varint,37,opt,name=tp_materials_used,json=tpMaterialsUsed,proto3" json:"tp_materials_used,omitempty"`
+	Id                uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Guildcard         uint64             `protobuf:"varint,2,opt,name=guildcard,proto3" json:"guildcard,omitempty"`
+	GuildcardStr      []byte             `protobuf:"bytes,3,opt,name=guildcard_str,json=guildcardStr,proto3" json:"guildcard_str,omitempty"`
+	Slot              uint32             `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Experience        uint32             `protobuf:"varint,5,opt,name=experience,proto3" json:"experience,omitempty"`
+	Level             uint32             `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	NameColor         uint32             `protobuf:"varint,7,opt,name=name_color,json=nameColor,proto3" json:"name_color,omitempty"`
+	ModelType         int32              `protobuf:"varint,8,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	NameColorChecksum uint32             `protobuf:"varint,9,opt,name=name_color_checksum,json=nameColorChecksum,proto3" json:"name_color_checksum,omitempty"`
+	SectionId         int32              `protobuf:"varint,10,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Class             int32              `protobuf:"varint,11,opt,name=class,proto3" json:"class,omitempty"`
+	V2Flags           int32              `protobuf:"varint,12,opt,name=v2_flags,json=v2Flags,proto3" json:"v2_flags,omitempty"`
+	Version           int32              `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	V1Flags           uint32             `protobuf:"varint,14,opt,name=v1_flags,json=v1Flags,proto3" json:"v1_flags,omitempty"`
+	Costume           uint32             `protobuf:"varint,15,opt,name=costume,proto3" json:"costume,omitempty"`
+	Skin              uint32             `protobuf:"varint,16,opt,name=skin,proto3" json:"skin,omitempty"`
+	Face              uint32             `protobuf:"varint,17,opt,name=face,proto3" json:"face,omitempty"`
+	Head              uint32             `protobuf:"varint,18,opt,name=head,proto3" json:"head,omitempty"`
+	Hair              uint32             `protobuf:"varint,19,opt,name=hair,proto3" json:"hair,omitempty"`
+	HairRed           uint32             `protobuf:"varint,20,opt,name=hair_red,json=hairRed,proto3" json:"hair_red,omitempty"`
+	HairGreen         uint32             `protobuf:"varint,21,opt,name=hair_green,json=hairGreen,proto3" json:"hair_green,omitempty"`
+	HairBlue          uint32             `protobuf:"varint,22,opt,name=hair_blue,json=hairBlue,proto3" json:"hair_blue,omitempty"`
+	ProportionX       float32            `protobuf:"fixed32,23,opt,name=proportion_x,json=proportionX,proto3" json:"proportion_x,omitempty"`
+	ProportionY       float32            `protobuf:"fixed32,24,opt,name=proportion_y,json=proportionY,proto3" json:"proportion_y,omitempty"`
+	ReadableName      string             `protobuf:"bytes,25,opt,name=readable_name,json=readableName,proto3" json:"readable_name,omitempty"`
+	Name              []byte             `protobuf:"bytes,26,opt,name=name,proto3" json:"name,omitempty"`
+	Playtime          uint32             `protobuf:"varint,27,opt,name=playtime,proto3" json:"playtime,omitempty"`
+	Atp               uint32             `protobuf:"varint,28,opt,name=atp,proto3" json:"atp,omitempty"`
+	Mst               uint32             `protobuf:"varint,29,opt,name=mst,proto3" json:"mst,omitempty"`
+	Evp               uint32             `protobuf:"varint,30,opt,name=evp,proto3" json:"evp,omitempty"`
+	Hp                uint32             `protobuf:"varint,31,opt,name=hp,proto3" json:"hp,omitempty"`
+	Dfp               uint32             `protobuf:"varint,32,opt,name=dfp,proto3" json:"dfp,omitempty"`
+	Ata               uint32             `protobuf:"varint,33,opt,name=ata,proto3" json:"ata,omitempty"`
+	Lck               uint32             `protobuf:"varint,34,opt,name=lck,proto3" json:"lck,omitempty"`
+	Meseta            uint32             `protobuf:"varint,35,opt,name=meseta,proto3" json:"meseta,omitempty"`
+	HpMaterialsUsed   int32              `protobuf:"varint,36,opt,name=hp_materials_used,json=hpMaterialsUsed,proto3" json:"hp_materials_used,omitempty"`
+	TpMaterialsUsed   int32              `protobuf:"varint,37,opt,name=tp_materials_used,json=tpMaterialsUsed,proto3" json:"tp_materials_used,omitempty"`
+	InfoBoard         []byte             `protobuf:"bytes,38,opt,name=info_board,json=infoBoard,proto3" json:"info_board,omitempty"`
+	ChallengeTitle    string             `protobuf:"bytes,39,opt,name=challenge_title,json=challengeTitle,proto3" json:"challenge_title,omitempty"`
+	ChallengeRecords  []*ChallengeRecord `protobuf:"bytes,40,rep,name=challenge_records,json=challengeRecords,proto3" json:"challenge_records,omitempty"`
 }
 
 func (x *Character) Reset() {
@@ -553,6 +565,99 @@ func (x *Character) GetTpMaterialsUsed() int32 {
 	return 0
 }
 
+func (x *Character) GetInfoBoard() []byte {
+	if x != nil {
+		return x.InfoBoard
+	}
+	return nil
+}
+
+func (x *Character) GetChallengeTitle() string {
+	if x != nil {
+		return x.ChallengeTitle
+	}
+	return ""
+}
+
+func (x *Character) GetChallengeRecords() []*ChallengeRecord {
+	if x != nil {
+		return x.ChallengeRecords
+	}
+	return nil
+}
+
+// ChallengeRecord is a character's best clear of one challenge mode stage.
+type ChallengeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Episode   uint32 `protobuf:"varint,1,opt,name=episode,proto3" json:"episode,omitempty"`
+	Stage     uint32 `protobuf:"varint,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	ClearTime uint32 `protobuf:"varint,3,opt,name=clear_time,json=clearTime,proto3" json:"clear_time,omitempty"`
+	Rank      string `protobuf:"bytes,4,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *ChallengeRecord) Reset() {
+	*x = ChallengeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_core_proto_archon_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChallengeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeRecord) ProtoMessage() {}
+
+func (x *ChallengeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_core_proto_archon_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeRecord.ProtoReflect.Descriptor instead.
+func (*ChallengeRecord) Descriptor() ([]byte, []int) {
+	return file_internal_core_proto_archon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChallengeRecord) GetEpisode() uint32 {
+	if x != nil {
+		return x.Episode
+	}
+	return 0
+}
+
+func (x *ChallengeRecord) GetStage() uint32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *ChallengeRecord) GetClearTime() uint32 {
+	if x != nil {
+		return x.ClearTime
+	}
+	return 0
+}
+
+func (x *ChallengeRecord) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
 type GuildcardEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -573,7 +678,7 @@ type GuildcardEntry struct {
 func (x *GuildcardEntry) Reset() {
 	*x = GuildcardEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_core_proto_archon_proto_msgTypes[3]
+		mi := &file_internal_core_proto_archon_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -586,7 +691,7 @@ func (x *GuildcardEntry) String() string {
 func (*GuildcardEntry) ProtoMessage() {}
 
 func (x *GuildcardEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_core_proto_archon_proto_msgTypes[3]
+	mi := &file_internal_core_proto_archon_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +704,7 @@ func (x *GuildcardEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuildcardEntry.ProtoReflect.Descriptor instead.
 func (*GuildcardEntry) Descriptor() ([]byte, []int) {
-	return file_internal_core_proto_archon_proto_rawDescGZIP(), []int{3}
+	return file_internal_core_proto_archon_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GuildcardEntry) GetId() uint32 {
@@ -684,7 +789,7 @@ type PlayerOptions struct {
 func (x *PlayerOptions) Reset() {
 	*x = PlayerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_core_proto_archon_proto_msgTypes[4]
+		mi := &file_internal_core_proto_archon_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +802,7 @@ func (x *PlayerOptions) String() string {
 func (*PlayerOptions) ProtoMessage() {}
 
 func (x *PlayerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_core_proto_archon_proto_msgTypes[4]
+	mi := &file_internal_core_proto_archon_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +815,7 @@ func (x *PlayerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerOptions.ProtoReflect.Descriptor instead.
 func (*PlayerOptions) Descriptor() ([]byte, []int) {
-	return file_internal_core_proto_archon_proto_rawDescGZIP(), []int{4}
+	return file_internal_core_proto_archon_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PlayerOptions) GetId() uint32 {
@@ -732,121 +837,139 @@ var File_internal_core_proto_archon_proto protoreflect.FileDescriptor
 var file_internal_core_proto_archon_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x06, 0x61, 0x72, 0x63, 0x68, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x04, 0x53, 0x68,
-	0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98, 0x02,
-	0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x6c,
-	0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x6d, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x02, 0x67, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
-	0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x5f, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c,
-	0x65, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xe3, 0x07, 0x0a, 0x09, 0x43, 0x68, 0x61,
-	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x63,
-	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64,
-	0x63, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72,
-	0x64, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
-	0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x32, 0x5f, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x32, 0x46, 0x6c, 0x61, 0x67,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76,
-	0x31, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
-	0x31, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x75, 0x6d,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x75, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x73, 0x6b, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x61, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x69, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x61, 0x69, 0x72,
-	0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68,
-	0x61, 0x69, 0x72, 0x5f, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x68, 0x61, 0x69, 0x72, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61,
-	0x69, 0x72, 0x5f, 0x62, 0x6c, 0x75, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68,
-	0x61, 0x69, 0x72, 0x42, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12, 0x23, 0x0a,
-	0x0d, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x19,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x74, 0x70, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x61, 0x74, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x03, 0x6d, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x76, 0x70, 0x18, 0x1e, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x76, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x70, 0x18, 0x1f,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x68, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x66, 0x70, 0x18,
-	0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x64, 0x66, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x74,
-	0x61, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
-	0x6c, 0x63, 0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6c, 0x63, 0x6b, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x73, 0x65, 0x74, 0x61, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x6d, 0x65, 0x73, 0x65, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x70, 0x5f, 0x6d, 0x61, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0f, 0x68, 0x70, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x55, 0x73,
-	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x70, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74,
-	0x70, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x55, 0x73, 0x65, 0x64, 0x22, 0xa7,
-	0x02, 0x0a, 0x0e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x12,
-	0x29, 0x0a, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x63,
-	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6b,
-	0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x63, 0x72, 0x6f, 0x64, 0x6d, 0x61, 0x6e, 0x2f,
-	0x61, 0x72, 0x63, 0x68, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x74, 0x6f, 0x12, 0x06, 0x61, 0x72, 0x63, 0x68, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x04, 0x53,
+	0x68, 0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22,
+	0x98, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x75,
+	0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x67, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76,
+	0x69, 0x6c, 0x65, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xf1, 0x08, 0x0a, 0x09, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x75, 0x69,
+	0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x53, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x32, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x32, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x08, 0x76, 0x31, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x76, 0x31, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x73, 0x74,
+	0x75, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x75,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x6b, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x61, 0x63, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65,
+	0x61, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x69, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x61,
+	0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x64, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x68, 0x61, 0x69, 0x72, 0x5f, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x68, 0x61, 0x69, 0x72, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x61, 0x69, 0x72, 0x5f, 0x62, 0x6c, 0x75, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x68, 0x61, 0x69, 0x72, 0x42, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x74, 0x70, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x61, 0x74, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x74, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x76, 0x70, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x76, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x70,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x68, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x66,
+	0x70, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x64, 0x66, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x74, 0x61, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x74, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x63, 0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6c, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x65, 0x74, 0x61, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x6d, 0x65, 0x73, 0x65, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x70, 0x5f, 0x6d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x24, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x68, 0x70, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x70, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x74, 0x70, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x26,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x28, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x10, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x74,
+	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61,
+	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x63, 0x61, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f,
+	0x67, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3e,
+	0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x30,
+	0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x63, 0x72,
+	0x6f, 0x64, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -861,20 +984,22 @@ func file_internal_core_proto_archon_proto_rawDescGZIP() []byte {
 	return file_internal_core_proto_archon_proto_rawDescData
 }
 
-var file_internal_core_proto_archon_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_internal_core_proto_archon_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_internal_core_proto_archon_proto_goTypes = []interface{}{
-	(*Ship)(nil),           // 0: archon.Ship
-	(*Account)(nil),        // 1: archon.Account
-	(*Character)(nil),      // 2: archon.Character
-	(*GuildcardEntry)(nil), // 3: archon.GuildcardEntry
-	(*PlayerOptions)(nil),  // 4: archon.PlayerOptions
+	(*Ship)(nil),            // 0: archon.Ship
+	(*Account)(nil),         // 1: archon.Account
+	(*Character)(nil),       // 2: archon.Character
+	(*ChallengeRecord)(nil), // 3: archon.ChallengeRecord
+	(*GuildcardEntry)(nil),  // 4: archon.GuildcardEntry
+	(*PlayerOptions)(nil),   // 5: archon.PlayerOptions
 }
 var file_internal_core_proto_archon_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: archon.Character.challenge_records:type_name -> archon.ChallengeRecord
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_internal_core_proto_archon_proto_init() }
@@ -920,7 +1045,7 @@ func file_internal_core_proto_archon_proto_init() {
 			}
 		}
 		file_internal_core_proto_archon_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GuildcardEntry); i {
+			switch v := v.(*ChallengeRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -932,6 +1057,18 @@ func file_internal_core_proto_archon_proto_init() {
 			}
 		}
 		file_internal_core_proto_archon_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuildcardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_core_proto_archon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlayerOptions); i {
 			case 0:
 				return &v.state
@@ -950,7 +1087,7 @@ func file_internal_core_proto_archon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_core_proto_archon_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
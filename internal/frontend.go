@@ -17,6 +17,7 @@ import (
 	"github.com/dcrodman/archon/internal/core"
 	"github.com/dcrodman/archon/internal/core/client"
 	archdebug "github.com/dcrodman/archon/internal/core/debug"
+	"github.com/dcrodman/archon/internal/shipgate"
 	"go.uber.org/zap"
 )
 
@@ -31,12 +32,19 @@ type frontend struct {
 	Backend Backend
 	Config  *core.Config
 	Logger  *zap.SugaredLogger
+
+	shipgateClient shipgate.Shipgate
 }
 
 // Start initializes the server backend and opens a TCP socket for the specified server.
 // A blocking loop for accepting client connections is spun off in its own goroutine and
 // added to the WaitGroup. Context cancellations will stop the server.
 func (f *frontend) Start(ctx context.Context, wg *sync.WaitGroup) error {
+	var err error
+	if f.shipgateClient, err = shipgate.NewRPCClient(f.Config); err != nil {
+		return fmt.Errorf("error creating shipgate client: %v", err)
+	}
+
 	socket, err := f.createSocket()
 	if err != nil {
 		return fmt.Errorf("error creating socket on %s: %v", f.Address, err)
@@ -118,6 +126,12 @@ func (f *frontend) acceptClient(ctx context.Context, connection *net.TCPConn, wg
 	f.Backend.SetUpClient(c)
 	c.Debug = f.Config.Debugging.PacketLoggingEnabled
 
+	if f.isBanned(ctx, c) {
+		f.Logger.Infof("[%s] rejected connection from banned address %s", f.Backend.Identifier(), c.IPAddr())
+		_ = connection.Close()
+		return
+	}
+
 	f.Logger.Infof("[%s] accepted connection from %s", f.Backend.Identifier(), c.IPAddr())
 
 	if err := f.Backend.Handshake(c); err != nil {
@@ -135,6 +149,17 @@ func (f *frontend) acceptClient(ctx context.Context, connection *net.TCPConn, wg
 	f.processPackets(ctx, c)
 }
 
+// isBanned returns whether the client's IP address is banned. Clients are let
+// through if the shipgate can't be reached, leaving it to check them at login.
+func (f *frontend) isBanned(ctx context.Context, c *client.Client) bool {
+	resp, err := f.shipgateClient.CheckBan(ctx, &shipgate.CheckBanRequest{IpAddress: c.IPAddr()})
+	if err != nil {
+		f.Logger.Warnf("[%s] error checking bans for %s: %v", f.Backend.Identifier(), c.IPAddr(), err)
+		return false
+	}
+	return resp.Banned
+}
+
 // processPackets starts a blocking loop dedicated to reading data sent from
 // a game client and only returns once the connection has closed.
 func (f *frontend) processPackets(ctx context.Context, c *client.Client) {
@@ -190,6 +215,10 @@ func (f *frontend) closeConnectionAndRecover(serverName string, c *client.Client
 
 	delete(connectedClients, c.IPAddr())
 
+	if handler, ok := f.Backend.(DisconnectHandler); ok {
+		handler.HandleDisconnect(c)
+	}
+
 	f.Logger.Infof("[%s] disconnected client %s", serverName, c.IPAddr())
 }
 
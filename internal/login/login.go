@@ -2,6 +2,8 @@ package login
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 
 	"go.uber.org/zap"
 	"golang.org/x/text/cases"
@@ -28,17 +30,54 @@ type Server struct {
 	Logger *zap.SugaredLogger
 
 	shipgateClient shipgate.Shipgate
+	// Clients that have logged in, so that they can be kicked.
+	sessions *client.Registry
 }
 
 func (s *Server) Identifier() string {
 	return s.Name
 }
 
-func (s *Server) Init(_ context.Context) error {
-	s.shipgateClient = shipgate.NewRPCClient(s.Config)
+func (s *Server) Init(ctx context.Context) error {
+	var err error
+	if s.shipgateClient, err = shipgate.NewRPCClient(s.Config); err != nil {
+		return fmt.Errorf("error creating shipgate client: %w", err)
+	}
+	s.sessions = client.NewRegistry()
+
+	go shipgate.WatchEvents(ctx, s.shipgateClient, s.Logger, s.Name, s.handleEvent)
 	return nil
 }
 
+// handleEvent disconnects players kicked through the shipgate, which is the
+// only event the LOGIN server has any use for.
+func (s *Server) handleEvent(event *shipgate.Event) {
+	if kick := event.GetKick(); kick != nil && s.KickPlayer(kick.AccountId, kick.Message) {
+		s.Logger.Infof("[%s] kicked account %d", s.Name, kick.AccountId)
+	}
+}
+
+// KickPlayer disconnects the player with the account if they're connected,
+// returning whether they were.
+func (s *Server) KickPlayer(accountID uint64, message string) bool {
+	c := s.sessions.Find(accountID)
+	if c == nil {
+		return false
+	}
+	if err := s.sendMessage(c, message); err != nil {
+		s.Logger.Warnf("[%s] error notifying kicked player %s: %v", s.Name, c.IPAddr(), err)
+	}
+	if err := c.Close(); err != nil {
+		s.Logger.Warnf("[%s] error kicking %s: %v", s.Name, c.IPAddr(), err)
+	}
+	return true
+}
+
+// HandleDisconnect forgets about the client's session.
+func (s *Server) HandleDisconnect(c *client.Client) {
+	s.sessions.Remove(c)
+}
+
 func (s *Server) SetUpClient(c *client.Client) {
 	c.CryptoSession = client.NewBlueBurstCryptoSession()
 	c.DebugTags[debug.SERVER_TYPE] = debug.LOGIN_SERVER
@@ -82,16 +121,22 @@ func (s *Server) handleLogin(ctx context.Context, c *client.Client, loginPkt *pa
 	username := string(bytes.StripPadding(loginPkt.Username[:]))
 	password := string(bytes.StripPadding(loginPkt.Password[:]))
 
-	_, err := s.shipgateClient.AuthenticateAccount(ctx, &shipgate.AuthenticateAccountRequest{
-		Username: username,
-		Password: password,
+	account, err := s.shipgateClient.AuthenticateAccount(ctx, &shipgate.AuthenticateAccountRequest{
+		Username:   username,
+		Password:   password,
+		IpAddress:  c.IPAddr(),
+		HardwareId: hardwareID(loginPkt),
 	})
 	if err != nil {
-		switch err {
-		case shipgate.ErrInvalidCredentials:
+		switch {
+		case shipgate.IsError(err, shipgate.ErrInvalidCredentials):
 			return s.sendSecurity(c, packets.BBLoginErrorPassword)
-		case shipgate.ErrAccountBanned:
+		case shipgate.IsError(err, shipgate.ErrAccountBanned):
 			return s.sendSecurity(c, packets.BBLoginErrorBanned)
+		case shipgate.IsError(err, shipgate.ErrAccountLocked):
+			return s.sendSecurity(c, packets.BBLoginErrorLocked)
+		case shipgate.IsError(err, shipgate.ErrAccountNotVerified):
+			return s.sendMessage(c, "Please verify your email address before logging in.")
 		default:
 			sendErr := s.sendMessage(c, cases.Title(language.English).String(err.Error()))
 			if sendErr == nil {
@@ -101,9 +146,9 @@ func (s *Server) handleLogin(ctx context.Context, c *client.Client, loginPkt *pa
 		}
 	}
 
-	if err := s.sendSecurity(c, packets.BBLoginErrorNone); err != nil {
-		return err
-	}
+	c.Account = account
+	s.sessions.Add(c)
+
 	// The first time we receive this packet the loginClientExtension will have included the
 	// version string in the security data; check it.
 	//if ClientVersionString != string(util.StripPadding(loginPkt.Security[:])) {
@@ -118,9 +163,31 @@ func (s *Server) handleLogin(ctx context.Context, c *client.Client, loginPkt *pa
 	// but for now we'll just set it and leave it alone.
 	c.Config.Magic = 0x48615467
 
+	// The client echoes its config back to each server it connects to, which lets
+	// them verify the player with the session token instead of their password.
+	session, err := s.shipgateClient.IssueSessionToken(ctx, &shipgate.IssueSessionTokenRequest{AccountId: account.Id})
+	if err != nil {
+		return fmt.Errorf("error issuing session token: %w", err)
+	}
+	c.Config.SetSessionToken(session.Token)
+
+	if err := s.sendSecurity(c, packets.BBLoginErrorNone); err != nil {
+		return err
+	}
 	return s.sendCharacterRedirect(c)
 }
 
+// hardwareID returns the hex-encoded hardware ID sent by the client, or an
+// empty string if it didn't send one.
+func hardwareID(loginPkt *packets.Login) string {
+	for _, b := range loginPkt.HardwareInfo {
+		if b != 0 {
+			return hex.EncodeToString(loginPkt.HardwareInfo[:])
+		}
+	}
+	return ""
+}
+
 // send the security initialization packet with information about the user's
 // authentication status.
 func (s *Server) sendSecurity(c *client.Client, errorCode uint32) error {
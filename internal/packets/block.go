@@ -1,11 +1,35 @@
 package packets
 
 const (
+	ChatType             = 0x06
 	LobbyListType        = 0x83
 	BlockListType        = 0x07
 	FullCharacterType    = 0xE7
 	FullCharacterEndType = 0x95
 	CharacterDataType    = 0x61
+	InfoBoardRequestType = 0xD8
+	InfoBoardUpdateType  = 0xD9
+	TextMessageType      = 0xB0
+	LobbyJoinType        = 0x67
+	CreateGameType       = 0xC1
+	GameJoinType         = 0x64
+	GameListType         = 0x08
+	LeaveGameType        = 0x98
+	QuestListType        = 0xA2
+	// Game commands are relayed between the players in a lobby or game. The
+	// targeted variants are only sent to the client ID in the header flags.
+	GameCommandType            = 0x60
+	GameCommandTargetType      = 0x62
+	GameCommandLargeType       = 0x6C
+	GameCommandLargeTargetType = 0x6D
+	// The event is passed in the header flags.
+	LobbyEventType = 0xDA
+	// Sent by the client to find a player by guildcard and answered by the server
+	// with where they are (including how to reach them for "meet user").
+	GuildcardSearchType       = 0x40
+	GuildcardSearchResultType = 0x41
+	// Sent by each player in a trade with the items they're offering.
+	TradeItemsType = 0xD0
 )
 
 type LobbyListEntry struct {
@@ -26,6 +50,15 @@ type Item struct {
 	MagData uint32
 }
 
+// TradeItems is sent by the client with the items the player is offering to
+// another player in their game.
+type TradeItems struct {
+	Header         BBHeader
+	TargetClientID uint16
+	ItemCount      uint16
+	Items          [32]Item
+}
+
 type InventoryItem struct {
 	InUse   uint8 // 0x01 for in use, 0xFF is unused
 	Unknown [3]byte
@@ -107,7 +140,7 @@ type FullCharacter struct {
 	AutoReply            [344]uint8
 	GCBoard              [172]uint8
 	Unknown12            [200]uint8
-	ChallengeData        [320]uint8
+	ChallengeData        ChallengeData
 	TechConfig           [40]uint8
 	Unknown13            [40]uint8
 	QuestData2           [92]uint8
@@ -126,12 +159,346 @@ type FullCharacter struct {
 }
 
 type Packet67 struct {
-	Header        BBHeader
-	Padding       uint32
-	Unknown1      uint32
+	Header      BBHeader
+	ClientID    uint8
+	LeaderID    uint8
+	DisableUDP  uint8
+	LobbyNumber uint8
+	BlockNumber uint16
+	// Lobby event (seasonal decorations) active in the lobby.
+	Event         uint16
 	Padding1      uint32
 	PlayerTag     uint32
 	Something     uint32
 	Padding2      [24]uint8
 	CharacterName [32]uint8
 }
+
+// CharacterData is the start of the character data sent by the client when it
+// enters the block (0x61) or leaves a game (0x98). Only the inventory is read for now.
+type CharacterData struct {
+	Header            BBHeader
+	NumInventoryItems uint8
+	HPMaterials       uint8
+	TPMaterials       uint8
+	Language          uint8
+	Inventory         [30]InventoryItem
+}
+
+// CreateGame is sent by the client to create a new game with the selected options.
+type CreateGame struct {
+	Header        BBHeader
+	Unused        [2]uint32
+	Name          [32]uint8
+	Password      [32]uint8
+	Difficulty    uint8
+	BattleMode    uint8
+	ChallengeMode uint8
+	// 1 for Episode 1, 2 for Episode 2, and 3 for Episode 4.
+	Episode  uint8
+	SoloMode uint8
+	Unused2  [3]uint8
+}
+
+// GameListEntry is one of the games on the block that the player can join. The
+// first entry in the list describes the block itself.
+type GameListEntry struct {
+	MenuID uint32
+	GameID uint32
+	// 0x22 plus the difficulty (0x00 for the block entry).
+	DifficultyTag uint8
+	NumPlayers    uint8
+	Name          [32]uint8
+	// 0x40 plus the episode number.
+	Episode uint8
+	// Combination of the GameListFlag values.
+	Flags uint8
+}
+
+// Flags shown alongside each game in the game list.
+const (
+	GameListFlagPassword  = 0x02
+	GameListFlagBattle    = 0x04
+	GameListFlagChallenge = 0x10
+	GameListFlagSolo      = 0x40
+)
+
+// GameList is the list of games on the block. The number of games (not counting
+// the block entry) is passed in the header flags.
+type GameList struct {
+	Header  BBHeader
+	Entries []GameListEntry
+}
+
+// GameSelection is a menu selection sent by the client when joining a game from
+// the game list, which includes the password the player entered.
+type GameSelection struct {
+	Header   BBHeader
+	Unknown  uint16
+	MenuID   uint16
+	ItemID   uint32
+	Password [32]uint8
+}
+
+// GamePlayer identifies one of the players already in a game.
+type GamePlayer struct {
+	PlayerTag uint32
+	Guildcard uint32
+	Unknown   [20]uint8
+	ClientID  uint32
+	Name      [32]uint8
+	HideHelp  uint32
+}
+
+// GameJoin places the client into a game.
+type GameJoin struct {
+	Header BBHeader
+	// Layout variations for each of the game's maps.
+	Variations    [32]uint32
+	Players       [4]GamePlayer
+	ClientID      uint8
+	LeaderID      uint8
+	DisableUDP    uint8
+	Difficulty    uint8
+	BattleMode    uint8
+	Event         uint8
+	SectionID     uint8
+	ChallengeMode uint8
+	RareSeed      uint32
+	Episode       uint8
+	Unused        uint8
+	SoloMode      uint8
+	Unused2       uint8
+}
+
+// ChallengeData is a character's challenge mode title and records.
+type ChallengeData struct {
+	TitleColor uint16
+	Unknown    uint16
+	RankTitle  [24]uint8
+	// Best clear times (in seconds) of each stage, played online.
+	TimesEp1 [9]uint32
+	TimesEp2 [5]uint32
+	// Best clear times of the Episode 1 stages played offline.
+	TimesEp1Offline [9]uint32
+	Unknown2        [200]uint8
+}
+
+// SubcommandHeader precedes the data of each game command.
+type SubcommandHeader struct {
+	Type uint8
+	// Size of the subcommand in 4-byte words, including the header.
+	Size     uint8
+	ClientID uint16
+}
+
+// GameCommand is a game command (0x60, 0x62, 0x6C, or 0x6D) relayed between players.
+type GameCommand struct {
+	Header BBHeader
+	Data   []byte
+}
+
+// ChallengeRecordsCommand is sent by the client with its updated records after
+// clearing a challenge mode stage.
+type ChallengeRecordsCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	Records    ChallengeData
+}
+
+// FloorChangeCommand is sent by the client when the player moves to another floor.
+type FloorChangeCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	Floor      uint32
+}
+
+// EnemyHitCommand is sent by the client when the player damages an enemy. The
+// subcommand's ClientID holds the enemy's entity ID.
+type EnemyHitCommand struct {
+	Header      BBHeader
+	Subcommand  SubcommandHeader
+	EnemyID     uint16
+	RemainingHP uint16
+	Flags       uint32
+}
+
+// EnemyExperienceRequest is sent by the client when an enemy the player hit dies.
+type EnemyExperienceRequest struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	EnemyID    uint16
+	ClientID   uint16
+	IsKiller   uint8
+	Unused     [3]uint8
+}
+
+// ItemCommand is sent by the client when the player equips, unequips, uses, or
+// discards one of their items.
+type ItemCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	ItemID     uint32
+}
+
+// PickUpItemCommand is sent by the game leader's client when a player picks up
+// an item from the floor.
+type PickUpItemCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	ClientID   uint16
+	Floor      uint16
+	ItemID     uint32
+}
+
+// DropStackCommand is sent by the client when the player drops part of a stack
+// of items or some of their meseta (in which case ItemID is 0xFFFFFFFF).
+type DropStackCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	Floor      uint16
+	Unused     uint16
+	X, Z       float32
+	ItemID     uint32
+	Amount     uint32
+}
+
+// CreateItemCommand places a new item in a player's inventory.
+type CreateItemCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	Item       Item
+	Unused     uint32
+}
+
+// GiveExperienceCommand tells the players in a game that a player gained experience.
+type GiveExperienceCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	Amount     uint32
+}
+
+// LevelUpCommand tells the players in a game that a player reached a new level.
+type LevelUpCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	ATP        uint16
+	MST        uint16
+	EVP        uint16
+	HP         uint16
+	DFP        uint16
+	ATA        uint16
+	Level      uint32
+}
+
+// BattleRules are the rules of a battle mode game as understood by the client.
+type BattleRules struct {
+	// 0 to allow technique disks, 1 to forbid them.
+	TechDiskMode uint8
+	// 0 to allow weapons and armor, 2 to forbid them.
+	WeaponAndArmorMode uint8
+	// 0 to allow mags, 1 to forbid them.
+	MagMode uint8
+	// 0 to allow tools, 2 to forbid them.
+	ToolMode uint8
+	TrapMode uint8
+	Unused   uint8
+	// 0 to allow respawning, 1 to forbid it, and 2 to limit it to Lives.
+	RespawnMode       uint8
+	ReplaceCharacter  uint8
+	DropWeapon        uint8
+	IsTeams           uint8
+	HideTargetReticle uint8
+	MesetaMode        uint8
+	DeathLevelUp      uint8
+	TrapCounts        [3]uint8
+	EnableSonar       uint8
+	SonarCount        uint8
+	ForbidScapeDolls  uint8
+	Unknown           uint8
+	Lives             uint32
+	MaxTechLevel      uint32
+	CharacterLevel    uint32
+	// Length of the match in minutes (0 for no limit).
+	TimeLimit        uint32
+	DeathTechLevelUp uint16
+	Unused2          uint16
+	BoxDropArea      uint32
+}
+
+// StartBattleCommand sends the rules of a battle mode game to a player in it.
+type StartBattleCommand struct {
+	Header     BBHeader
+	Subcommand SubcommandHeader
+	Rules      BattleRules
+}
+
+// GuildcardSearch is sent by the client to find out where the player with
+// TargetGuildcard is connected.
+type GuildcardSearch struct {
+	Header            BBHeader
+	PlayerTag         uint32
+	SearcherGuildcard uint32
+	TargetGuildcard   uint32
+}
+
+// GuildcardSearchResult tells the client where the player they searched for is.
+// If the player chooses to meet them, the client connects to the address in the
+// embedded redirect and asks to join the lobby.
+type GuildcardSearchResult struct {
+	Header            BBHeader
+	PlayerTag         uint32
+	SearcherGuildcard uint32
+	TargetGuildcard   uint32
+	Redirect          Redirect
+	// UTF-16 description of the player's location, e.g. "Lobby 3,BLOCK01,Archon".
+	Location [136]uint8
+	MenuID   uint32
+	LobbyID  uint32
+	Unused   [60]uint8
+	Name     [64]uint8
+}
+
+// QuestListEntry is one of the quests the player can choose from.
+type QuestListEntry struct {
+	MenuID      uint32
+	QuestID     uint32
+	Name        [64]uint8
+	Description [244]uint8
+}
+
+// QuestList is the list of quests in a category. The number of quests is
+// passed in the header flags.
+type QuestList struct {
+	Header  BBHeader
+	Entries []QuestListEntry
+}
+
+// InfoBoardEntry is the name and info board text of one player in the lobby.
+type InfoBoardEntry struct {
+	Name    [32]uint8
+	Message [344]uint8
+}
+
+// InfoBoard is sent in response to a request (0xD8) for the info boards of the
+// players in the client's lobby.
+type InfoBoard struct {
+	Header  BBHeader
+	Entries []InfoBoardEntry
+}
+
+// Chat is a message sent by a player, which the server relays to everyone in the
+// player's lobby with the sender's guildcard and name filled in.
+type Chat struct {
+	Header    BBHeader
+	Padding   uint32
+	Guildcard uint32
+	Message   []byte
+}
+
+// TextMessage is a system message displayed to the player in the lobby.
+type TextMessage struct {
+	Header  BBHeader
+	Padding [2]uint32
+	Message []byte
+}
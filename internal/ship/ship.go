@@ -6,6 +6,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/text/cases"
@@ -28,14 +29,43 @@ const (
 
 	// BackMenuItem is the block ID reserved for returning to the ship select menu.
 	BackMenuItem = 0xFF
+
+	// Used if the config doesn't specify how often to send heartbeats.
+	defaultHeartbeatInterval = 10 * time.Second
 )
 
 var loginCopyright = []byte("Phantasy Star Online Blue Burst Game Server. Copyright 1999-2004 SONICTEAM.")
 
+// Announcer is implemented by the block servers so that the ship can pass
+// along announcements to the players connected to its blocks.
+type Announcer interface {
+	Announce(message string)
+}
+
+// PlayerCounter is implemented by the block servers so that the ship can show
+// (and tell the shipgate) how many players are on each block.
+type PlayerCounter interface {
+	PlayerCount() int
+	MaxPlayers() int
+}
+
+// PlayerManager is implemented by the block servers so that the ship can act on
+// individual players at the shipgate's request.
+type PlayerManager interface {
+	MessagePlayer(accountID uint64, message string) bool
+	KickPlayer(accountID uint64, message string) bool
+}
+
 type Block struct {
 	Name    string
 	Address string
 	ID      int
+	// Server receiving announcements for the block, if any.
+	Announcer Announcer
+	// Server reporting the number of players on the block, if any.
+	Players PlayerCounter
+	// Server messaging and kicking players on the block, if any.
+	Manager PlayerManager
 }
 
 // Server is the SHIP server implementation. This is similar to PATCH and LOGIN
@@ -49,6 +79,8 @@ type Server struct {
 	Blocks []Block
 
 	shipgateClient shipgate.Shipgate
+	// Clients on the ship and block menus, so that they can be kicked.
+	sessions *client.Registry
 }
 
 func (s *Server) Identifier() string {
@@ -58,19 +90,172 @@ func (s *Server) Identifier() string {
 // Init connects the ship to the shipgate and registers so that it
 // can begin receiving players.
 func (s *Server) Init(ctx context.Context) error {
-	s.shipgateClient = shipgate.NewRPCClient(s.Config)
+	var err error
+	if s.shipgateClient, err = shipgate.NewRPCClient(s.Config); err != nil {
+		return fmt.Errorf("error creating shipgate client: %w", err)
+	}
 
 	// Register this ship with the shipgate so that it can start accepting players.
+	if err := s.register(ctx); err != nil {
+		return err
+	}
+
+	s.sessions = client.NewRegistry()
+
+	go shipgate.WatchEvents(ctx, s.shipgateClient, s.Logger, s.Name, s.handleEvent)
+	go s.sendHeartbeats(ctx)
+	return nil
+}
+
+func (s *Server) register(ctx context.Context) error {
 	if _, err := s.shipgateClient.RegisterShip(ctx, &shipgate.RegisterShipRequest{
-		Name:    s.Config.ShipServer.Name,
-		Address: s.Config.ExternalIP,
-		Port:    strconv.Itoa(s.Config.ShipServer.Port),
+		Name:       s.Config.ShipServer.Name,
+		Address:    s.Config.ExternalIP,
+		Port:       strconv.Itoa(s.Config.ShipServer.Port),
+		MaxPlayers: int32(s.maxPlayers()),
 	}); err != nil {
 		return fmt.Errorf("error registering with shipgate: %v", err)
 	}
 	return nil
 }
 
+// sendHeartbeats periodically tells the shipgate that the ship is still running
+// until ctx is cancelled. The ship registers again if the shipgate has forgotten
+// about it (e.g. because it restarted or the ship missed too many heartbeats).
+func (s *Server) sendHeartbeats(ctx context.Context) {
+	interval := s.Config.ShipServer.HeartbeatInterval
+	if interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, err := s.shipgateClient.ShipHeartbeat(ctx, &shipgate.ShipHeartbeatRequest{
+			Name:   s.Config.ShipServer.Name,
+			Blocks: s.blockStatuses(),
+		})
+		if shipgate.IsError(err, shipgate.ErrShipNotRegistered) {
+			s.Logger.Infof("[%s] registering with the shipgate again", s.Name)
+			err = s.register(ctx)
+		}
+		if err != nil && ctx.Err() == nil {
+			s.Logger.Warnf("[%s] error sending heartbeat to shipgate: %v", s.Name, err)
+		}
+	}
+}
+
+// maxPlayers returns the number of players the ship can hold.
+func (s *Server) maxPlayers() int {
+	if s.Config.ShipServer.MaxPlayers > 0 {
+		return s.Config.ShipServer.MaxPlayers
+	}
+	total := 0
+	for _, block := range s.Blocks {
+		if block.Players == nil {
+			// There's no way to know how many players the ship can hold.
+			return 0
+		}
+		total += block.Players.MaxPlayers()
+	}
+	return total
+}
+
+// blockStatuses returns the current state of each of the ship's blocks.
+func (s *Server) blockStatuses() []*shipgate.BlockStatus {
+	statuses := make([]*shipgate.BlockStatus, len(s.Blocks))
+	for i, block := range s.Blocks {
+		statuses[i] = &shipgate.BlockStatus{Id: int32(block.ID), Name: block.Name}
+		if block.Players != nil {
+			statuses[i].PlayerCount = int32(block.Players.PlayerCount())
+		}
+	}
+	return statuses
+}
+
+func (s *Server) handleEvent(event *shipgate.Event) {
+	switch payload := event.Payload.(type) {
+	case *shipgate.Event_Announcement:
+		s.Logger.Infof("[%s] received announcement: %s", s.Name, payload.Announcement.Message)
+		for _, block := range s.Blocks {
+			if block.Announcer != nil {
+				block.Announcer.Announce(payload.Announcement.Message)
+			}
+		}
+	case *shipgate.Event_PlayerMessage:
+		for _, block := range s.Blocks {
+			if block.Manager != nil && block.Manager.MessagePlayer(payload.PlayerMessage.AccountId, payload.PlayerMessage.Message) {
+				break
+			}
+		}
+	case *shipgate.Event_Kick:
+		if s.KickPlayer(payload.Kick.AccountId, payload.Kick.Message) {
+			s.Logger.Infof("[%s] kicked account %d", s.Name, payload.Kick.AccountId)
+			break
+		}
+		for _, block := range s.Blocks {
+			if block.Manager != nil && block.Manager.KickPlayer(payload.Kick.AccountId, payload.Kick.Message) {
+				s.Logger.Infof("[%s] kicked account %d from %s", s.Name, payload.Kick.AccountId, block.Name)
+				break
+			}
+		}
+	default:
+		s.Logger.Warnf("[%s] received unknown event %d from shipgate", s.Name, event.Id)
+	}
+}
+
+// KickPlayer disconnects the player with the account if they're on the ship's
+// menus, returning whether they were.
+func (s *Server) KickPlayer(accountID uint64, message string) bool {
+	c := s.sessions.Find(accountID)
+	if c == nil {
+		return false
+	}
+	if err := s.sendMessage(c, message); err != nil {
+		s.Logger.Warnf("[%s] error notifying kicked player %s: %v", s.Name, c.IPAddr(), err)
+	}
+	if err := c.Close(); err != nil {
+		s.Logger.Warnf("[%s] error kicking %s: %v", s.Name, c.IPAddr(), err)
+	}
+	return true
+}
+
+// HandleDisconnect forgets about the client's session.
+func (s *Server) HandleDisconnect(c *client.Client) {
+	if s.sessions.Remove(c) {
+		s.clearPresence(context.Background(), c)
+	}
+}
+
+// setPresence tells the shipgate that the player is on the ship so that their
+// characters aren't edited out from under them.
+func (s *Server) setPresence(ctx context.Context, c *client.Client) {
+	if _, err := s.shipgateClient.SetPlayerPresence(ctx, &shipgate.PlayerPresence{
+		AccountId: c.Account.Id,
+		Ship:      s.Config.ShipServer.Name,
+		Server:    s.Name,
+	}); err != nil {
+		s.Logger.Warnf("[%s] error setting presence of %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
+// clearPresence tells the shipgate that the player has left.
+func (s *Server) clearPresence(ctx context.Context, c *client.Client) {
+	if _, err := s.shipgateClient.ClearPlayerPresence(ctx, &shipgate.PlayerPresence{
+		AccountId: c.Account.Id,
+		Ship:      s.Config.ShipServer.Name,
+		Server:    s.Name,
+	}); err != nil {
+		s.Logger.Warnf("[%s] error clearing presence of %s: %v", s.Name, c.IPAddr(), err)
+	}
+}
+
 func (s *Server) SetUpClient(c *client.Client) {
 	c.CryptoSession = client.NewBlueBurstCryptoSession()
 	c.DebugTags[debug.SERVER_TYPE] = debug.SHIP_SERVER
@@ -111,28 +296,30 @@ func (s *Server) Handle(ctx context.Context, c *client.Client, data []byte) erro
 }
 
 func (s *Server) handleShipLogin(ctx context.Context, c *client.Client, loginPkt *packets.Login) error {
-	username := string(bytes.StripPadding(loginPkt.Username[:]))
-	password := string(bytes.StripPadding(loginPkt.Password[:]))
+	bytes.StructFromBytes(loginPkt.Security[:], &c.Config)
 
-	_, err := s.shipgateClient.AuthenticateAccount(ctx, &shipgate.AuthenticateAccountRequest{
-		Username: username,
-		Password: password,
+	resp, err := s.shipgateClient.VerifySessionToken(ctx, &shipgate.VerifySessionTokenRequest{
+		Token: c.Config.SessionToken(),
 	})
 	if err != nil {
-		switch err {
-		case shipgate.ErrInvalidCredentials:
-			return s.sendSecurity(c, packets.BBLoginErrorPassword)
-		case shipgate.ErrAccountBanned:
-			return s.sendSecurity(c, packets.BBLoginErrorBanned)
+		var sendErr error
+		switch {
+		case shipgate.IsError(err, shipgate.ErrAccountBanned):
+			sendErr = s.sendSecurity(c, packets.BBLoginErrorBanned)
 		default:
-			sendErr := s.sendMessage(c, cases.Title(language.English).String(err.Error()))
-			if sendErr == nil {
-				return sendErr
-			}
-			return err
+			sendErr = s.sendMessage(c, cases.Title(language.English).String(err.Error()))
+		}
+		if sendErr != nil {
+			s.Logger.Warnf("[%s] error notifying %s of failed login: %v", s.Name, c.IPAddr(), sendErr)
 		}
+		// Players can't do anything without a valid session, so the connection is closed.
+		return fmt.Errorf("error verifying session for %s: %w", c.IPAddr(), err)
 	}
 
+	c.Account = resp.Account
+	s.sessions.Add(c)
+	s.setPresence(ctx, c)
+
 	if err := s.sendSecurity(c, packets.BBLoginErrorNone); err != nil {
 		return err
 	}
@@ -178,7 +365,11 @@ func (s *Server) sendBlockList(c *client.Client) error {
 			Unknown: 0x12,
 			BlockID: blockListMenuType | uint32(blockCfg.ID),
 		}
-		copy(block.BlockName[:], bytes.ConvertToUtf16(blockCfg.Name))
+		name := blockCfg.Name
+		if blockCfg.Players != nil {
+			name = shipgate.PlayerCountLabel(name, blockCfg.Players.PlayerCount(), blockCfg.Players.MaxPlayers())
+		}
+		copy(block.BlockName[:], bytes.ConvertToUtf16(name))
 		blocks = append(blocks, block)
 	}
 
@@ -238,6 +429,12 @@ func (s *Server) handleBlockSelection(ctx context.Context, c *client.Client, sel
 	var err error
 	for _, block := range s.Blocks {
 		if block.ID == int(selection) {
+			if block.Players != nil && block.Players.PlayerCount() >= block.Players.MaxPlayers() {
+				if err := s.sendMessage(c, fmt.Sprintf("%s is full.", block.Name)); err != nil {
+					return err
+				}
+				return s.sendBlockList(c)
+			}
 			err = s.sendBlockRedirect(c, block)
 			break
 		}
@@ -276,7 +473,7 @@ func (s *Server) sendShipList(ctx context.Context, c *client.Client) error {
 				MenuID: uint16(i + 1),
 				ShipID: uint32(ship.Id),
 			}
-			copy(entry.ShipName[:], bytes.ConvertToUtf16(ship.Name))
+			copy(entry.ShipName[:], bytes.ConvertToUtf16(shipgate.PlayerCountLabel(ship.Name, int(ship.PlayerCount), int(ship.MaxPlayers))))
 			pkt.ShipEntries = append(pkt.ShipEntries, entry)
 		}
 	}
@@ -294,6 +491,12 @@ func (s *Server) handleShipSelection(ctx context.Context, c *client.Client, sele
 	if selection >= uint32(len(shipList.Ships)) {
 		return fmt.Errorf("invalid ship selection: %d", selection)
 	}
+	if shipgate.IsFull(shipList.Ships[selection]) {
+		if err := s.sendMessage(c, fmt.Sprintf("%s is full.", shipList.Ships[selection].Name)); err != nil {
+			return err
+		}
+		return s.sendShipList(ctx, c)
+	}
 
 	ip := net.ParseIP(shipList.Ships[selection].Ip).To4()
 	port, _ := strconv.Atoi(shipList.Ships[selection].Port)
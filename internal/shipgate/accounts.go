@@ -0,0 +1,95 @@
+package shipgate
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/dcrodman/archon/internal/core/data"
+)
+
+const (
+	// Usernames and passwords have to fit in the client's login packet.
+	maxUsernameLength = 16
+	minPasswordLength = 4
+	maxPasswordLength = 16
+	// Most accounts returned by a single ListAccounts call.
+	maxListedAccounts = 100
+	// Length of the random tokens emailed to players.
+	accountTokenSize = 32
+	// How long players have to verify their email address after registering.
+	verificationTokenTTL = 48 * time.Hour
+	// How long password reset tokens can be used for.
+	passwordResetTokenTTL = 24 * time.Hour
+)
+
+var (
+	ErrUsernameTaken = errors.New("that username is already taken")
+	ErrEmailTaken    = errors.New("that email address is already in use")
+	ErrInvalidToken  = errors.New("this link is invalid or has expired")
+)
+
+// The client doesn't support capital letters in usernames or passwords.
+var (
+	usernamePattern = regexp.MustCompile(`^[a-z0-9_.-]+$`)
+	passwordPattern = regexp.MustCompile(`^[!-@\[-~]+$`)
+)
+
+// invalidArgument marks err as caused by bad input so that clients know it can
+// be shown to players.
+func invalidArgument(err error) error {
+	return twirp.NewError(twirp.InvalidArgument, err.Error())
+}
+
+func validateUsername(username string) error {
+	if len(username) == 0 || len(username) > maxUsernameLength {
+		return invalidArgument(fmt.Errorf("usernames must be between 1 and %d characters long", maxUsernameLength))
+	} else if !usernamePattern.MatchString(username) {
+		return invalidArgument(errors.New("usernames can only contain lowercase letters, numbers, '_', '.', and '-'"))
+	}
+	return nil
+}
+
+func validatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return invalidArgument(fmt.Errorf("passwords must be between %d and %d characters long", minPasswordLength, maxPasswordLength))
+	} else if !passwordPattern.MatchString(password) {
+		return invalidArgument(errors.New("passwords can only contain lowercase letters, numbers, and symbols"))
+	}
+	return nil
+}
+
+func validateEmail(email string) error {
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return invalidArgument(fmt.Errorf("invalid email address: %s", email))
+	}
+	return nil
+}
+
+// newAccountToken generates a token for the account, returning it along with
+// the record to save (which only holds its hash).
+func newAccountToken(accountID uint64, purpose string, ttl time.Duration) (string, *data.AccountToken, error) {
+	b := make([]byte, accountTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, fmt.Errorf("error generating token: %w", err)
+	}
+	token := hex.EncodeToString(b)
+	return token, &data.AccountToken{
+		AccountID: accountID,
+		Purpose:   purpose,
+		Hash:      hashAccountToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+func hashAccountToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,100 @@
+package shipgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/core/data"
+)
+
+func TestValidateAccountFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(string) error
+		value    string
+		wantErr  bool
+	}{
+		{name: "username", validate: validateUsername, value: "sonic_team.01"},
+		{name: "empty username", validate: validateUsername, value: "", wantErr: true},
+		{name: "long username", validate: validateUsername, value: "abcdefghijklmnopq", wantErr: true},
+		{name: "capitalized username", validate: validateUsername, value: "Sonic", wantErr: true},
+		{name: "username with spaces", validate: validateUsername, value: "sonic team", wantErr: true},
+		{name: "password", validate: validatePassword, value: "hunter22"},
+		{name: "short password", validate: validatePassword, value: "abc", wantErr: true},
+		{name: "long password", validate: validatePassword, value: "abcdefghijklmnopq", wantErr: true},
+		{name: "password with symbols", validate: validatePassword, value: "p@ss_w0rd!"},
+		{name: "capitalized password", validate: validatePassword, value: "Hunter22", wantErr: true},
+		{name: "password with spaces", validate: validatePassword, value: "hunter 22", wantErr: true},
+		{name: "email", validate: validateEmail, value: "sonic@example.com"},
+		{name: "email with name", validate: validateEmail, value: "Sonic <sonic@example.com>", wantErr: true},
+		{name: "invalid email", validate: validateEmail, value: "sonic", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.validate(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("validating %q returned error %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_AuthorizeOperator(t *testing.T) {
+	s := &service{}
+
+	ctx := withCaller(context.Background(), &caller{name: "login", role: CallerRoleAdmin})
+	if actor, err := s.authorizeOperator(ctx, 0, auth.PermissionManageAccounts); err != nil || actor != nil {
+		t.Errorf("expected operator requests from admins to be allowed, got %v (error: %v)", actor, err)
+	}
+
+	ctx = withCaller(context.Background(), &caller{name: "community ship", role: CallerRoleShip})
+	if _, err := s.authorizeOperator(ctx, 0, auth.PermissionManageAccounts); err != ErrPermissionDenied {
+		t.Errorf("expected operator requests from ships to be denied, got %v", err)
+	}
+}
+
+func TestService_CreateAccount_Email(t *testing.T) {
+	s := &service{logger: zap.NewNop().Sugar(), db: newTestDatabase(t), passwordCost: bcrypt.MinCost}
+
+	// Operators don't have to give an email address.
+	if _, err := s.CreateAccount(context.Background(), &CreateAccountRequest{Username: "sonic", Password: "hunter22"}); err != nil {
+		t.Errorf("CreateAccount() returned an unexpected error without an email address: %v", err)
+	}
+	if _, err := s.CreateAccount(context.Background(), &CreateAccountRequest{Username: "tails", Password: "hunter22", Email: "tails"}); err == nil {
+		t.Errorf("expected CreateAccount() to reject an invalid email address")
+	}
+
+	// Players registering themselves do.
+	if _, err := s.RegisterAccount(context.Background(), &RegisterAccountRequest{Username: "knuckles", Password: "hunter22"}); err == nil {
+		t.Errorf("expected RegisterAccount() to require an email address")
+	}
+	if _, err := s.RegisterAccount(context.Background(), &RegisterAccountRequest{
+		Username: "knuckles",
+		Password: "hunter22",
+		Email:    "knuckles@example.com",
+	}); err != nil {
+		t.Errorf("RegisterAccount() returned an unexpected error: %v", err)
+	}
+}
+
+func TestNewAccountToken(t *testing.T) {
+	token, record, err := newAccountToken(1, data.TokenPurposeVerifyEmail, time.Hour)
+	if err != nil {
+		t.Fatalf("newAccountToken() returned an unexpected error: %v", err)
+	}
+	if record.Hash == token || record.Hash != hashAccountToken(token) {
+		t.Errorf("expected the record to hold the token's hash, got %s", record.Hash)
+	}
+	if record.AccountID != 1 || record.Purpose != data.TokenPurposeVerifyEmail || !record.ExpiresAt.After(time.Now()) {
+		t.Errorf("got unexpected token record %+v", record)
+	}
+
+	other, _, _ := newAccountToken(1, data.TokenPurposeVerifyEmail, time.Hour)
+	if other == token {
+		t.Error("expected tokens to be unique")
+	}
+}
@@ -0,0 +1,290 @@
+package shipgate
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/auth"
+)
+
+// Roles that can be given to the servers calling the shipgate.
+const (
+	// Ships can only call the RPCs in shipMethods and only act on the players they're hosting.
+	CallerRoleShip = "ship"
+	// Admins (the login servers and command line tools) can call anything.
+	CallerRoleAdmin = "admin"
+)
+
+const (
+	callerHeader    = "X-Archon-Caller"
+	timestampHeader = "X-Archon-Timestamp"
+	signatureHeader = "X-Archon-Signature"
+	// How far a request's timestamp can be from the shipgate's clock. Signed requests
+	// can be replayed within this window, which is why TLS should be used as well.
+	maxClockSkew = 5 * time.Minute
+	// Largest request body the shipgate will read.
+	maxRequestSize = 4 << 20
+)
+
+var ErrUnauthenticated = errors.New("missing or invalid shipgate API key")
+
+// RPCs that ships are allowed to call.
+var shipMethods = map[string]bool{
+	"GetActiveShips":         true,
+	"RegisterShip":           true,
+	"ShipHeartbeat":          true,
+	"VerifySessionToken":     true,
+	"RevokeSessions":         true,
+	"BanPlayer":              true,
+	"ListBans":               true,
+	"LiftBan":                true,
+	"CheckBan":               true,
+	"SetPrivilegeLevel":      true,
+	"RecordAuditEntry":       true,
+	"ReportViolation":        true,
+	"ListViolations":         true,
+	"SetPlayerLocation":      true,
+	"ClearPlayerLocation":    true,
+	"SetPlayerPresence":      true,
+	"ClearPlayerPresence":    true,
+	"FindPlayer":             true,
+	"MessagePlayer":          true,
+	"KickPlayer":             true,
+	"ScheduleWarp":           true,
+	"PollEvents":             true,
+	"Broadcast":              true,
+	"FindCharacter":          true,
+	"UpsertCharacter":        true,
+	"UpdateCharacterStats":   true,
+	"UpdateInfoBoard":        true,
+	"UpdateChallengeRecords": true,
+}
+
+// ShipAuditPermissions are the actions that ships can record in the audit log,
+// which are the block's GM commands, and the permission each one requires.
+var ShipAuditPermissions = map[string]auth.Permission{
+	"kick":       auth.PermissionKick,
+	"ban":        auth.PermissionBan,
+	"announce":   auth.PermissionAnnounce,
+	"event":      auth.PermissionLobbyEvent,
+	"warp":       auth.PermissionWarp,
+	"teleport":   auth.PermissionWarp,
+	"item":       auth.PermissionSpawnItem,
+	"violations": auth.PermissionReviewViolations,
+}
+
+// caller is the server making a request to the shipgate.
+type caller struct {
+	name string
+	role string
+}
+
+func (c *caller) isShip() bool {
+	return c.role == CallerRoleShip
+}
+
+type callerContextKey struct{}
+
+func withCaller(ctx context.Context, c *caller) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, c)
+}
+
+// callerFromContext returns the server that made the request. Requests that didn't
+// go through an authenticator (e.g. in tests) are treated as coming from an admin.
+func callerFromContext(ctx context.Context) *caller {
+	if c, ok := ctx.Value(callerContextKey{}).(*caller); ok {
+		return c
+	}
+	return &caller{role: CallerRoleAdmin}
+}
+
+type callerKey struct {
+	secret []byte
+	role   string
+}
+
+// authenticator checks the API keys that servers sign their shipgate requests with.
+type authenticator struct {
+	keys map[string]callerKey
+	// Requests without an API key are only accepted from this machine, and only
+	// when there aren't any callers configured.
+	allowLocal bool
+	isLocal    func(ip net.IP) bool
+	now        func() time.Time
+}
+
+func newAuthenticator(cfg *core.Config) (*authenticator, error) {
+	a := &authenticator{
+		keys:       make(map[string]callerKey),
+		allowLocal: len(cfg.ShipgateServer.Callers) == 0,
+		isLocal:    isLocalIP,
+		now:        time.Now,
+	}
+	for name, c := range cfg.ShipgateServer.Callers {
+		if c.Key == "" {
+			return nil, fmt.Errorf("caller %s has no key", name)
+		}
+		if c.Role != CallerRoleShip && c.Role != CallerRoleAdmin {
+			return nil, fmt.Errorf("caller %s has unknown role %q", name, c.Role)
+		}
+		// Config keys are case-insensitive, so names are too.
+		a.keys[strings.ToLower(name)] = callerKey{secret: []byte(c.Key), role: c.Role}
+	}
+	return a, nil
+}
+
+// wrap returns a handler that only passes authenticated requests on to next.
+func (a *authenticator) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := a.authenticate(r)
+		if err != nil {
+			WriteError(w, twirp.NewError(twirp.Unauthenticated, err.Error()))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(withCaller(r.Context(), c)))
+	})
+}
+
+// authenticate returns the caller that signed the request.
+func (a *authenticator) authenticate(r *http.Request) (*caller, error) {
+	name := strings.ToLower(r.Header.Get(callerHeader))
+	if name == "" {
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil && a.allowLocal && a.isLocal(net.ParseIP(host)) {
+			return &caller{name: "local", role: CallerRoleAdmin}, nil
+		}
+		return nil, ErrUnauthenticated
+	}
+	key, ok := a.keys[name]
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get(timestampHeader), 10, 64)
+	if err != nil {
+		return nil, ErrUnauthenticated
+	}
+	if skew := a.now().Sub(time.Unix(timestamp, 0)); skew > maxClockSkew || skew < -maxClockSkew {
+		return nil, ErrUnauthenticated
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading request: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	signature, err := hex.DecodeString(r.Header.Get(signatureHeader))
+	if err != nil || !hmac.Equal(signature, signRequest(key.secret, name, timestamp, r.URL.Path, body)) {
+		return nil, ErrUnauthenticated
+	}
+	return &caller{name: name, role: key.role}, nil
+}
+
+// authorizeMethod is a twirp hook that stops ships from calling RPCs they aren't allowed to.
+func authorizeMethod(ctx context.Context) (context.Context, error) {
+	method, _ := twirp.MethodName(ctx)
+	if callerFromContext(ctx).isShip() && !shipMethods[method] {
+		return ctx, twirp.NewError(twirp.PermissionDenied, ErrPermissionDenied.Error())
+	}
+	return ctx, nil
+}
+
+// isLocalIP returns whether ip belongs to this machine.
+func isLocalIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	} else if ip.IsLoopback() {
+		return true
+	}
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return false
+	}
+	for _, addr := range addrs {
+		if network, ok := addr.(*net.IPNet); ok && network.IP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func signRequest(secret []byte, name string, timestamp int64, path string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s\n%d\n%s\n", name, timestamp, path)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// signingTransport signs the requests sent to the shipgate with an API key.
+type signingTransport struct {
+	name string
+	key  []byte
+	base http.RoundTripper
+	now  func() time.Time
+}
+
+func (t *signingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if body, err = io.ReadAll(r.Body); err != nil {
+			return nil, err
+		}
+		r.Body.Close()
+	}
+
+	// RoundTrippers aren't supposed to modify the request they're given.
+	name := strings.ToLower(t.name)
+	timestamp := t.now().Unix()
+	signed := r.Clone(r.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.Header.Set(callerHeader, name)
+	signed.Header.Set(timestampHeader, strconv.FormatInt(timestamp, 10))
+	signed.Header.Set(signatureHeader, hex.EncodeToString(signRequest(t.key, name, timestamp, r.URL.Path, body)))
+	return t.base.RoundTrip(signed)
+}
+
+// newHTTPClient returns the client used to send RPCs to the shipgate, which
+// verifies its certificate and signs requests if configured to.
+func newHTTPClient(cfg *core.Config) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caFile := cfg.ShipgateServer.TLS.CAFile; caFile != "" {
+		pem, err := os.ReadFile(cfg.QualifiedPath(caFile))
+		if err != nil {
+			return nil, fmt.Errorf("error reading shipgate CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if apiKey := cfg.ShipgateServer.APIKey; apiKey.Key != "" {
+		roundTripper = &signingTransport{
+			name: apiKey.Name,
+			key:  []byte(apiKey.Key),
+			base: transport,
+			now:  time.Now,
+		}
+	}
+	return &http.Client{Transport: roundTripper}, nil
+}
@@ -0,0 +1,345 @@
+package shipgate
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/core/data"
+)
+
+func TestAuthenticator(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.ShipgateServer.Callers = map[string]struct {
+		Key  string `mapstructure:"key"`
+		Role string `mapstructure:"role"`
+	}{
+		"community ship": {Key: "ship key", Role: CallerRoleShip},
+	}
+	a, err := newAuthenticator(cfg)
+	if err != nil {
+		t.Fatalf("newAuthenticator() returned an unexpected error: %v", err)
+	}
+	a.isLocal = func(net.IP) bool { return true }
+
+	server := httptest.NewServer(a.wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		c := callerFromContext(r.Context())
+		_, _ = io.WriteString(w, c.name+" "+c.role+" "+string(body))
+	})))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		transport http.RoundTripper
+		want      string
+		wantCode  int
+	}{
+		{
+			name:      "valid key",
+			transport: &signingTransport{name: "Community Ship", key: []byte("ship key"), base: http.DefaultTransport, now: time.Now},
+			want:      "community ship ship hello",
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "wrong key",
+			transport: &signingTransport{name: "Community Ship", key: []byte("guess"), base: http.DefaultTransport, now: time.Now},
+			wantCode:  http.StatusUnauthorized,
+		},
+		{
+			name:      "unknown caller",
+			transport: &signingTransport{name: "Other Ship", key: []byte("ship key"), base: http.DefaultTransport, now: time.Now},
+			wantCode:  http.StatusUnauthorized,
+		},
+		{
+			name: "stale timestamp",
+			transport: &signingTransport{name: "Community Ship", key: []byte("ship key"), base: http.DefaultTransport, now: func() time.Time {
+				return time.Now().Add(-2 * maxClockSkew)
+			}},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:      "unsigned with callers configured",
+			transport: http.DefaultTransport,
+			wantCode:  http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &http.Client{Transport: tt.transport}
+			resp, err := client.Post(server.URL+"/twirp/Shipgate/Test", "text/plain", strings.NewReader("hello"))
+			if err != nil {
+				t.Fatalf("request returned an unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantCode {
+				t.Fatalf("got status %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			if body, _ := io.ReadAll(resp.Body); tt.want != "" && string(body) != tt.want {
+				t.Errorf("got response %q, want %q", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_Local(t *testing.T) {
+	a, err := newAuthenticator(&core.Config{})
+	if err != nil {
+		t.Fatalf("newAuthenticator() returned an unexpected error: %v", err)
+	}
+	local := true
+	a.isLocal = func(net.IP) bool { return local }
+
+	r := httptest.NewRequest(http.MethodPost, "/twirp/Shipgate/Test", nil)
+	if c, err := a.authenticate(r); err != nil || c.role != CallerRoleAdmin {
+		t.Errorf("expected local requests to be allowed without a key, got %+v (error: %v)", c, err)
+	}
+	local = false
+	if _, err := a.authenticate(r); err != ErrUnauthenticated {
+		t.Errorf("expected remote requests without a key to be rejected, got %v", err)
+	}
+}
+
+func TestNewAuthenticator_InvalidRole(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.ShipgateServer.Callers = map[string]struct {
+		Key  string `mapstructure:"key"`
+		Role string `mapstructure:"role"`
+	}{
+		"community ship": {Key: "ship key", Role: "superuser"},
+	}
+	if _, err := newAuthenticator(cfg); err == nil {
+		t.Error("expected an error for an unknown role")
+	}
+}
+
+func TestService_CheckHosting(t *testing.T) {
+	s := &service{locations: newPlayerLocations()}
+	s.locations.set(&PlayerLocation{AccountId: 1, Guildcard: 42000001, Ship: "Community Ship"})
+
+	tests := []struct {
+		name      string
+		caller    *caller
+		accountID uint64
+		wantErr   bool
+	}{
+		{name: "hosting ship", caller: &caller{name: "community ship", role: CallerRoleShip}, accountID: 1},
+		{name: "other ship", caller: &caller{name: "other ship", role: CallerRoleShip}, accountID: 1, wantErr: true},
+		{name: "player not on ship", caller: &caller{name: "community ship", role: CallerRoleShip}, accountID: 2, wantErr: true},
+		{name: "admin", caller: &caller{name: "login", role: CallerRoleAdmin}, accountID: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.checkHosting(withCaller(context.Background(), tt.caller), tt.accountID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkHosting() returned error %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_SetPlayerLocation(t *testing.T) {
+	s := &service{logger: zap.NewNop().Sugar(), locations: newPlayerLocations(), verified: newVerifiedAccounts()}
+	s.verified.add("community ship", 1, 42000001, time.Now().Add(time.Hour))
+	ship := withCaller(context.Background(), &caller{name: "community ship", role: CallerRoleShip})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		location *PlayerLocation
+		wantErr  bool
+	}{
+		{name: "verified account", ctx: ship, location: &PlayerLocation{AccountId: 1, Guildcard: 42000001, Ship: "Community Ship"}},
+		{name: "account never verified", ctx: ship, location: &PlayerLocation{AccountId: 2, Guildcard: 42000002, Ship: "Community Ship"}, wantErr: true},
+		{name: "someone else's guildcard", ctx: ship, location: &PlayerLocation{AccountId: 1, Guildcard: 42000002, Ship: "Community Ship"}, wantErr: true},
+		{name: "other ship", ctx: ship, location: &PlayerLocation{AccountId: 1, Guildcard: 42000001, Ship: "Other Ship"}, wantErr: true},
+		{name: "admin", ctx: context.Background(), location: &PlayerLocation{AccountId: 3, Guildcard: 42000003, Ship: "Community Ship"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.SetPlayerLocation(tt.ctx, tt.location)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetPlayerLocation() returned error %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Claiming an account it never verified doesn't let the ship act on its behalf.
+	if err := s.checkHosting(ship, 2); err == nil {
+		t.Errorf("expected the ship not to be hosting account 2")
+	}
+	if err := s.checkHosting(ship, 1); err != nil {
+		t.Errorf("expected the ship to be hosting account 1, got %v", err)
+	}
+}
+
+func TestService_SetPlayerPresence(t *testing.T) {
+	s := &service{
+		logger:    zap.NewNop().Sugar(),
+		locations: newPlayerLocations(),
+		presences: newPlayerPresences(),
+		verified:  newVerifiedAccounts(),
+	}
+	s.verified.add("community ship", 1, 42000001, time.Now().Add(time.Hour))
+	ship := withCaller(context.Background(), &caller{name: "community ship", role: CallerRoleShip})
+
+	if _, err := s.SetPlayerPresence(ship, &PlayerPresence{AccountId: 2, Ship: "Community Ship", Server: "SHIP"}); err == nil {
+		t.Errorf("expected the ship not to be able to report an account it never verified")
+	}
+	if _, err := s.SetPlayerPresence(ship, &PlayerPresence{AccountId: 1, Ship: "Other Ship", Server: "SHIP"}); err == nil {
+		t.Errorf("expected the ship not to be able to report players on another ship")
+	}
+	if _, err := s.SetPlayerPresence(ship, &PlayerPresence{AccountId: 1, Ship: "Community Ship", Server: "SHIP"}); err != nil {
+		t.Fatalf("SetPlayerPresence() returned error %v", err)
+	}
+
+	// Players at character select or on the ship menu can't have their characters edited.
+	_, err := s.EditCharacter(context.Background(), &EditCharacterRequest{AccountId: 1, Level: 10})
+	if err == nil || !strings.Contains(err.Error(), ErrPlayerOnline.Error()) {
+		t.Errorf("expected EditCharacter() to fail with %v, got %v", ErrPlayerOnline, err)
+	}
+}
+
+// newTestDatabase returns a database with the accounts for tests of RPCs that
+// need one.
+func newTestDatabase(t *testing.T, accounts ...*data.Account) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")))
+	if err != nil {
+		t.Fatalf("error initializing test database: %v", err)
+	}
+	if err := db.AutoMigrate(&data.Account{}, &data.AuditEntry{}, &data.Character{}, &data.ChallengeRecord{}, &data.ScheduledBroadcast{}); err != nil {
+		t.Fatalf("error auto migrating db: %v", err)
+	}
+	for _, account := range accounts {
+		if err := data.CreateAccount(db, account); err != nil {
+			t.Fatalf("error creating account: %v", err)
+		}
+	}
+	return db
+}
+
+func TestShipMethods_KickPlayer(t *testing.T) {
+	gm := &data.Account{Username: "gm", Email: "gm@example.com", Guildcard: 42000001, GM: true}
+	player := &data.Account{Username: "player", Email: "player@example.com", Guildcard: 42000002}
+	db := newTestDatabase(t, gm, player)
+
+	cfg := &core.Config{}
+	cfg.ShipgateServer.Callers = map[string]struct {
+		Key  string `mapstructure:"key"`
+		Role string `mapstructure:"role"`
+	}{
+		"community ship": {Key: "ship key", Role: CallerRoleShip},
+	}
+	a, err := newAuthenticator(cfg)
+	if err != nil {
+		t.Fatalf("newAuthenticator() returned an unexpected error: %v", err)
+	}
+	a.isLocal = func(net.IP) bool { return false }
+	roles, err := auth.NewRoles(cfg)
+	if err != nil {
+		t.Fatalf("NewRoles() returned an unexpected error: %v", err)
+	}
+
+	s := &service{
+		logger:    zap.NewNop().Sugar(),
+		db:        db,
+		roles:     roles,
+		events:    newEventQueue(),
+		locations: newPlayerLocations(),
+		verified:  newVerifiedAccounts(),
+	}
+	// The GM is on the ship while the player is somewhere else.
+	s.locations.set(&PlayerLocation{AccountId: gm.ID, Guildcard: 42000001, Ship: "Community Ship", BlockAddress: "block1"})
+	s.locations.set(&PlayerLocation{AccountId: player.ID, Guildcard: 42000002, Ship: "Other Ship", BlockAddress: "block2"})
+
+	server := httptest.NewServer(a.wrap(NewShipgateServer(s, &twirp.ServerHooks{RequestRouted: authorizeMethod})))
+	defer server.Close()
+	client := NewShipgateProtobufClient(server.URL, &http.Client{Transport: &signingTransport{
+		name: "Community Ship", key: []byte("ship key"), base: http.DefaultTransport, now: time.Now,
+	}})
+
+	tests := []struct {
+		name    string
+		actorID uint64
+		wantErr bool
+	}{
+		{name: "GM on the ship", actorID: gm.ID},
+		{name: "actor without permission", actorID: player.ID, wantErr: true},
+		{name: "no actor", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.KickPlayer(context.Background(), &KickPlayerRequest{
+				ActorAccountId: tt.actorID,
+				AccountId:      player.ID,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("KickPlayer() returned error %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := client.MessagePlayer(context.Background(), &MessagePlayerRequest{
+		ActorAccountId: gm.ID,
+		AccountId:      player.ID,
+		Message:        "hello",
+	}); err != nil {
+		t.Errorf("MessagePlayer() returned an unexpected error: %v", err)
+	}
+}
+
+func TestService_RecordAuditEntry(t *testing.T) {
+	gm := &data.Account{Username: "gm", Email: "gm@example.com", Guildcard: 42000001, GM: true}
+	player := &data.Account{Username: "player", Email: "player@example.com", Guildcard: 42000002}
+	elsewhere := &data.Account{Username: "admin", Email: "admin@example.com", Guildcard: 42000003, PrivilegeLevel: auth.LevelAdmin}
+	roles, err := auth.NewRoles(&core.Config{})
+	if err != nil {
+		t.Fatalf("NewRoles() returned an unexpected error: %v", err)
+	}
+	s := &service{
+		logger:    zap.NewNop().Sugar(),
+		db:        newTestDatabase(t, gm, player, elsewhere),
+		roles:     roles,
+		locations: newPlayerLocations(),
+	}
+	s.locations.set(&PlayerLocation{AccountId: gm.ID, Guildcard: 42000001, Ship: "Community Ship", BlockAddress: "block1"})
+	s.locations.set(&PlayerLocation{AccountId: player.ID, Guildcard: 42000002, Ship: "Community Ship", BlockAddress: "block1"})
+	s.locations.set(&PlayerLocation{AccountId: elsewhere.ID, Guildcard: 42000003, Ship: "Other Ship", BlockAddress: "block2"})
+	ship := withCaller(context.Background(), &caller{name: "community ship", role: CallerRoleShip})
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		entry   *AuditEntry
+		wantErr bool
+	}{
+		{name: "command the actor can run", ctx: ship, entry: &AuditEntry{ActorAccountId: gm.ID, Action: "kick", Target: "sonic"}},
+		{name: "command the actor can't run", ctx: ship, entry: &AuditEntry{ActorAccountId: player.ID, Action: "ban", Target: "sonic"}, wantErr: true},
+		{name: "actor on another ship", ctx: ship, entry: &AuditEntry{ActorAccountId: elsewhere.ID, Action: "ban", Target: "sonic"}, wantErr: true},
+		{name: "action that isn't a command", ctx: ship, entry: &AuditEntry{ActorAccountId: gm.ID, Action: "set_privilege_level", Target: "gm"}, wantErr: true},
+		{name: "no actor", ctx: ship, entry: &AuditEntry{Action: "kick", Target: "sonic"}, wantErr: true},
+		{name: "admin", ctx: context.Background(), entry: &AuditEntry{Action: "set_privilege_level", Target: "gm"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.RecordAuditEntry(tt.ctx, tt.entry)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RecordAuditEntry() returned error %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,10 +1,47 @@
 package shipgate
 
 import (
+	"time"
+
 	"github.com/dcrodman/archon/internal/core/data"
 	"github.com/dcrodman/archon/internal/core/proto"
 )
 
+func accountToProto(account *data.Account) *proto.Account {
+	return &proto.Account{
+		Id:               uint64(account.ID),
+		Username:         account.Username,
+		Email:            account.Email,
+		RegistrationDate: account.RegistrationDate.Format(time.RFC3339),
+		Guildcard:        uint64(account.Guildcard),
+		Gm:               account.GM,
+		Banned:           account.Banned,
+		Active:           account.Active,
+		TeamId:           int64(account.TeamID),
+		PrivilegeLevel:   []byte{account.PrivilegeLevel},
+	}
+}
+
+func shipToProto(s *ship) *proto.Ship {
+	return &proto.Ship{
+		Id:          int32(s.id),
+		Name:        s.name,
+		Ip:          s.ip,
+		Port:        s.port,
+		PlayerCount: int32(s.playerCount()),
+		MaxPlayers:  int32(s.maxPlayers),
+	}
+}
+
+func characterProfileToProto(character *data.Character) *CharacterProfile {
+	return &CharacterProfile{
+		Name:      character.ReadableName,
+		Class:     int32(character.Class),
+		Level:     character.Level + 1,
+		SectionId: int32(character.SectionID),
+	}
+}
+
 func characterToProto(character *data.Character) *proto.Character {
 	protoCharacter := &proto.Character{
 		Id:                character.ID,
@@ -44,10 +81,30 @@ func characterToProto(character *data.Character) *proto.Character {
 		Meseta:            character.Meseta,
 		HpMaterialsUsed:   int32(character.HPMaterialsUsed),
 		TpMaterialsUsed:   int32(character.TPMaterialsUsed),
+		InfoBoard:         character.InfoBoard,
+		ChallengeTitle:    character.ChallengeTitle,
 	}
 	return protoCharacter
 }
 
+func challengeRecordToProto(record *data.ChallengeRecord) *proto.ChallengeRecord {
+	return &proto.ChallengeRecord{
+		Episode:   record.Episode,
+		Stage:     record.Stage,
+		ClearTime: record.ClearTime,
+		Rank:      record.Rank,
+	}
+}
+
+func challengeRecordFromProto(record *proto.ChallengeRecord) data.ChallengeRecord {
+	return data.ChallengeRecord{
+		Episode:   record.Episode,
+		Stage:     record.Stage,
+		ClearTime: record.ClearTime,
+		Rank:      record.Rank,
+	}
+}
+
 func characterFromProto(character *proto.Character) *data.Character {
 	dbCharacter := &data.Character{
 		Guildcard:         character.Guildcard,
@@ -86,6 +143,8 @@ func characterFromProto(character *proto.Character) *data.Character {
 		Meseta:            character.Meseta,
 		HPMaterialsUsed:   byte(character.HpMaterialsUsed),
 		TPMaterialsUsed:   byte(character.TpMaterialsUsed),
+		InfoBoard:         character.InfoBoard,
+		ChallengeTitle:    character.ChallengeTitle,
 	}
 	return dbCharacter
 }
@@ -116,3 +175,47 @@ func playerOptionsFromProto(playerOptions *proto.PlayerOptions) *data.PlayerOpti
 		KeyConfig: playerOptions.KeyConfig,
 	}
 }
+
+func violationToProto(violation *data.Violation) *Violation {
+	return &Violation{
+		Id:            violation.ID,
+		AccountId:     violation.AccountID,
+		CharacterName: violation.CharacterName,
+		Rule:          violation.Rule,
+		Details:       violation.Details,
+		Action:        violation.Action,
+		Source:        violation.Source,
+		CreatedAt:     violation.CreatedAt.Unix(),
+	}
+}
+
+func banToProto(ban *data.Ban) *Ban {
+	protoBan := &Ban{
+		Id:        ban.ID,
+		Type:      ban.Type,
+		AccountId: ban.AccountID,
+		Value:     ban.Value,
+		Reason:    ban.Reason,
+		IssuedBy:  ban.IssuedBy,
+		CreatedAt: ban.CreatedAt.Unix(),
+	}
+	if ban.ExpiresAt != nil {
+		protoBan.ExpiresAt = ban.ExpiresAt.Unix()
+	}
+	return protoBan
+}
+
+func banFromProto(ban *Ban) *data.Ban {
+	dataBan := &data.Ban{
+		Type:      ban.Type,
+		AccountID: ban.AccountId,
+		Value:     ban.Value,
+		Reason:    ban.Reason,
+		IssuedBy:  ban.IssuedBy,
+	}
+	if ban.ExpiresAt != 0 {
+		expires := time.Unix(ban.ExpiresAt, 0)
+		dataBan.ExpiresAt = &expires
+	}
+	return dataBan
+}
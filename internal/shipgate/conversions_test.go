@@ -0,0 +1,88 @@
+package shipgate
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/dcrodman/archon/internal/core/data"
+	"github.com/dcrodman/archon/internal/core/proto"
+)
+
+func TestService_UpsertCharacter_KeepsChallengeTitle(t *testing.T) {
+	account := &data.Account{Username: "sonic", Email: "sonic@example.com", Guildcard: 42000001}
+	s := &service{logger: zap.NewNop().Sugar(), db: newTestDatabase(t, account), locations: newPlayerLocations()}
+	if err := data.UpsertCharacter(s.db, &data.Character{
+		AccountID:      account.ID,
+		Slot:           1,
+		ReadableName:   "sonic",
+		ChallengeTitle: "Master",
+	}); err != nil {
+		t.Fatalf("error creating character: %v", err)
+	}
+
+	resp, err := s.FindCharacter(context.Background(), &CharacterRequest{AccountId: account.ID, Slot: 1})
+	if err != nil {
+		t.Fatalf("FindCharacter() returned an unexpected error: %v", err)
+	}
+	character := resp.Character
+	character.Experience = 100
+	if _, err := s.UpsertCharacter(context.Background(), &UpsertCharacterRequest{
+		AccountId: account.ID,
+		Character: character,
+	}); err != nil {
+		t.Fatalf("UpsertCharacter() returned an unexpected error: %v", err)
+	}
+
+	saved, err := data.FindCharacter(s.db, uint(account.ID), 1)
+	if err != nil || saved == nil {
+		t.Fatalf("error finding character: %v", err)
+	}
+	if saved.Experience != 100 || saved.ChallengeTitle != "Master" {
+		t.Errorf("expected the character to be saved with its challenge title, got experience %d and title %q",
+			saved.Experience, saved.ChallengeTitle)
+	}
+}
+
+func TestService_UpdateCharacterStats(t *testing.T) {
+	account := &data.Account{Username: "sonic", Email: "sonic@example.com", Guildcard: 42000001}
+	s := &service{logger: zap.NewNop().Sugar(), db: newTestDatabase(t, account), locations: newPlayerLocations()}
+	if err := data.UpsertCharacter(s.db, &data.Character{
+		AccountID:      account.ID,
+		Slot:           1,
+		ReadableName:   "sonic",
+		InfoBoard:      []byte("hello"),
+		ChallengeTitle: "Master",
+	}); err != nil {
+		t.Fatalf("error creating character: %v", err)
+	}
+
+	// Progress is saved from the character the block loaded, which has none of the
+	// changes made since.
+	if _, err := s.UpdateCharacterStats(context.Background(), &UpdateCharacterStatsRequest{
+		AccountId: account.ID,
+		Slot:      1,
+		Character: &proto.Character{Slot: 1, Level: 4, Experience: 500, Atp: 30},
+	}); err != nil {
+		t.Fatalf("UpdateCharacterStats() returned an unexpected error: %v", err)
+	}
+
+	saved, err := data.FindCharacter(s.db, uint(account.ID), 1)
+	if err != nil || saved == nil {
+		t.Fatalf("error finding character: %v", err)
+	}
+	if saved.Level != 4 || saved.Experience != 500 || saved.ATP != 30 {
+		t.Errorf("expected the character's progress to be saved, got %+v", saved)
+	}
+	if string(saved.InfoBoard) != "hello" || saved.ChallengeTitle != "Master" || saved.ReadableName != "sonic" {
+		t.Errorf("expected the rest of the character to be kept, got %+v", saved)
+	}
+}
+
+func TestCharacterFromProto(t *testing.T) {
+	character := &proto.Character{ReadableName: "sonic", InfoBoard: []byte("hello"), ChallengeTitle: "Master"}
+	if got := characterToProto(characterFromProto(character)); got.ChallengeTitle != "Master" || string(got.InfoBoard) != "hello" {
+		t.Errorf("expected the character to survive a round trip, got %v", got)
+	}
+}
@@ -0,0 +1,141 @@
+package shipgate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// Number of recent events kept around for ships that fall behind between polls.
+	maxBufferedEvents = 100
+	// How long a PollEvents call waits for new events before returning empty-handed.
+	pollTimeout = 20 * time.Second
+)
+
+// How long WatchEvents waits before polling the shipgate again after an error.
+var pollRetryInterval = 5 * time.Second
+
+// eventQueue holds the events published by the shipgate so that ships can
+// long-poll for them. Events are assigned increasing IDs and ships keep track
+// of the last one they've seen.
+type eventQueue struct {
+	mu     sync.Mutex
+	events []*Event
+	lastID uint64
+	// Closed and replaced every time an event is published in order to wake up any waiting pollers.
+	notify chan struct{}
+}
+
+func newEventQueue() *eventQueue {
+	return &eventQueue{
+		// Starting from the current time means IDs keep increasing across shipgate
+		// restarts, so ships polling with an ID from before the restart still
+		// receive everything published since.
+		lastID: uint64(time.Now().UnixNano()),
+		notify: make(chan struct{}),
+	}
+}
+
+// publish assigns e an ID and makes it available to pollers.
+func (q *eventQueue) publish(e *Event) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.lastID++
+	e.Id = q.lastID
+	q.events = append(q.events, e)
+	if len(q.events) > maxBufferedEvents {
+		q.events = q.events[len(q.events)-maxBufferedEvents:]
+	}
+
+	close(q.notify)
+	q.notify = make(chan struct{})
+}
+
+// publishAt publishes e once deliverAt has passed, then calls onPublish (if set).
+func (q *eventQueue) publishAt(e *Event, deliverAt time.Time, onPublish func()) {
+	publish := func() {
+		q.publish(e)
+		if onPublish != nil {
+			onPublish()
+		}
+	}
+	delay := time.Until(deliverAt)
+	if delay <= 0 {
+		publish()
+		return
+	}
+	time.AfterFunc(delay, publish)
+}
+
+// since returns any events newer than afterID along with the latest event ID.
+// Callers that haven't seen any events yet (afterID of 0) only receive the
+// latest ID so that they don't replay old events. The same goes for callers
+// that are somehow ahead of the queue.
+func (q *eventQueue) since(afterID uint64) ([]*Event, uint64, <-chan struct{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if afterID == 0 || afterID > q.lastID {
+		return nil, q.lastID, q.notify
+	}
+	var events []*Event
+	for _, e := range q.events {
+		if e.Id > afterID {
+			events = append(events, e)
+		}
+	}
+	return events, q.lastID, q.notify
+}
+
+// wait blocks until there are events newer than afterID, timeout elapses,
+// or ctx is cancelled.
+func (q *eventQueue) wait(ctx context.Context, afterID uint64, timeout time.Duration) ([]*Event, uint64) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		events, lastID, notify := q.since(afterID)
+		if len(events) > 0 || lastID != afterID {
+			return events, lastID
+		}
+
+		select {
+		case <-notify:
+		case <-timer.C:
+			return nil, lastID
+		case <-ctx.Done():
+			return nil, lastID
+		}
+	}
+}
+
+// WatchEvents polls client for events published by the shipgate and passes them
+// to handle until ctx is cancelled. Errors are logged under name and retried.
+func WatchEvents(ctx context.Context, client Shipgate, logger *zap.SugaredLogger, name string, handle func(*Event)) {
+	var lastID uint64
+	for {
+		resp, err := client.PollEvents(ctx, &PollEventsRequest{AfterId: lastID})
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Warnf("[%s] error polling shipgate for events: %v", name, err)
+			// Give the shipgate a chance to come back before trying again.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(pollRetryInterval):
+			}
+			continue
+		}
+
+		for _, event := range resp.Events {
+			handle(event)
+		}
+		lastID = resp.LastId
+	}
+}
@@ -0,0 +1,143 @@
+package shipgate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcrodman/archon/internal/core/data"
+)
+
+func TestEventQueue(t *testing.T) {
+	q := newEventQueue()
+	ctx := context.Background()
+
+	// New pollers only receive the current position in the queue.
+	q.publish(&Event{})
+	events, lastID := q.wait(ctx, 0, time.Second)
+	if len(events) != 0 {
+		t.Fatalf("expected no events for a new poller, got %d", len(events))
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		q.publish(&Event{Payload: &Event_Announcement{Announcement: &Announcement{Message: "hello"}}})
+	}()
+	events, nextID := q.wait(ctx, lastID, time.Second)
+	if len(events) != 1 || events[0].GetAnnouncement().GetMessage() != "hello" {
+		t.Fatalf("expected to receive the published announcement, got %v", events)
+	}
+	if nextID != events[0].Id || nextID <= lastID {
+		t.Errorf("expected last ID to be %d, got %d", events[0].Id, nextID)
+	}
+
+	events, _ = q.wait(ctx, nextID, 10*time.Millisecond)
+	if len(events) != 0 {
+		t.Errorf("expected poll to time out without events, got %d", len(events))
+	}
+}
+
+func TestEventQueue_PublishAt(t *testing.T) {
+	q := newEventQueue()
+	_, lastID := q.wait(context.Background(), 0, time.Second)
+
+	q.publishAt(&Event{}, time.Now().Add(20*time.Millisecond), nil)
+	if events, _, _ := q.since(lastID); len(events) != 0 {
+		t.Fatalf("expected scheduled event not to be published yet")
+	}
+
+	events, _ := q.wait(context.Background(), lastID, time.Second)
+	if len(events) != 1 {
+		t.Errorf("expected scheduled event to be published, got %d events", len(events))
+	}
+}
+
+// pollingShipgate serves a fixed series of PollEvents responses.
+type pollingShipgate struct {
+	Shipgate
+	responses []*PollEventsResponse
+	afterIDs  []uint64
+	cancel    func()
+}
+
+func (p *pollingShipgate) PollEvents(ctx context.Context, req *PollEventsRequest) (*PollEventsResponse, error) {
+	p.afterIDs = append(p.afterIDs, req.AfterId)
+	if len(p.afterIDs) == 1 {
+		return nil, errors.New("shipgate unavailable")
+	}
+	if len(p.responses) == 0 {
+		p.cancel()
+		return nil, ctx.Err()
+	}
+	resp := p.responses[0]
+	p.responses = p.responses[1:]
+	return resp, nil
+}
+
+func TestWatchEvents(t *testing.T) {
+	defer func(interval time.Duration) { pollRetryInterval = interval }(pollRetryInterval)
+	pollRetryInterval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &pollingShipgate{
+		responses: []*PollEventsResponse{
+			{LastId: 10},
+			{LastId: 12, Events: []*Event{{Id: 11}, {Id: 12}}},
+		},
+		cancel: cancel,
+	}
+
+	var handled []uint64
+	WatchEvents(ctx, client, zap.NewNop().Sugar(), "TEST", func(e *Event) {
+		handled = append(handled, e.Id)
+	})
+
+	if len(handled) != 2 || handled[0] != 11 || handled[1] != 12 {
+		t.Errorf("expected events 11 and 12 to be handled, got %v", handled)
+	}
+	// The failed poll is retried from the start, then each poll picks up where the last left off.
+	expected := []uint64{0, 0, 10, 12}
+	if len(client.afterIDs) != len(expected) {
+		t.Fatalf("expected polls after %v, got %v", expected, client.afterIDs)
+	}
+	for i, id := range expected {
+		if client.afterIDs[i] != id {
+			t.Errorf("expected poll %d to be after %d, got %d", i, id, client.afterIDs[i])
+		}
+	}
+}
+
+func TestService_ScheduledBroadcast(t *testing.T) {
+	s := &service{logger: zap.NewNop().Sugar(), db: newTestDatabase(t), events: newEventQueue()}
+
+	req := &BroadcastRequest{Message: "Restarting soon", DeliverAt: time.Now().Add(time.Hour).Unix()}
+	if _, err := s.Broadcast(context.Background(), req); err != nil {
+		t.Fatalf("Broadcast() returned an unexpected error: %v", err)
+	}
+	broadcasts, err := data.FindScheduledBroadcasts(s.db)
+	if err != nil || len(broadcasts) != 1 {
+		t.Fatalf("expected the broadcast to be saved, got %v (err: %v)", broadcasts, err)
+	}
+
+	// Simulate a restart after the broadcast came due.
+	if err := s.db.Model(&broadcasts[0]).Update("deliver_at", time.Now().Add(-time.Minute)).Error; err != nil {
+		t.Fatalf("error updating broadcast: %v", err)
+	}
+	restarted := &service{logger: s.logger, db: s.db, events: newEventQueue()}
+	_, lastID := restarted.events.wait(context.Background(), 0, time.Second)
+	if err := restarted.resumeBroadcasts(); err != nil {
+		t.Fatalf("resumeBroadcasts() returned an unexpected error: %v", err)
+	}
+
+	events, _, _ := restarted.events.since(lastID)
+	if len(events) != 1 || events[0].GetAnnouncement().GetMessage() != req.Message {
+		t.Fatalf("expected the saved broadcast to be published, got %v", events)
+	}
+	if broadcasts, _ := data.FindScheduledBroadcasts(s.db); len(broadcasts) != 0 {
+		t.Errorf("expected the sent broadcast to be removed, got %v", broadcasts)
+	}
+}
@@ -0,0 +1,128 @@
+package shipgate
+
+import (
+	"sync"
+	"time"
+)
+
+// Used if the config doesn't specify how long lockouts last.
+const (
+	defaultLockoutDuration    = time.Minute
+	defaultMaxLockoutDuration = time.Hour
+)
+
+// loginFailures is the record of failed logins for one username or IP address.
+type loginFailures struct {
+	count       int
+	last        time.Time
+	lockedUntil time.Time
+}
+
+// loginLockouts tracks failed logins by username and by IP address. Once either
+// reaches its threshold it's locked out, and each further failure doubles the
+// lockout up to maxDuration. A threshold of 0 disables that kind of lockout.
+type loginLockouts struct {
+	mu       sync.Mutex
+	failures map[string]*loginFailures
+	now      func() time.Time
+
+	usernameThreshold int
+	ipThreshold       int
+	duration          time.Duration
+	maxDuration       time.Duration
+}
+
+func newLoginLockouts(usernameThreshold, ipThreshold int, duration, maxDuration time.Duration) *loginLockouts {
+	if duration <= 0 {
+		duration = defaultLockoutDuration
+	}
+	if maxDuration < duration {
+		maxDuration = defaultMaxLockoutDuration
+	}
+	return &loginLockouts{
+		failures:          make(map[string]*loginFailures),
+		now:               time.Now,
+		usernameThreshold: usernameThreshold,
+		ipThreshold:       ipThreshold,
+		duration:          duration,
+		maxDuration:       maxDuration,
+	}
+}
+
+// locked returns whether logins for username or from ip are locked out.
+func (l *loginLockouts) locked(username, ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for _, key := range []string{usernameKey(username), ipKey(ip)} {
+		if f, ok := l.failures[key]; ok && now.Before(f.lockedUntil) {
+			return true
+		}
+	}
+	return false
+}
+
+// fail records a failed login and returns whether it locked out the username or ip.
+func (l *loginLockouts) fail(username, ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep()
+	lockedUsername := l.record(usernameKey(username), l.usernameThreshold)
+	lockedIP := ip != "" && l.record(ipKey(ip), l.ipThreshold)
+	return lockedUsername || lockedIP
+}
+
+// succeed clears the failed logins for username after the player logs in. The
+// IP address's failures are kept so that one valid account can't be used to
+// reset the limit for guessing the passwords of others.
+func (l *loginLockouts) succeed(username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, usernameKey(username))
+}
+
+func (l *loginLockouts) record(key string, threshold int) bool {
+	if threshold <= 0 {
+		return false
+	}
+	f, ok := l.failures[key]
+	if !ok {
+		f = &loginFailures{}
+		l.failures[key] = f
+	}
+	f.count++
+	f.last = l.now()
+
+	if f.count < threshold {
+		return false
+	}
+	f.lockedUntil = f.last.Add(l.lockoutDuration(f.count - threshold))
+	return true
+}
+
+// lockoutDuration returns how long to lock out after the nth failure past the threshold.
+func (l *loginLockouts) lockoutDuration(n int) time.Duration {
+	d := l.duration
+	for i := 0; i < n && d < l.maxDuration; i++ {
+		d *= 2
+	}
+	if d > l.maxDuration {
+		return l.maxDuration
+	}
+	return d
+}
+
+// sweep forgets failures that haven't been added to in longer than the longest lockout.
+func (l *loginLockouts) sweep() {
+	now := l.now()
+	for key, f := range l.failures {
+		if now.Sub(f.last) > l.maxDuration && !now.Before(f.lockedUntil) {
+			delete(l.failures, key)
+		}
+	}
+}
+
+func usernameKey(username string) string { return "username:" + username }
+func ipKey(ip string) string             { return "ip:" + ip }
@@ -0,0 +1,78 @@
+package shipgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twitchtv/twirp"
+)
+
+func TestLoginLockouts(t *testing.T) {
+	lockouts := newLoginLockouts(3, 5, time.Minute, 10*time.Minute)
+	now := time.Now()
+	lockouts.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if lockouts.fail("player", "10.0.0.1") {
+			t.Fatalf("expected no lockout after %d failures", i+1)
+		}
+	}
+	if !lockouts.fail("player", "10.0.0.1") || !lockouts.locked("player", "10.0.0.2") {
+		t.Fatalf("expected the username to be locked out after 3 failures")
+	}
+
+	// Each failure past the threshold doubles the lockout.
+	lockouts.fail("player", "10.0.0.1")
+	now = now.Add(time.Minute + time.Second)
+	if !lockouts.locked("player", "10.0.0.2") {
+		t.Errorf("expected the second lockout to last 2 minutes")
+	}
+	now = now.Add(time.Minute)
+	if lockouts.locked("player", "10.0.0.2") {
+		t.Errorf("expected the lockout to have expired")
+	}
+
+	lockouts.succeed("player")
+	if lockouts.fail("player", "10.0.0.3") {
+		t.Errorf("expected a successful login to reset the username's failures")
+	}
+}
+
+func TestLoginLockouts_IPAddress(t *testing.T) {
+	lockouts := newLoginLockouts(0, 3, time.Minute, time.Hour)
+	for _, username := range []string{"a", "b", "c"} {
+		lockouts.fail(username, "10.0.0.1")
+	}
+	if !lockouts.locked("d", "10.0.0.1") {
+		t.Errorf("expected the IP address to be locked out for every username")
+	}
+	if lockouts.locked("d", "10.0.0.2") {
+		t.Errorf("expected other IP addresses not to be locked out")
+	}
+
+	lockouts.succeed("a")
+	if !lockouts.locked("a", "10.0.0.1") {
+		t.Errorf("expected a successful login not to reset the IP address's failures")
+	}
+}
+
+func TestLoginLockouts_MaxDuration(t *testing.T) {
+	lockouts := newLoginLockouts(1, 0, time.Minute, 5*time.Minute)
+	for i, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute} {
+		if got := lockouts.lockoutDuration(i); got != want {
+			t.Errorf("lockoutDuration(%d) = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestIsError(t *testing.T) {
+	if !IsError(ErrAccountLocked, ErrAccountLocked) {
+		t.Errorf("expected an error to match itself")
+	}
+	if !IsError(twirp.InternalErrorWith(ErrAccountLocked), ErrAccountLocked) {
+		t.Errorf("expected a twirp error to match by message")
+	}
+	if IsError(twirp.InternalErrorWith(ErrAccountBanned), ErrAccountLocked) {
+		t.Errorf("expected different errors not to match")
+	}
+}
@@ -0,0 +1,60 @@
+package shipgate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Passwords are stored as bcrypt hashes, which encode their own version, cost,
+// and salt (e.g. "$2a$10$..."). Accounts created before bcrypt was adopted have
+// unsalted, hex-encoded SHA-256 hashes that are replaced the next time the
+// player logs in.
+const bcryptPrefix = "$2"
+
+// HashPassword returns a salted hash of password. cost is the bcrypt work factor;
+// bcrypt.DefaultCost is used if it's not set.
+func HashPassword(password string, cost int) (string, error) {
+	if cost <= 0 {
+		cost = bcrypt.DefaultCost
+	}
+	hash, err := bcrypt.GenerateFromPassword(stripPadding([]byte(password)), cost)
+	if err != nil {
+		return "", fmt.Errorf("error generating password hash: %w", err)
+	}
+	return string(hash), nil
+}
+
+// checkPassword returns whether password matches hash and, if it does, whether
+// hash should be replaced because it uses the legacy scheme or a different cost.
+func checkPassword(hash, password string, cost int) (match bool, rehash bool) {
+	if !strings.HasPrefix(hash, bcryptPrefix) {
+		return hash == legacyHashPassword(password), true
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), stripPadding([]byte(password))); err != nil {
+		return false, false
+	}
+	if cost <= 0 {
+		cost = bcrypt.DefaultCost
+	}
+	hashCost, err := bcrypt.Cost([]byte(hash))
+	return true, err != nil || hashCost != cost
+}
+
+// legacyHashPassword returns the unsalted SHA-256 hash Archon originally stored passwords as.
+func legacyHashPassword(password string) string {
+	hash := sha256.Sum256(stripPadding([]byte(password)))
+	return hex.EncodeToString(hash[:])
+}
+
+func stripPadding(b []byte) []byte {
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != 0 {
+			return b[:i+1]
+		}
+	}
+	return b
+}
@@ -0,0 +1,54 @@
+package shipgate
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("password", bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("unexpected error hashing password: %v", err)
+	}
+	if !strings.HasPrefix(hash, bcryptPrefix) {
+		t.Fatalf("expected a bcrypt hash, got %s", hash)
+	}
+	if other, _ := HashPassword("password", bcrypt.MinCost); other == hash {
+		t.Errorf("expected hashes of the same password to be salted differently")
+	}
+
+	tests := []struct {
+		name       string
+		password   string
+		cost       int
+		wantMatch  bool
+		wantRehash bool
+	}{
+		{name: "matching password", password: "password", cost: bcrypt.MinCost, wantMatch: true},
+		{name: "padded password", password: "password\x00\x00", cost: bcrypt.MinCost, wantMatch: true},
+		{name: "wrong password", password: "hunter2", cost: bcrypt.MinCost},
+		{name: "changed cost", password: "password", cost: bcrypt.MinCost + 1, wantMatch: true, wantRehash: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, rehash := checkPassword(hash, tt.password, tt.cost)
+			if match != tt.wantMatch || rehash != tt.wantRehash {
+				t.Errorf("checkPassword() = %v, %v; want %v, %v", match, rehash, tt.wantMatch, tt.wantRehash)
+			}
+		})
+	}
+}
+
+func TestCheckPassword_Legacy(t *testing.T) {
+	// SHA-256 of "password", as stored by earlier versions of Archon.
+	legacy := "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"
+
+	if match, rehash := checkPassword(legacy, "password", bcrypt.MinCost); !match || !rehash {
+		t.Errorf("checkPassword() = %v, %v; want a match that needs rehashing", match, rehash)
+	}
+	if match, _ := checkPassword(legacy, "hunter2", bcrypt.MinCost); match {
+		t.Errorf("expected the wrong password not to match a legacy hash")
+	}
+}
@@ -2,134 +2,1257 @@ package shipgate
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"errors"
 	"fmt"
-	"sync"
+	"strings"
 	"time"
 
+	"github.com/twitchtv/twirp"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 
+	"github.com/dcrodman/archon/internal/core/auth"
 	"github.com/dcrodman/archon/internal/core/data"
 	"github.com/dcrodman/archon/internal/core/proto"
 )
 
-type ship struct {
-	id   int
-	name string
-	ip   string
-	port string
-	// TODO: Need a way to deregister these reliably. Ships will probably need
-	// their own gRPC service so I'm deferring the heartbeating problem until then.
-	active bool
-}
-
 // Service implements the SHIPGATE server logic, which acts as the data and coordination
 // layer between the other server components. It never directly interacts with the client,
 // only handling RPC requests from other trusted servers.
 type service struct {
-	logger              *zap.SugaredLogger
-	db                  *gorm.DB
-	connectedShips      map[string]*ship
-	connectedShipsMutex sync.RWMutex
+	logger    *zap.SugaredLogger
+	db        *gorm.DB
+	roles     *auth.Roles
+	ships     *shipRegistry
+	events    *eventQueue
+	sessions  *sessionSigner
+	warps     *pendingWarps
+	locations *playerLocations
+	presences *playerPresences
+	verified  *verifiedAccounts
+	lockouts  *loginLockouts
+	levels    LevelTable
+	// bcrypt work factor used to hash passwords.
+	passwordCost int
+	startedAt    time.Time
 }
 
+// Most character profiles returned for a single name.
+const maxCharacterProfiles = 20
+
+// Number of levels characters can have, which are stored starting from 0.
+const maxCharacterLevel = 200
+
 func (s *service) GetActiveShips(ctx context.Context, _ *emptypb.Empty) (*ShipList, error) {
 	s.logger.Debug("GetActiveShips")
-	s.connectedShipsMutex.RLock()
-	defer s.connectedShipsMutex.RUnlock()
 
 	var shipList ShipList
-	for _, connectedShip := range s.connectedShips {
-		shipList.Ships = append(shipList.Ships, &proto.Ship{
-			Id:   int32(connectedShip.id),
-			Name: connectedShip.name,
-			Ip:   connectedShip.ip,
-			Port: connectedShip.port,
-		})
+	for _, activeShip := range s.activeShips() {
+		shipList.Ships = append(shipList.Ships, shipToProto(&activeShip))
 	}
 
 	return &shipList, nil
 }
 
+func (s *service) GetServerStatus(ctx context.Context, _ *emptypb.Empty) (*ServerStatus, error) {
+	s.logger.Debug("GetServerStatus")
+
+	status := &ServerStatus{StartedAt: s.startedAt.Unix()}
+	for _, activeShip := range s.activeShips() {
+		status.Ships = append(status.Ships, &ShipStatus{
+			Ship:   shipToProto(&activeShip),
+			Blocks: activeShip.blocks,
+		})
+	}
+	return status, nil
+}
+
+// activeShips deregisters any ships that have stopped sending heartbeats and
+// returns the rest.
+func (s *service) activeShips() []ship {
+	s.expireShips()
+	return s.ships.active()
+}
+
+// expireShips deregisters any ships that have stopped sending heartbeats along
+// with the players they were hosting, who can't still be connected to them.
+func (s *service) expireShips() {
+	for _, expired := range s.ships.expire() {
+		s.locations.clearShip(expired.name)
+		s.presences.clearShip(expired.name)
+		s.verified.forgetShip(expired.name)
+		s.logger.Infof("[SHIPGATE] deregistered ship %s after it stopped sending heartbeats", expired.name)
+	}
+}
+
+// watchShips deregisters ships as they stop sending heartbeats until ctx is cancelled.
+func (s *service) watchShips(ctx context.Context) {
+	ticker := time.NewTicker(s.ships.timeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.expireShips()
+		}
+	}
+}
+
 func (s *service) RegisterShip(ctx context.Context, req *RegisterShipRequest) (*emptypb.Empty, error) {
 	s.logger.Debug("RegisterShip")
-	s.connectedShipsMutex.Lock()
-	defer s.connectedShipsMutex.Unlock()
-
-	// Ships are never cleared from the map so that we can keep the IDs relatively
-	// stable and allow for brief interruptions while preserving idempotency.
-	if _, ok := s.connectedShips[req.Name]; ok {
-		if !s.connectedShips[req.Name].active {
-			s.logger.Infof("[SHIPGATE] reactivated ship %s at %s:%s", req.Name, req.Address, req.Port)
-		}
-		s.connectedShips[req.Name].active = true
-		s.connectedShips[req.Name].ip = req.Address
-		s.connectedShips[req.Name].port = req.Port
+	if err := checkShip(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	// IDs are saved so that ships keep the same one across restarts.
+	registered, err := data.FindOrCreateShip(s.db, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error registering ship %s: %w", req.Name, err)
+	}
+	if s.ships.register(int(registered.ID), req.Name, req.Address, req.Port, int(req.MaxPlayers)) {
+		s.logger.Infof("[SHIPGATE] reactivated ship %s at %s:%s", req.Name, req.Address, req.Port)
 	} else {
-		s.connectedShips[req.Name] = &ship{
-			id:   len(s.connectedShips) + 1,
-			name: req.Name,
-			ip:   req.Address,
-			port: req.Port,
-		}
 		s.logger.Infof("[SHIPGATE] registered ship %s at %s:%s", req.Name, req.Address, req.Port)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (s *service) ShipHeartbeat(ctx context.Context, req *ShipHeartbeatRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("ShipHeartbeat")
+	if err := checkShip(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	if err := s.ships.heartbeat(req.Name, req.Blocks); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 var (
 	ErrUnknown            = errors.New("an unexpected error occurred, please contact your server administrator")
 	ErrInvalidCredentials = errors.New("username/combination password not found")
 	ErrAccountBanned      = errors.New("this account has been suspended")
+	ErrPermissionDenied   = errors.New("you do not have permission to do that")
+	ErrAccountLocked      = errors.New("too many failed login attempts, please try again later")
+	ErrAccountNotVerified = errors.New("please verify your email address before logging in")
+	ErrPlayerNotOnline    = errors.New("that player is not online")
+	ErrPlayerOnline       = errors.New("that player is online and has to be kicked first")
 )
 
+// IsError returns whether err is target. Errors returned by the shipgate reach
+// its clients as twirp errors, so they're matched by message.
+func IsError(err, target error) bool {
+	if errors.Is(err, target) {
+		return true
+	}
+	var twerr twirp.Error
+	return errors.As(err, &twerr) && twerr.Msg() == target.Error()
+}
+
 func (s *service) AuthenticateAccount(ctx context.Context, req *AuthenticateAccountRequest) (*proto.Account, error) {
 	s.logger.Debug("AuthenticateAccount")
+	if s.lockouts.locked(req.Username, req.IpAddress) {
+		return nil, ErrAccountLocked
+	}
 	account, err := data.FindAccountByUsername(s.db, req.Username)
 	if err != nil {
 		return nil, ErrUnknown
 	}
 
-	if account == nil || account.Password != HashPassword(req.Password) {
+	match, rehash := false, false
+	if account != nil {
+		match, rehash = checkPassword(account.Password, req.Password, s.passwordCost)
+	}
+	if !match {
+		if s.lockouts.fail(req.Username, req.IpAddress) {
+			s.logger.Warnf("[SHIPGATE] locked out logins for %s from %s after repeated failures", req.Username, req.IpAddress)
+		}
 		return nil, ErrInvalidCredentials
-	} else if account.Banned {
+	}
+	s.lockouts.succeed(req.Username)
+
+	if !account.Active {
+		return nil, ErrAccountNotVerified
+	}
+
+	if banned, err := s.isBanned(account, req.IpAddress, req.HardwareId); err != nil {
+		return nil, ErrUnknown
+	} else if banned {
 		return nil, ErrAccountBanned
 	}
 
-	return &proto.Account{
-		Id:               uint64(account.ID),
-		Username:         account.Username,
-		Email:            account.Email,
-		RegistrationDate: account.RegistrationDate.Format(time.RFC3339),
-		Guildcard:        uint64(account.Guildcard),
-		Gm:               account.GM,
-		Banned:           account.Banned,
-		Active:           account.Active,
-		TeamId:           int64(account.TeamID),
-		PrivilegeLevel:   []byte{account.PrivilegeLevel},
-	}, nil
+	if rehash {
+		// Upgrade hashes created with an old scheme (or cost) now that we have the password.
+		if err := s.rehashPassword(account, req.Password); err != nil {
+			s.logger.Warnf("[SHIPGATE] error upgrading password hash of %s: %v", account.Username, err)
+		}
+	}
+
+	return accountToProto(account), nil
+}
+
+func (s *service) IssueSessionToken(ctx context.Context, req *IssueSessionTokenRequest) (*SessionToken, error) {
+	s.logger.Debug("IssueSessionToken")
+
+	session := &data.Session{AccountID: req.AccountId, ExpiresAt: s.sessions.expiry()}
+	if err := data.CreateSession(s.db, session); err != nil {
+		return nil, ErrUnknown
+	}
+	return &SessionToken{Token: s.sessions.sign(session.ID, session.ExpiresAt)}, nil
+}
+
+func (s *service) VerifySessionToken(ctx context.Context, req *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
+	s.logger.Debug("VerifySessionToken")
+
+	sessionID, err := s.sessions.verify(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	session, err := data.FindSession(s.db, sessionID)
+	if err != nil {
+		return nil, ErrUnknown
+	} else if session == nil || !session.Valid(time.Now()) {
+		return nil, ErrInvalidSession
+	}
+
+	account, err := data.FindAccountByID(s.db, uint(session.AccountID))
+	if err != nil {
+		return nil, ErrUnknown
+	} else if account == nil {
+		return nil, ErrInvalidSession
+	}
+	if banned, err := s.isBanned(account, "", ""); err != nil {
+		return nil, ErrUnknown
+	} else if banned {
+		return nil, ErrAccountBanned
+	}
+
+	if c := callerFromContext(ctx); c.isShip() {
+		s.verified.add(c.name, account.ID, uint32(account.Guildcard), session.ExpiresAt)
+	}
+
+	resp := &VerifySessionTokenResponse{Account: accountToProto(account)}
+	if req.BlockAddress != "" {
+		if w := s.warps.take(sessionID, req.BlockAddress); w != nil {
+			resp.Warped = true
+			resp.Slot = w.slot
+			resp.LobbyId = w.lobbyID
+		}
+	}
+	return resp, nil
+}
+
+func (s *service) RevokeSessions(ctx context.Context, req *RevokeSessionsRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("RevokeSessions")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionBan); err != nil {
+		return nil, err
+	}
+	account, err := s.findAccount(req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.revokeSessions(req.AccountId); err != nil {
+		return nil, fmt.Errorf("error revoking sessions of account %s: %w", account.Username, err)
+	}
+
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "revoke_sessions",
+		Target:         account.Username,
+		Source:         "shipgate",
+	})
+}
+
+func (s *service) EndSession(ctx context.Context, req *EndSessionRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("EndSession")
+
+	// Holding the token is enough to end the session, just as it's enough to use it.
+	sessionID, err := s.sessions.verify(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := data.RevokeSession(s.db, sessionID); err != nil {
+		return nil, fmt.Errorf("error ending session %d: %w", sessionID, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) BanPlayer(ctx context.Context, req *BanRequest) (*Ban, error) {
+	s.logger.Debug("BanPlayer")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionBan); err != nil {
+		return nil, err
+	}
+	ban := banFromProto(req.Ban)
+	ban.IssuedBy = req.ActorAccountId
+	return s.issueBan(ctx, ban)
+}
+
+// issueBan creates the ban and records who issued it.
+func (s *service) issueBan(ctx context.Context, ban *data.Ban) (*Ban, error) {
+	target, err := s.createBan(ban)
+	if err != nil {
+		return nil, err
+	}
+
+	details := ban.Reason
+	if ban.ExpiresAt != nil {
+		details = fmt.Sprintf("%s (until %s)", ban.Reason, ban.ExpiresAt.Format(time.RFC3339))
+	}
+	if _, err := s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: ban.IssuedBy,
+		Action:         "ban",
+		Target:         target,
+		Details:        details,
+		Source:         "shipgate",
+	}); err != nil {
+		return nil, err
+	}
+	return banToProto(ban), nil
+}
+
+func (s *service) ListBans(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+	s.logger.Debug("ListBans")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionBan); err != nil {
+		return nil, err
+	}
+	bans, err := data.FindActiveBans(s.db, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving bans: %w", err)
+	}
+
+	resp := &ListBansResponse{}
+	for i := range bans {
+		resp.Bans = append(resp.Bans, banToProto(&bans[i]))
+	}
+	return resp, nil
+}
+
+func (s *service) LiftBan(ctx context.Context, req *LiftBanRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("LiftBan")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionBan); err != nil {
+		return nil, err
+	}
+	ban, err := data.FindBan(s.db, req.BanId)
+	if err != nil {
+		return nil, ErrUnknown
+	} else if ban == nil || !ban.Active(time.Now()) {
+		return nil, fmt.Errorf("ban %d not found", req.BanId)
+	}
+	if err := data.LiftBan(s.db, ban.ID); err != nil {
+		return nil, fmt.Errorf("error lifting ban %d: %w", ban.ID, err)
+	}
+
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "lift_ban",
+		Target:         fmt.Sprintf("ban %d", ban.ID),
+		Source:         "shipgate",
+	})
+}
+
+func (s *service) CheckBan(ctx context.Context, req *CheckBanRequest) (*CheckBanResponse, error) {
+	s.logger.Debug("CheckBan")
+
+	ban, err := s.findBan(req.AccountId, req.IpAddress, req.HardwareId)
+	if err != nil {
+		return nil, ErrUnknown
+	} else if ban == nil {
+		return &CheckBanResponse{}, nil
+	}
+	return &CheckBanResponse{Banned: true, Ban: banToProto(ban)}, nil
+}
+
+// createBan validates and saves ban, logging out the account if it's an account
+// ban. It returns a description of what was banned for the audit log.
+func (s *service) createBan(ban *data.Ban) (string, error) {
+	if err := ban.Validate(); err != nil {
+		return "", err
+	}
+	target := ban.Value
+	if ban.Type == data.BanTypeAccount {
+		account, err := data.FindAccountByID(s.db, uint(ban.AccountID))
+		if err != nil {
+			return "", ErrUnknown
+		} else if account == nil {
+			return "", fmt.Errorf("account %d not found", ban.AccountID)
+		}
+		target = account.Username
+	}
+
+	if err := data.CreateBan(s.db, ban); err != nil {
+		return "", fmt.Errorf("error creating ban: %w", err)
+	}
+	if ban.Type == data.BanTypeAccount {
+		if err := s.revokeSessions(ban.AccountID); err != nil {
+			return "", fmt.Errorf("error revoking sessions of account %d: %w", ban.AccountID, err)
+		}
+	}
+	return target, nil
+}
+
+// revokeSessions logs the account out of every session. Ships also lose the
+// ability to place the account until it logs in to them again.
+func (s *service) revokeSessions(accountID uint64) error {
+	if err := data.RevokeSessions(s.db, accountID); err != nil {
+		return err
+	}
+	s.verified.forget(accountID)
+	return nil
+}
+
+// isBanned returns whether the account is banned, either permanently through
+// the account itself or by a ban on it, the IP address, or the hardware ID.
+func (s *service) isBanned(account *data.Account, ip, hardwareID string) (bool, error) {
+	if account.Banned {
+		return true, nil
+	}
+	ban, err := s.findBan(uint64(account.ID), ip, hardwareID)
+	return ban != nil, err
+}
+
+// findBan returns the first ban in effect on the account, IP address, or hardware ID.
+func (s *service) findBan(accountID uint64, ip, hardwareID string) (*data.Ban, error) {
+	bans, err := data.FindActiveBans(s.db, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for i := range bans {
+		if bans[i].Matches(accountID, ip, hardwareID) {
+			return &bans[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// rehashPassword replaces the account's stored password hash with one using the current scheme.
+func (s *service) rehashPassword(account *data.Account, password string) error {
+	hash, err := HashPassword(password, s.passwordCost)
+	if err != nil {
+		return err
+	}
+	account.Password = hash
+	return data.UpdateAccount(s.db, account)
+}
+
+// authorize returns the acting account if its role grants permission p.
+func (s *service) authorize(ctx context.Context, actorAccountID uint64, p auth.Permission) (*data.Account, error) {
+	if err := s.checkHosting(ctx, actorAccountID); err != nil {
+		return nil, err
+	}
+	actor, err := data.FindAccountByID(s.db, uint(actorAccountID))
+	if err != nil {
+		return nil, ErrUnknown
+	} else if actor == nil || !s.roles.ForLevel(auth.Level(actor.PrivilegeLevel, actor.GM)).Can(p) {
+		return nil, ErrPermissionDenied
+	}
+	return actor, nil
+}
+
+// authorizeOperator is like authorize, but also accepts requests without an actor
+// from servers other than ships, which come from server operator tooling. The
+// returned actor is nil for those requests.
+func (s *service) authorizeOperator(ctx context.Context, actorAccountID uint64, p auth.Permission) (*data.Account, error) {
+	if actorAccountID == 0 {
+		if callerFromContext(ctx).isShip() {
+			return nil, ErrPermissionDenied
+		}
+		return nil, nil
+	}
+	return s.authorize(ctx, actorAccountID, p)
+}
+
+// findAccount returns the account with id or an error if it doesn't exist.
+func (s *service) findAccount(id uint64) (*data.Account, error) {
+	account, err := data.FindAccountByID(s.db, uint(id))
+	if err != nil {
+		return nil, ErrUnknown
+	} else if account == nil {
+		return nil, fmt.Errorf("account %d not found", id)
+	}
+	return account, nil
+}
+
+// checkHosting returns ErrPermissionDenied if the request comes from a ship that
+// isn't hosting the account.
+func (s *service) checkHosting(ctx context.Context, accountID uint64) error {
+	if c := callerFromContext(ctx); c.isShip() && !s.locations.hosting(accountID, c.name) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// checkShip returns ErrPermissionDenied if the request comes from a ship other than name.
+func checkShip(ctx context.Context, name string) error {
+	if c := callerFromContext(ctx); c.isShip() && !strings.EqualFold(c.name, name) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func (s *service) SetPrivilegeLevel(ctx context.Context, req *SetPrivilegeLevelRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("SetPrivilegeLevel")
+
+	actor, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	role := s.roles.ForLevel(byte(req.PrivilegeLevel))
+	if req.PrivilegeLevel > 0xFF || uint32(role.Level) != req.PrivilegeLevel {
+		return nil, fmt.Errorf("no role exists with privilege level %d", req.PrivilegeLevel)
+	}
+	// Accounts can't grant permissions they don't have themselves.
+	if actor != nil {
+		actorRole := s.roles.ForLevel(auth.Level(actor.PrivilegeLevel, actor.GM))
+		if role.All && !actorRole.All {
+			return nil, ErrPermissionDenied
+		}
+		for _, permission := range role.Permissions {
+			if !actorRole.Can(permission) {
+				return nil, ErrPermissionDenied
+			}
+		}
+	}
+
+	account, err := data.FindAccountByUsername(s.db, req.Username)
+	if err != nil {
+		return nil, ErrUnknown
+	} else if account == nil {
+		return nil, fmt.Errorf("account %s not found", req.Username)
+	}
+	account.PrivilegeLevel = role.Level
+	if err := data.UpdateAccount(s.db, account); err != nil {
+		return nil, fmt.Errorf("error updating privilege level for account %s: %w", req.Username, err)
+	}
+
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "set_privilege_level",
+		Target:         account.Username,
+		Details:        role.Name,
+		Source:         "shipgate",
+	})
+}
+
+func (s *service) CreateAccount(ctx context.Context, req *CreateAccountRequest) (*proto.Account, error) {
+	s.logger.Debug("CreateAccount")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+		return nil, err
+	}
+	account, err := s.createAccount(req.Username, req.Password, req.Email, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "create_account",
+		Target:         account.Username,
+		Source:         "shipgate",
+	}); err != nil {
+		return nil, err
+	}
+	return accountToProto(account), nil
+}
+
+func (s *service) RegisterAccount(ctx context.Context, req *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+	s.logger.Debug("RegisterAccount")
+
+	// Players registering themselves need an address to verify and to reset
+	// their password with.
+	if err := validateEmail(req.Email); err != nil {
+		return nil, err
+	}
+
+	resp := &RegisterAccountResponse{}
+	var verification *data.AccountToken
+	if req.VerifyEmail {
+		token, record, err := newAccountToken(0, data.TokenPurposeVerifyEmail, verificationTokenTTL)
+		if err != nil {
+			return nil, err
+		}
+		resp.VerificationToken, verification = token, record
+	}
+	account, err := s.createAccount(req.Username, req.Password, req.Email, verification)
+	if err != nil {
+		return nil, err
+	}
+	resp.Account = accountToProto(account)
+
+	if _, err := s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: account.ID,
+		Action:         "register",
+		Target:         account.Username,
+		Details:        fmt.Sprintf("from %s", req.IpAddress),
+		Source:         "shipgate",
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *service) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*proto.Account, error) {
+	s.logger.Debug("VerifyEmail")
+
+	token, err := data.FindAccountToken(s.db, data.TokenPurposeVerifyEmail, hashAccountToken(req.Token))
+	if err != nil {
+		return nil, ErrUnknown
+	} else if token == nil || !time.Now().Before(token.ExpiresAt) {
+		return nil, invalidArgument(ErrInvalidToken)
+	}
+	account, err := s.findAccount(token.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account.Active = true
+	if err := data.UpdateAccount(s.db, account); err != nil {
+		return nil, fmt.Errorf("error activating account %s: %w", account.Username, err)
+	}
+	if err := data.DeleteAccountTokens(s.db, account.ID, data.TokenPurposeVerifyEmail); err != nil {
+		return nil, fmt.Errorf("error deleting verification tokens of account %s: %w", account.Username, err)
+	}
+
+	if _, err := s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: account.ID,
+		Action:         "verify_email",
+		Target:         account.Username,
+		Details:        account.Email,
+		Source:         "shipgate",
+	}); err != nil {
+		return nil, err
+	}
+	return accountToProto(account), nil
+}
+
+func (s *service) IssuePasswordReset(ctx context.Context, req *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+	s.logger.Debug("IssuePasswordReset")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+		return nil, err
+	}
+
+	var account *data.Account
+	if req.AccountId != 0 {
+		var err error
+		if account, err = s.findAccount(req.AccountId); err != nil {
+			return nil, err
+		}
+	} else {
+		found, err := data.FindAccountByEmail(s.db, req.Email)
+		if err != nil {
+			return nil, ErrUnknown
+		} else if found == nil || found.DeletedAt.Valid {
+			// Callers decide whether to tell the requester that the address is unknown.
+			return &IssuePasswordResetResponse{}, nil
+		}
+		account = found
+	}
+
+	// Only the latest token is valid so that older emails can't be used.
+	if err := data.DeleteAccountTokens(s.db, account.ID, data.TokenPurposeResetPassword); err != nil {
+		return nil, fmt.Errorf("error deleting reset tokens of account %s: %w", account.Username, err)
+	}
+	token, record, err := newAccountToken(account.ID, data.TokenPurposeResetPassword, passwordResetTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := data.CreateAccountToken(s.db, record); err != nil {
+		return nil, fmt.Errorf("error saving reset token for account %s: %w", account.Username, err)
+	}
+
+	if _, err := s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "issue_password_reset",
+		Target:         account.Username,
+		Source:         "shipgate",
+	}); err != nil {
+		return nil, err
+	}
+	return &IssuePasswordResetResponse{Account: accountToProto(account), Token: token}, nil
+}
+
+func (s *service) ResetPassword(ctx context.Context, req *ResetPasswordRequest) (*proto.Account, error) {
+	s.logger.Debug("ResetPassword")
+
+	token, err := data.FindAccountToken(s.db, data.TokenPurposeResetPassword, hashAccountToken(req.Token))
+	if err != nil {
+		return nil, ErrUnknown
+	} else if token == nil || !time.Now().Before(token.ExpiresAt) {
+		return nil, invalidArgument(ErrInvalidToken)
+	}
+	if err := validatePassword(req.Password); err != nil {
+		return nil, err
+	}
+	account, err := s.findAccount(token.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Password, err = HashPassword(req.Password, s.passwordCost); err != nil {
+		return nil, fmt.Errorf("error hashing password: %w", err)
+	}
+	if err := data.UpdateAccount(s.db, account); err != nil {
+		return nil, fmt.Errorf("error updating password of account %s: %w", account.Username, err)
+	}
+	if err := data.DeleteAccountTokens(s.db, account.ID, data.TokenPurposeResetPassword); err != nil {
+		return nil, fmt.Errorf("error deleting reset tokens of account %s: %w", account.Username, err)
+	}
+	// Whoever forced the reset may have been logged in with the old password.
+	if err := s.revokeSessions(account.ID); err != nil {
+		return nil, fmt.Errorf("error revoking sessions of account %s: %w", account.Username, err)
+	}
+
+	if _, err := s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: account.ID,
+		Action:         "reset_password",
+		Target:         account.Username,
+		Source:         "shipgate",
+	}); err != nil {
+		return nil, err
+	}
+	return accountToProto(account), nil
+}
+
+// createAccount validates and saves a new account.
+// createAccount validates and saves a new account. Accounts created with a
+// verification token stay inactive until the token is redeemed.
+func (s *service) createAccount(username, password, email string, verification *data.AccountToken) (*data.Account, error) {
+	if err := s.checkUsername(username); err != nil {
+		return nil, err
+	}
+	if err := validatePassword(password); err != nil {
+		return nil, err
+	}
+	// Email addresses are optional for accounts created by server operators.
+	if email != "" {
+		if err := s.checkEmail(email); err != nil {
+			return nil, err
+		}
+	}
+
+	passwordHash, err := HashPassword(password, s.passwordCost)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing password: %w", err)
+	}
+	account := &data.Account{
+		Username:         username,
+		Password:         passwordHash,
+		Email:            email,
+		RegistrationDate: time.Now(),
+	}
+	if verification != nil {
+		err = data.CreateUnverifiedAccount(s.db, account, verification)
+	} else {
+		err = data.CreateAccount(s.db, account)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating account %s: %w", username, err)
+	}
+	return account, nil
+}
+
+func (s *service) UpdateAccount(ctx context.Context, req *UpdateAccountRequest) (*proto.Account, error) {
+	s.logger.Debug("UpdateAccount")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+		return nil, err
+	}
+	account, err := s.findAccount(req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	if username := req.Username; username != "" && username != account.Username {
+		if err := s.checkUsername(username); err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("username: %s -> %s", account.Username, username))
+		account.Username = username
+	}
+	if req.Email != "" && req.Email != account.Email {
+		if err := s.checkEmail(req.Email); err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("email: %s -> %s", account.Email, req.Email))
+		account.Email = req.Email
+	}
+	if len(changes) == 0 {
+		return accountToProto(account), nil
+	}
+	if err := data.UpdateAccount(s.db, account); err != nil {
+		return nil, fmt.Errorf("error updating account %d: %w", account.ID, err)
+	}
+
+	if _, err := s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "update_account",
+		Target:         account.Username,
+		Details:        strings.Join(changes, ", "),
+		Source:         "shipgate",
+	}); err != nil {
+		return nil, err
+	}
+	return accountToProto(account), nil
+}
+
+func (s *service) SetPassword(ctx context.Context, req *SetPasswordRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("SetPassword")
+
+	self := req.ActorAccountId != 0 && req.ActorAccountId == req.AccountId
+	if !self {
+		if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+			return nil, err
+		}
+	}
+	account, err := s.findAccount(req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if self {
+		if match, _ := checkPassword(account.Password, req.CurrentPassword, s.passwordCost); !match {
+			return nil, ErrInvalidCredentials
+		}
+	}
+	if err := validatePassword(req.Password); err != nil {
+		return nil, err
+	}
+
+	if account.Password, err = HashPassword(req.Password, s.passwordCost); err != nil {
+		return nil, fmt.Errorf("error hashing password: %w", err)
+	}
+	if err := data.UpdateAccount(s.db, account); err != nil {
+		return nil, fmt.Errorf("error updating password of account %s: %w", account.Username, err)
+	}
+	// Anyone who was using the old password shouldn't stay logged in.
+	if err := s.revokeSessions(account.ID); err != nil {
+		return nil, fmt.Errorf("error revoking sessions of account %s: %w", account.Username, err)
+	}
+
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "set_password",
+		Target:         account.Username,
+		Source:         "shipgate",
+	})
+}
+
+func (s *service) BanAccount(ctx context.Context, req *BanAccountRequest) (*Ban, error) {
+	s.logger.Debug("BanAccount")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionBan); err != nil {
+		return nil, err
+	}
+	ban := &data.Ban{
+		Type:      data.BanTypeAccount,
+		AccountID: req.AccountId,
+		Reason:    req.Reason,
+		IssuedBy:  req.ActorAccountId,
+	}
+	if req.ExpiresAt > 0 {
+		expiresAt := time.Unix(req.ExpiresAt, 0)
+		ban.ExpiresAt = &expiresAt
+	}
+	return s.issueBan(ctx, ban)
+}
+
+func (s *service) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	s.logger.Debug("ListAccounts")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxListedAccounts {
+		limit = maxListedAccounts
+	}
+	accounts, err := data.FindAccounts(s.db, strings.ToLower(req.UsernamePrefix), limit, int(req.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving accounts: %w", err)
+	}
+
+	resp := &ListAccountsResponse{}
+	for i := range accounts {
+		resp.Accounts = append(resp.Accounts, accountToProto(&accounts[i]))
+	}
+	return resp, nil
+}
+
+func (s *service) GetAccount(ctx context.Context, req *GetAccountRequest) (*proto.Account, error) {
+	s.logger.Debug("GetAccount")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+		return nil, err
+	}
+	account, err := s.findAccount(req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	return accountToProto(account), nil
+}
+
+func (s *service) DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("DeleteAccount")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+		return nil, err
+	}
+	account, err := s.findAccount(req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.revokeSessions(account.ID); err != nil {
+		return nil, fmt.Errorf("error revoking sessions of account %s: %w", account.Username, err)
+	}
+	details := ""
+	if req.Permanent {
+		err = data.PermanentlyDeleteAccount(s.db, account)
+		details = "permanent"
+	} else {
+		err = data.DeleteAccount(s.db, account)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error deleting account %s: %w", account.Username, err)
+	}
+
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "delete_account",
+		Target:         account.Username,
+		Details:        details,
+		Source:         "shipgate",
+	})
+}
+
+// checkUsername returns an error if username is invalid or taken, including by a
+// deleted account.
+func (s *service) checkUsername(username string) error {
+	if err := validateUsername(username); err != nil {
+		return err
+	}
+	if existing, err := data.FindUnscopedAccount(s.db, username); err != nil {
+		return ErrUnknown
+	} else if existing != nil {
+		return invalidArgument(ErrUsernameTaken)
+	}
+	return nil
+}
+
+// checkEmail returns an error if email is invalid or in use by another account.
+func (s *service) checkEmail(email string) error {
+	if err := validateEmail(email); err != nil {
+		return err
+	}
+	if existing, err := data.FindAccountByEmail(s.db, email); err != nil {
+		return ErrUnknown
+	} else if existing != nil {
+		return invalidArgument(ErrEmailTaken)
+	}
+	return nil
+}
+
+func (s *service) RecordAuditEntry(ctx context.Context, req *AuditEntry) (*emptypb.Empty, error) {
+	s.logger.Debug("RecordAuditEntry")
+
+	// Ships can only record the commands run by the players they're hosting, and
+	// only those that the player's role allows.
+	if callerFromContext(ctx).isShip() {
+		permission, ok := ShipAuditPermissions[req.Action]
+		if !ok || req.ActorAccountId == 0 {
+			return nil, ErrPermissionDenied
+		}
+		if _, err := s.authorize(ctx, req.ActorAccountId, permission); err != nil {
+			return nil, err
+		}
+	}
+	return s.recordAuditEntry(req)
+}
+
+// recordAuditEntry saves a record of an action taken through the shipgate.
+func (s *service) recordAuditEntry(req *AuditEntry) (*emptypb.Empty, error) {
+	if err := data.CreateAuditEntry(s.db, &data.AuditEntry{
+		ActorAccountID: req.ActorAccountId,
+		Action:         req.Action,
+		Target:         req.Target,
+		Details:        req.Details,
+		Source:         req.Source,
+	}); err != nil {
+		return nil, fmt.Errorf("error recording audit entry: %w", err)
+	}
+	s.logger.Infof("[SHIPGATE] audit: account %d performed %s on %s (%s) via %s",
+		req.ActorAccountId, req.Action, req.Target, req.Details, req.Source)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) ReportViolation(ctx context.Context, req *Violation) (*emptypb.Empty, error) {
+	s.logger.Debug("ReportViolation")
+	if err := s.checkHosting(ctx, req.AccountId); err != nil {
+		return nil, err
+	}
+
+	if err := data.CreateViolation(s.db, &data.Violation{
+		AccountID:     req.AccountId,
+		CharacterName: req.CharacterName,
+		Rule:          req.Rule,
+		Details:       req.Details,
+		Action:        req.Action,
+		Source:        req.Source,
+	}); err != nil {
+		return nil, fmt.Errorf("error recording violation: %w", err)
+	}
+	s.logger.Infof("[SHIPGATE] violation: account %d (%s) broke rule %s on %s: %s (action: %s)",
+		req.AccountId, req.CharacterName, req.Rule, req.Source, req.Details, req.Action)
+
+	if req.Action != "ban" {
+		return &emptypb.Empty{}, nil
+	}
+	ban := &data.Ban{
+		Type:      data.BanTypeAccount,
+		AccountID: req.AccountId,
+		Reason:    fmt.Sprintf("automatic ban for breaking anti-cheat rule %s", req.Rule),
+	}
+	target, err := s.createBan(ban)
+	if err != nil {
+		return nil, fmt.Errorf("error banning account %d: %w", req.AccountId, err)
+	}
+	return s.recordAuditEntry(&AuditEntry{
+		Action:  "ban",
+		Target:  target,
+		Details: ban.Reason,
+		Source:  req.Source,
+	})
+}
+
+func (s *service) ListViolations(ctx context.Context, req *ListViolationsRequest) (*ListViolationsResponse, error) {
+	s.logger.Debug("ListViolations")
+
+	if _, err := s.authorize(ctx, req.ActorAccountId, auth.PermissionReviewViolations); err != nil {
+		return nil, err
+	}
+	violations, err := data.FindViolations(s.db, req.AccountId, int(req.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving violations: %w", err)
+	}
+
+	resp := &ListViolationsResponse{}
+	for i := range violations {
+		resp.Violations = append(resp.Violations, violationToProto(&violations[i]))
+	}
+	return resp, nil
+}
+
+func (s *service) SetPlayerLocation(ctx context.Context, req *PlayerLocation) (*emptypb.Empty, error) {
+	s.logger.Debug("SetPlayerLocation")
+	if err := checkShip(ctx, req.Ship); err != nil {
+		return nil, err
+	}
+	// Ships can only place players who logged in to them, which is what allows
+	// them to act on those players' behalf.
+	if c := callerFromContext(ctx); c.isShip() && !s.verified.verified(c.name, req.AccountId, req.Guildcard) {
+		return nil, ErrPermissionDenied
+	}
+	s.locations.set(req)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) ClearPlayerLocation(ctx context.Context, req *ClearPlayerLocationRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("ClearPlayerLocation")
+	if err := s.checkHosting(ctx, req.AccountId); err != nil {
+		return nil, err
+	}
+	s.locations.clear(req.AccountId, req.BlockAddress)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) SetPlayerPresence(ctx context.Context, req *PlayerPresence) (*emptypb.Empty, error) {
+	s.logger.Debug("SetPlayerPresence")
+	if err := checkShip(ctx, req.Ship); err != nil {
+		return nil, err
+	}
+	if c := callerFromContext(ctx); c.isShip() && s.verified.find(c.name, req.AccountId) == nil {
+		return nil, ErrPermissionDenied
+	}
+	s.presences.set(req)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) ClearPlayerPresence(ctx context.Context, req *PlayerPresence) (*emptypb.Empty, error) {
+	s.logger.Debug("ClearPlayerPresence")
+	if err := checkShip(ctx, req.Ship); err != nil {
+		return nil, err
+	}
+	s.presences.clear(req)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) FindPlayer(ctx context.Context, req *FindPlayerRequest) (*FindPlayerResponse, error) {
+	s.logger.Debug("FindPlayer")
+	if location := s.locations.find(req.Guildcard); location != nil {
+		return &FindPlayerResponse{Found: true, Location: location}, nil
+	}
+	return &FindPlayerResponse{}, nil
+}
+
+func (s *service) ListPlayers(ctx context.Context, req *ListPlayersRequest) (*ListPlayersResponse, error) {
+	s.logger.Debug("ListPlayers")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionKick); err != nil {
+		return nil, err
+	}
+	return &ListPlayersResponse{Players: s.locations.list()}, nil
 }
 
-// HashPassword returns a version of password with Archon's chosen hashing strategy.
-func HashPassword(password string) string {
-	hash := sha256.New()
-	if _, err := hash.Write(stripPadding([]byte(password))); err != nil {
-		panic(fmt.Errorf("error generating password hash: %v", err))
+func (s *service) MessagePlayer(ctx context.Context, req *MessagePlayerRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("MessagePlayer")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionAnnounce); err != nil {
+		return nil, err
+	}
+	if req.Message == "" {
+		return nil, invalidArgument(errors.New("message cannot be empty"))
 	}
-	return hex.EncodeToString(hash.Sum(nil)[:])
+	location := s.locations.findAccount(req.AccountId)
+	if location == nil {
+		return nil, invalidArgument(ErrPlayerNotOnline)
+	}
+
+	s.events.publish(&Event{Payload: &Event_PlayerMessage{PlayerMessage: &PlayerMessage{
+		AccountId: req.AccountId,
+		Message:   req.Message,
+	}}})
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "message",
+		Target:         location.CharacterName,
+		Details:        req.Message,
+		Source:         "shipgate",
+	})
 }
 
-func stripPadding(b []byte) []byte {
-	for i := len(b) - 1; i >= 0; i-- {
-		if b[i] != 0 {
-			return b[:i+1]
+// DefaultKickMessage is shown to players who are kicked without a reason.
+const DefaultKickMessage = "You have been disconnected by a GM."
+
+func (s *service) KickPlayer(ctx context.Context, req *KickPlayerRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("KickPlayer")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionKick); err != nil {
+		return nil, err
+	}
+	message := req.Message
+	if message == "" {
+		message = DefaultKickMessage
+	}
+
+	// Players that haven't made it to a block yet (e.g. they're selecting a character
+	// or ship) don't have a location, but are still kicked by whichever server they're on.
+	var target string
+	if location := s.locations.findAccount(req.AccountId); location != nil {
+		s.logger.Infof("[SHIPGATE] kicking %s from %s", location.CharacterName, location.Ship)
+		target = location.CharacterName
+	} else {
+		account, err := s.findAccount(req.AccountId)
+		if err != nil {
+			return nil, err
 		}
+		s.logger.Infof("[SHIPGATE] kicking account %s", account.Username)
+		target = account.Username
+	}
+
+	s.events.publish(&Event{Payload: &Event_Kick{Kick: &Kick{
+		AccountId: req.AccountId,
+		Message:   message,
+	}}})
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "kick",
+		Target:         target,
+		Details:        req.Message,
+		Source:         "shipgate",
+	})
+}
+
+func (s *service) ScheduleWarp(ctx context.Context, req *WarpRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("ScheduleWarp")
+
+	sessionID, err := s.sessions.verify(req.SessionToken)
+	if err != nil {
+		return nil, err
 	}
-	return b
+	s.warps.schedule(sessionID, &warp{
+		slot:         req.Slot,
+		blockAddress: req.BlockAddress,
+		lobbyID:      req.LobbyId,
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) PollEvents(ctx context.Context, req *PollEventsRequest) (*PollEventsResponse, error) {
+	events, lastID := s.events.wait(ctx, req.AfterId, pollTimeout)
+	return &PollEventsResponse{Events: events, LastId: lastID}, nil
+}
+
+func (s *service) Broadcast(ctx context.Context, req *BroadcastRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("Broadcast")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionAnnounce); err != nil {
+		return nil, err
+	}
+	if req.Message == "" {
+		return nil, fmt.Errorf("broadcast message cannot be empty")
+	}
+
+	if req.DeliverAt > 0 {
+		// Scheduled broadcasts are saved so that a restart doesn't drop them.
+		broadcast := &data.ScheduledBroadcast{Message: req.Message, DeliverAt: time.Unix(req.DeliverAt, 0)}
+		if err := data.CreateScheduledBroadcast(s.db, broadcast); err != nil {
+			return nil, fmt.Errorf("error saving scheduled broadcast: %w", err)
+		}
+		s.logger.Infof("[SHIPGATE] scheduled broadcast for %s: %s", broadcast.DeliverAt.Format(time.RFC3339), req.Message)
+		s.scheduleBroadcast(broadcast)
+	} else {
+		s.logger.Infof("[SHIPGATE] broadcast: %s", req.Message)
+		s.events.publish(announcementEvent(req.Message))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func announcementEvent(message string) *Event {
+	return &Event{Payload: &Event_Announcement{Announcement: &Announcement{Message: message}}}
+}
+
+// scheduleBroadcast publishes a saved broadcast when it's due and removes it
+// from the database once it's gone out.
+func (s *service) scheduleBroadcast(broadcast *data.ScheduledBroadcast) {
+	s.events.publishAt(announcementEvent(broadcast.Message), broadcast.DeliverAt, func() {
+		if err := data.DeleteScheduledBroadcast(s.db, broadcast.ID); err != nil {
+			s.logger.Warnf("[SHIPGATE] error removing sent broadcast %d: %v", broadcast.ID, err)
+		}
+	})
+}
+
+// resumeBroadcasts reschedules any broadcasts that were still pending when the
+// shipgate last stopped. Ones that came due while it was down go out right away.
+func (s *service) resumeBroadcasts() error {
+	broadcasts, err := data.FindScheduledBroadcasts(s.db)
+	if err != nil {
+		return fmt.Errorf("error loading scheduled broadcasts: %w", err)
+	}
+	for i := range broadcasts {
+		s.logger.Infof("[SHIPGATE] resuming scheduled broadcast for %s: %s",
+			broadcasts[i].DeliverAt.Format(time.RFC3339), broadcasts[i].Message)
+		s.scheduleBroadcast(&broadcasts[i])
+	}
+	return nil
 }
 
 func (s *service) FindCharacter(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
@@ -147,12 +1270,77 @@ func (s *service) FindCharacter(ctx context.Context, req *CharacterRequest) (*Fi
 	if character != nil {
 		resp.Exists = true
 		resp.Character = characterToProto(character)
+
+		records, err := data.FindChallengeRecords(s.db, character.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving challenge records for account %d slot %d: %w", req.AccountId, req.Slot, err)
+		}
+		for i := range records {
+			resp.Character.ChallengeRecords = append(resp.Character.ChallengeRecords, challengeRecordToProto(&records[i]))
+		}
+	}
+	return resp, nil
+}
+
+func (s *service) FindCharacterProfiles(ctx context.Context, req *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+	s.logger.Debug("FindCharacterProfiles")
+
+	characters, err := data.FindCharactersByName(s.db, req.Name, maxCharacterProfiles)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving characters named %s: %w", req.Name, err)
+	}
+	resp := &FindCharacterProfilesResponse{}
+	for i := range characters {
+		resp.Profiles = append(resp.Profiles, characterProfileToProto(&characters[i]))
 	}
 	return resp, nil
 }
 
+func (s *service) EditCharacter(ctx context.Context, req *EditCharacterRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("EditCharacter")
+
+	if _, err := s.authorizeOperator(ctx, req.ActorAccountId, auth.PermissionManageAccounts); err != nil {
+		return nil, err
+	}
+	if req.Level >= maxCharacterLevel {
+		return nil, invalidArgument(fmt.Errorf("level must be at most %d", maxCharacterLevel))
+	}
+	// The servers the player is connected to would overwrite the changes the next
+	// time they save the character.
+	if s.locations.findAccount(req.AccountId) != nil || s.presences.online(req.AccountId) {
+		return nil, invalidArgument(ErrPlayerOnline)
+	}
+	character, err := data.FindCharacter(s.db, uint(req.AccountId), req.Slot)
+	if err != nil {
+		return nil, ErrUnknown
+	} else if character == nil {
+		return nil, invalidArgument(fmt.Errorf("account %d has no character in slot %d", req.AccountId, req.Slot))
+	}
+
+	// Stats follow from the level, so they're recomputed along with it.
+	edited := characterToProto(character)
+	s.levels.SetLevel(edited, int(req.Level))
+	edited.Experience = req.Experience
+	edited.Meseta = req.Meseta
+	if err := data.UpdateCharacterStats(s.db, uint(req.AccountId), req.Slot, characterFromProto(edited)); err != nil {
+		return nil, fmt.Errorf("error updating character %s: %w", character.ReadableName, err)
+	}
+
+	return s.recordAuditEntry(&AuditEntry{
+		ActorAccountId: req.ActorAccountId,
+		Action:         "edit_character",
+		Target:         character.ReadableName,
+		Details: fmt.Sprintf("level %d -> %d, experience %d -> %d, meseta %d -> %d",
+			character.Level+1, req.Level+1, character.Experience, req.Experience, character.Meseta, req.Meseta),
+		Source: "shipgate",
+	})
+}
+
 func (s *service) UpsertCharacter(ctx context.Context, req *UpsertCharacterRequest) (*emptypb.Empty, error) {
 	s.logger.Debug("UpsertCharacter")
+	if err := s.checkHosting(ctx, req.AccountId); err != nil {
+		return nil, err
+	}
 
 	character := characterFromProto(req.Character)
 	character.AccountID = req.AccountId
@@ -171,6 +1359,53 @@ func (s *service) DeleteCharacter(ctx context.Context, req *CharacterRequest) (*
 	return &emptypb.Empty{}, nil
 }
 
+func (s *service) UpdateCharacterStats(ctx context.Context, req *UpdateCharacterStatsRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("UpdateCharacterStats")
+	if err := s.checkHosting(ctx, req.AccountId); err != nil {
+		return nil, err
+	}
+
+	if err := data.UpdateCharacterStats(s.db, uint(req.AccountId), req.Slot, characterFromProto(req.Character)); err != nil {
+		return nil, fmt.Errorf("error updating stats for account %d slot %d: %w", req.AccountId, req.Slot, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) UpdateInfoBoard(ctx context.Context, req *UpdateInfoBoardRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("UpdateInfoBoard")
+	if err := s.checkHosting(ctx, req.AccountId); err != nil {
+		return nil, err
+	}
+
+	if err := data.UpdateInfoBoard(s.db, uint(req.AccountId), req.Slot, req.InfoBoard); err != nil {
+		return nil, fmt.Errorf("error updating info board for account %d slot %d: %w", req.AccountId, req.Slot, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) UpdateChallengeRecords(ctx context.Context, req *UpdateChallengeRecordsRequest) (*emptypb.Empty, error) {
+	s.logger.Debug("UpdateChallengeRecords")
+	if err := s.checkHosting(ctx, req.AccountId); err != nil {
+		return nil, err
+	}
+
+	character, err := data.FindCharacter(s.db, uint(req.AccountId), req.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving character for account %d slot %d: %w", req.AccountId, req.Slot, err)
+	} else if character == nil {
+		return nil, fmt.Errorf("no character found for account %d slot %d", req.AccountId, req.Slot)
+	}
+
+	var records []data.ChallengeRecord
+	for _, record := range req.Records {
+		records = append(records, challengeRecordFromProto(record))
+	}
+	if err := data.UpdateChallengeRecords(s.db, character.ID, records, req.Title); err != nil {
+		return nil, fmt.Errorf("error updating challenge records for account %d slot %d: %w", req.AccountId, req.Slot, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *service) GetGuildcardEntries(ctx context.Context, req *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
 	s.logger.Debug("GetGuildcardEntries")
 
@@ -0,0 +1,80 @@
+package shipgate
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// Session tokens have to fit in the unused portion of the client config, so
+	// they're made up of the session ID, its expiry, and a truncated signature.
+	sessionTokenSize     = 24
+	sessionSignatureSize = sessionTokenSize - 8
+	// Length of the randomly generated secret used if none is configured.
+	sessionSecretSize = 32
+	// Used if the config doesn't specify how long sessions last.
+	defaultSessionTTL = 24 * time.Hour
+)
+
+var ErrInvalidSession = errors.New("your session has expired, please log in again")
+
+// sessionSigner creates and checks the signed tokens identifying sessions.
+type sessionSigner struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// newSessionSigner returns a signer using secret, or a random secret if it's empty.
+func newSessionSigner(secret string, ttl time.Duration) (*sessionSigner, error) {
+	signer := &sessionSigner{secret: []byte(secret), ttl: ttl, now: time.Now}
+	if len(signer.secret) == 0 {
+		signer.secret = make([]byte, sessionSecretSize)
+		if _, err := rand.Read(signer.secret); err != nil {
+			return nil, fmt.Errorf("error generating session secret: %w", err)
+		}
+	}
+	if signer.ttl <= 0 {
+		signer.ttl = defaultSessionTTL
+	}
+	return signer, nil
+}
+
+// expiry returns when a session started now expires. Token expiries are stored
+// in seconds, so the time is truncated to match.
+func (s *sessionSigner) expiry() time.Time {
+	return s.now().Add(s.ttl).Truncate(time.Second)
+}
+
+// sign returns the token for the session with id that expires at expires.
+func (s *sessionSigner) sign(id uint64, expires time.Time) []byte {
+	token := make([]byte, sessionTokenSize)
+	binary.LittleEndian.PutUint32(token[0:], uint32(id))
+	binary.LittleEndian.PutUint32(token[4:], uint32(expires.Unix()))
+	copy(token[8:], s.signature(token[:8]))
+	return token
+}
+
+// verify checks the token's signature and expiry and returns its session ID.
+// Whether the session has been revoked is up to the caller to check.
+func (s *sessionSigner) verify(token []byte) (uint64, error) {
+	if len(token) != sessionTokenSize || !hmac.Equal(token[8:], s.signature(token[:8])) {
+		return 0, ErrInvalidSession
+	}
+	expires := time.Unix(int64(binary.LittleEndian.Uint32(token[4:])), 0)
+	if !s.now().Before(expires) {
+		return 0, ErrInvalidSession
+	}
+	return uint64(binary.LittleEndian.Uint32(token[0:])), nil
+}
+
+func (s *sessionSigner) signature(data []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(data)
+	return mac.Sum(nil)[:sessionSignatureSize]
+}
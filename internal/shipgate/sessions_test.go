@@ -0,0 +1,39 @@
+package shipgate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessionSigner(t *testing.T) {
+	signer, err := newSessionSigner("secret", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error creating signer: %v", err)
+	}
+	now := time.Now()
+	signer.now = func() time.Time { return now }
+
+	token := signer.sign(42, signer.expiry())
+	if len(token) != sessionTokenSize {
+		t.Fatalf("got token of %d bytes, want %d", len(token), sessionTokenSize)
+	}
+	if id, err := signer.verify(token); err != nil || id != 42 {
+		t.Errorf("verify() = %d, %v; want 42, nil", id, err)
+	}
+
+	tampered := append([]byte{}, token...)
+	tampered[0] = 43
+	if _, err := signer.verify(tampered); err != ErrInvalidSession {
+		t.Errorf("expected ErrInvalidSession for a tampered token, got %v", err)
+	}
+
+	other, _ := newSessionSigner("other secret", time.Hour)
+	if _, err := other.verify(token); err != ErrInvalidSession {
+		t.Errorf("expected ErrInvalidSession for a token signed with another secret, got %v", err)
+	}
+
+	now = now.Add(time.Hour + time.Second)
+	if _, err := signer.verify(token); err != ErrInvalidSession {
+		t.Errorf("expected ErrInvalidSession for an expired token, got %v", err)
+	}
+}
@@ -8,22 +8,37 @@ import (
 	"time"
 
 	"github.com/glebarez/sqlite"
+	"github.com/twitchtv/twirp"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
 	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/auth"
 	"github.com/dcrodman/archon/internal/core/data"
+	"github.com/dcrodman/archon/internal/core/proto"
 )
 
-func NewRPCClient(cfg *core.Config) Shipgate {
-	return NewShipgateProtobufClient(cfg.ShipgateAddress(), http.DefaultClient)
+func NewRPCClient(cfg *core.Config) (Shipgate, error) {
+	client, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewShipgateProtobufClient(cfg.ShipgateAddress(), client), nil
+}
+
+// LevelTable changes the level of characters along with the stats that come with
+// it. It's implemented by character.LevelTable.
+type LevelTable interface {
+	SetLevel(c *proto.Character, level int)
 }
 
 type Server struct {
 	Config *core.Config
 	Logger *zap.SugaredLogger
+	// Used to recompute the stats of characters edited by staff.
+	LevelTable LevelTable
 
 	db         *gorm.DB
 	httpServer http.Server
@@ -38,17 +53,66 @@ func (s *Server) Start(ctx context.Context) {
 		}
 		s.Logger.Infof("[SHIPGATE] connected to database %s", s.db.Name())
 
+		roles, err := auth.NewRoles(s.Config)
+		if err != nil {
+			s.Logger.Errorf("error loading roles: %v", err)
+			return
+		}
+		sessions, err := newSessionSigner(s.Config.ShipgateServer.SessionSecret, s.Config.ShipgateServer.SessionTTL)
+		if err != nil {
+			s.Logger.Errorf("error initializing sessions: %v", err)
+			return
+		}
+
+		authenticator, err := newAuthenticator(s.Config)
+		if err != nil {
+			s.Logger.Errorf("error loading shipgate callers: %v", err)
+			return
+		}
+		if authenticator.allowLocal {
+			s.Logger.Infof("[SHIPGATE] no callers configured; only accepting requests from this machine")
+		}
+
+		lockout := s.Config.ShipgateServer.LoginLockout
+
+		svc := &service{
+			logger:       s.Logger,
+			db:           s.db,
+			roles:        roles,
+			ships:        newShipRegistry(s.Config.ShipgateServer.ShipTimeout),
+			events:       newEventQueue(),
+			sessions:     sessions,
+			warps:        newPendingWarps(),
+			locations:    newPlayerLocations(),
+			presences:    newPlayerPresences(),
+			verified:     newVerifiedAccounts(),
+			levels:       s.LevelTable,
+			lockouts:     newLoginLockouts(lockout.Threshold, lockout.IPThreshold, lockout.Duration, lockout.MaxDuration),
+			passwordCost: s.Config.ShipgateServer.PasswordCost,
+			startedAt:    time.Now(),
+		}
+		go svc.watchShips(ctx)
+		if err := svc.resumeBroadcasts(); err != nil {
+			s.Logger.Errorf("error resuming scheduled broadcasts: %v", err)
+			return
+		}
+
 		// Set up and start the HTTP handler for handling the RPC requests.
 		s.httpServer = http.Server{
-			Addr: fmt.Sprintf(":%d", s.Config.ShipgateServer.Port),
-			Handler: NewShipgateServer(&service{
-				logger:         s.Logger,
-				db:             s.db,
-				connectedShips: make(map[string]*ship),
-			}),
+			Addr:    fmt.Sprintf(":%d", s.Config.ShipgateServer.Port),
+			Handler: authenticator.wrap(NewShipgateServer(svc, &twirp.ServerHooks{RequestRouted: authorizeMethod})),
 		}
 
-		if err := s.httpServer.ListenAndServe(); err != nil {
+		tlsConfig := s.Config.ShipgateServer.TLS
+		if tlsConfig.CertFile != "" {
+			err = s.httpServer.ListenAndServeTLS(
+				s.Config.QualifiedPath(tlsConfig.CertFile),
+				s.Config.QualifiedPath(tlsConfig.KeyFile),
+			)
+		} else {
+			err = s.httpServer.ListenAndServe()
+		}
+		if err != nil {
 			s.Logger.Errorf("[SHIPGATE] error: %v", err)
 		}
 		s.Logger.Infof("[SHIPGATE] exited")
@@ -83,6 +147,14 @@ func (s *Server) initDatabase() error {
 		&data.PlayerOptions{},
 		&data.Character{},
 		&data.GuildcardEntry{},
+		&data.AuditEntry{},
+		&data.ChallengeRecord{},
+		&data.Violation{},
+		&data.Session{},
+		&data.Ban{},
+		&data.Ship{},
+		&data.AccountToken{},
+		&data.ScheduledBroadcast{},
 	); err != nil {
 		return fmt.Errorf("error auto migrating db: %s", err)
 	}
@@ -45,19 +45,178 @@ type Shipgate interface {
 	// RegisterShip informs the shipgate that it is able to serve players.
 	RegisterShip(context.Context, *RegisterShipRequest) (*google_protobuf.Empty, error)
 
+	// ShipHeartbeat tells the shipgate that a ship is still running and how many
+	// players are on each of its blocks. Ships that stop sending heartbeats are
+	// removed from the active ships. Fails if the ship needs to register again.
+	ShipHeartbeat(context.Context, *ShipHeartbeatRequest) (*google_protobuf.Empty, error)
+
+	// GetServerStatus returns when the shipgate started and the players on each
+	// block of the active ships.
+	GetServerStatus(context.Context, *google_protobuf.Empty) (*ServerStatus, error)
+
+	// PollEvents waits for events that ships need to act on (such as announcements)
+	// and returns them. The call returns without any events if none are published
+	// within the shipgate's poll timeout, so ships are expected to call it in a loop.
+	PollEvents(context.Context, *PollEventsRequest) (*PollEventsResponse, error)
+
+	// Broadcast sends a message to every player on every ship. The acting account
+	// must be allowed to make announcements.
+	Broadcast(context.Context, *BroadcastRequest) (*google_protobuf.Empty, error)
+
+	// SetPlayerLocation records where a player is connected, replacing any
+	// previous location.
+	SetPlayerLocation(context.Context, *PlayerLocation) (*google_protobuf.Empty, error)
+
+	// ClearPlayerLocation forgets where a player is once they leave a block.
+	ClearPlayerLocation(context.Context, *ClearPlayerLocationRequest) (*google_protobuf.Empty, error)
+
+	// SetPlayerPresence records that a player is connected to a server other than a
+	// block, which keeps their characters from being edited until they disconnect.
+	SetPlayerPresence(context.Context, *PlayerPresence) (*google_protobuf.Empty, error)
+
+	// ClearPlayerPresence forgets a player's presence once they leave the server.
+	ClearPlayerPresence(context.Context, *PlayerPresence) (*google_protobuf.Empty, error)
+
+	// FindPlayer looks up where the player with a guildcard is connected.
+	FindPlayer(context.Context, *FindPlayerRequest) (*FindPlayerResponse, error)
+
+	// ListPlayers returns where every connected player is. The acting account must
+	// be allowed to kick players.
+	ListPlayers(context.Context, *ListPlayersRequest) (*ListPlayersResponse, error)
+
+	// MessagePlayer sends a message to a connected player. The acting account must
+	// be allowed to make announcements.
+	MessagePlayer(context.Context, *MessagePlayerRequest) (*google_protobuf.Empty, error)
+
+	// KickPlayer disconnects a player from whichever server they're connected to,
+	// including the LOGIN and CHARACTER servers. The acting account must be allowed
+	// to kick players.
+	KickPlayer(context.Context, *KickPlayerRequest) (*google_protobuf.Empty, error)
+
+	// ScheduleWarp records that a player is about to be redirected to another
+	// block, which places them in the chosen lobby when it verifies their session
+	// token. Warps expire if the player doesn't arrive shortly after.
+	ScheduleWarp(context.Context, *WarpRequest) (*google_protobuf.Empty, error)
+
 	// AuthenticateAccount verifies an account. A password should be provided
 	// via the rpc call metadata.
 	AuthenticateAccount(context.Context, *AuthenticateAccountRequest) (*archon.Account, error)
 
+	// IssueSessionToken starts a session for an account that has logged in and
+	// returns the signed, expiring token identifying it. The token is handed to
+	// the client so that other servers don't need the player's password.
+	IssueSessionToken(context.Context, *IssueSessionTokenRequest) (*SessionToken, error)
+
+	// VerifySessionToken checks a session token and returns the account it belongs to.
+	VerifySessionToken(context.Context, *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error)
+
+	// RevokeSessions ends every session of an account, forcing the player to log
+	// in again. The acting account must be allowed to ban players.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*google_protobuf.Empty, error)
+
+	// EndSession ends the session identified by a token, e.g. when its owner logs out.
+	EndSession(context.Context, *EndSessionRequest) (*google_protobuf.Empty, error)
+
+	// BanPlayer bans an account, IP address (or CIDR range), or hardware ID. Banned
+	// accounts are logged out. The acting account must be allowed to ban players.
+	BanPlayer(context.Context, *BanRequest) (*Ban, error)
+
+	// ListBans returns the bans currently in effect. The acting account must be
+	// allowed to ban players.
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+
+	// LiftBan ends a ban before it expires. The acting account must be allowed to ban players.
+	LiftBan(context.Context, *LiftBanRequest) (*google_protobuf.Empty, error)
+
+	// CheckBan returns whether any ban applies to an account, IP address, or hardware ID.
+	CheckBan(context.Context, *CheckBanRequest) (*CheckBanResponse, error)
+
+	// SetPrivilegeLevel changes the role of an account. The acting account must be
+	// allowed to manage roles and hold every permission granted by the new role.
+	SetPrivilegeLevel(context.Context, *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error)
+
+	// CreateAccount registers a new account. The acting account must be allowed to
+	// manage accounts.
+	CreateAccount(context.Context, *CreateAccountRequest) (*archon.Account, error)
+
+	// RegisterAccount creates an account for a player signing up themselves. If
+	// email verification is required, the account can't log in until VerifyEmail
+	// is called with the returned token.
+	RegisterAccount(context.Context, *RegisterAccountRequest) (*RegisterAccountResponse, error)
+
+	// VerifyEmail activates the account that a verification token was issued for.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*archon.Account, error)
+
+	// IssuePasswordReset creates a token that lets whoever holds it set a new password
+	// for the account, replacing any issued before. The acting account must be allowed
+	// to manage accounts.
+	IssuePasswordReset(context.Context, *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error)
+
+	// ResetPassword sets the password of the account a reset token was issued for and
+	// logs it out everywhere.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*archon.Account, error)
+
+	// UpdateAccount changes the username or email address of an account. The acting
+	// account must be allowed to manage accounts.
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*archon.Account, error)
+
+	// SetPassword changes the password of an account and logs it out. Players can
+	// change their own password with their current one, otherwise the acting account
+	// must be allowed to manage accounts.
+	SetPassword(context.Context, *SetPasswordRequest) (*google_protobuf.Empty, error)
+
+	// BanAccount bans an account and logs it out. The acting account must be allowed
+	// to ban players.
+	BanAccount(context.Context, *BanAccountRequest) (*Ban, error)
+
+	// ListAccounts returns accounts ordered by username. The acting account must be
+	// allowed to manage accounts.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+
+	// GetAccount returns an account by ID. The acting account must be allowed to
+	// manage accounts.
+	GetAccount(context.Context, *GetAccountRequest) (*archon.Account, error)
+
+	// DeleteAccount deletes an account and logs it out. The acting account must be
+	// allowed to manage accounts.
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*google_protobuf.Empty, error)
+
+	// RecordAuditEntry saves a record of a privileged action taken by an account.
+	RecordAuditEntry(context.Context, *AuditEntry) (*google_protobuf.Empty, error)
+
+	// ReportViolation records an anti-cheat violation for review by GMs. Accounts
+	// are banned if the block server's action for the violation was to ban them.
+	ReportViolation(context.Context, *Violation) (*google_protobuf.Empty, error)
+
+	// ListViolations returns the most recent violations, newest first. The acting
+	// account must be allowed to review violations.
+	ListViolations(context.Context, *ListViolationsRequest) (*ListViolationsResponse, error)
+
 	// FindCharacter looks up character in a slot on an account.
 	FindCharacter(context.Context, *CharacterRequest) (*FindCharacterResponse, error)
 
+	// FindCharacterProfiles returns the public profiles of the characters with a name.
+	FindCharacterProfiles(context.Context, *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error)
+
+	// EditCharacter changes the stats of a character whose player isn't connected.
+	// The acting account must be allowed to manage accounts.
+	EditCharacter(context.Context, *EditCharacterRequest) (*google_protobuf.Empty, error)
+
 	// UpsertCharacter creates a new character in a slot on an account.
 	UpsertCharacter(context.Context, *UpsertCharacterRequest) (*google_protobuf.Empty, error)
 
 	// DeleteCharacter deletes the character data in a slot on an account.
 	DeleteCharacter(context.Context, *CharacterRequest) (*google_protobuf.Empty, error)
 
+	// UpdateCharacterStats saves the level, experience, meseta, and stats of the character in a slot on an account.
+	UpdateCharacterStats(context.Context, *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error)
+
+	// UpdateInfoBoard replaces the info board text of the character in a slot on an account.
+	UpdateInfoBoard(context.Context, *UpdateInfoBoardRequest) (*google_protobuf.Empty, error)
+
+	// UpdateChallengeRecords saves the challenge mode clears and title of the character in a slot on an account.
+	UpdateChallengeRecords(context.Context, *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error)
+
 	// GetGuildcardEntires returns the list of guildcards on an account.
 	GetGuildcardEntries(context.Context, *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error)
 
@@ -74,7 +233,7 @@ type Shipgate interface {
 
 type shipgateProtobufClient struct {
 	client      HTTPClient
-	urls        [9]string
+	urls        [50]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -102,13 +261,54 @@ func NewShipgateProtobufClient(baseURL string, client HTTPClient, opts ...twirp.
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "archon", "Shipgate")
-	urls := [9]string{
+	urls := [50]string{
 		serviceURL + "GetActiveShips",
 		serviceURL + "RegisterShip",
+		serviceURL + "ShipHeartbeat",
+		serviceURL + "GetServerStatus",
+		serviceURL + "PollEvents",
+		serviceURL + "Broadcast",
+		serviceURL + "SetPlayerLocation",
+		serviceURL + "ClearPlayerLocation",
+		serviceURL + "SetPlayerPresence",
+		serviceURL + "ClearPlayerPresence",
+		serviceURL + "FindPlayer",
+		serviceURL + "ListPlayers",
+		serviceURL + "MessagePlayer",
+		serviceURL + "KickPlayer",
+		serviceURL + "ScheduleWarp",
 		serviceURL + "AuthenticateAccount",
+		serviceURL + "IssueSessionToken",
+		serviceURL + "VerifySessionToken",
+		serviceURL + "RevokeSessions",
+		serviceURL + "EndSession",
+		serviceURL + "BanPlayer",
+		serviceURL + "ListBans",
+		serviceURL + "LiftBan",
+		serviceURL + "CheckBan",
+		serviceURL + "SetPrivilegeLevel",
+		serviceURL + "CreateAccount",
+		serviceURL + "RegisterAccount",
+		serviceURL + "VerifyEmail",
+		serviceURL + "IssuePasswordReset",
+		serviceURL + "ResetPassword",
+		serviceURL + "UpdateAccount",
+		serviceURL + "SetPassword",
+		serviceURL + "BanAccount",
+		serviceURL + "ListAccounts",
+		serviceURL + "GetAccount",
+		serviceURL + "DeleteAccount",
+		serviceURL + "RecordAuditEntry",
+		serviceURL + "ReportViolation",
+		serviceURL + "ListViolations",
 		serviceURL + "FindCharacter",
+		serviceURL + "FindCharacterProfiles",
+		serviceURL + "EditCharacter",
 		serviceURL + "UpsertCharacter",
 		serviceURL + "DeleteCharacter",
+		serviceURL + "UpdateCharacterStats",
+		serviceURL + "UpdateInfoBoard",
+		serviceURL + "UpdateChallengeRecords",
 		serviceURL + "GetGuildcardEntries",
 		serviceURL + "GetPlayerOptions",
 		serviceURL + "UpsertPlayerOptions",
@@ -214,26 +414,26 @@ func (c *shipgateProtobufClient) callRegisterShip(ctx context.Context, in *Regis
 	return out, nil
 }
 
-func (c *shipgateProtobufClient) AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
+func (c *shipgateProtobufClient) ShipHeartbeat(ctx context.Context, in *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
-	caller := c.callAuthenticateAccount
+	ctx = ctxsetters.WithMethodName(ctx, "ShipHeartbeat")
+	caller := c.callShipHeartbeat
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
+		caller = func(ctx context.Context, req *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AuthenticateAccountRequest)
+					typedReq, ok := req.(*ShipHeartbeatRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ShipHeartbeatRequest) when calling interceptor")
 					}
-					return c.callAuthenticateAccount(ctx, typedReq)
+					return c.callShipHeartbeat(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*archon.Account)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -243,8 +443,8 @@ func (c *shipgateProtobufClient) AuthenticateAccount(ctx context.Context, in *Au
 	return caller(ctx, in)
 }
 
-func (c *shipgateProtobufClient) callAuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
-	out := new(archon.Account)
+func (c *shipgateProtobufClient) callShipHeartbeat(ctx context.Context, in *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -260,26 +460,26 @@ func (c *shipgateProtobufClient) callAuthenticateAccount(ctx context.Context, in
 	return out, nil
 }
 
-func (c *shipgateProtobufClient) FindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
+func (c *shipgateProtobufClient) GetServerStatus(ctx context.Context, in *google_protobuf.Empty) (*ServerStatus, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
-	caller := c.callFindCharacter
+	ctx = ctxsetters.WithMethodName(ctx, "GetServerStatus")
+	caller := c.callGetServerStatus
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ServerStatus, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*google_protobuf.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
 					}
-					return c.callFindCharacter(ctx, typedReq)
+					return c.callGetServerStatus(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindCharacterResponse)
+				typedResp, ok := resp.(*ServerStatus)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ServerStatus) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -289,8 +489,8 @@ func (c *shipgateProtobufClient) FindCharacter(ctx context.Context, in *Characte
 	return caller(ctx, in)
 }
 
-func (c *shipgateProtobufClient) callFindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
-	out := new(FindCharacterResponse)
+func (c *shipgateProtobufClient) callGetServerStatus(ctx context.Context, in *google_protobuf.Empty) (*ServerStatus, error) {
+	out := new(ServerStatus)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -306,26 +506,26 @@ func (c *shipgateProtobufClient) callFindCharacter(ctx context.Context, in *Char
 	return out, nil
 }
 
-func (c *shipgateProtobufClient) UpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) PollEvents(ctx context.Context, in *PollEventsRequest) (*PollEventsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
-	caller := c.callUpsertCharacter
+	ctx = ctxsetters.WithMethodName(ctx, "PollEvents")
+	caller := c.callPollEvents
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *PollEventsRequest) (*PollEventsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpsertCharacterRequest)
+					typedReq, ok := req.(*PollEventsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PollEventsRequest) when calling interceptor")
 					}
-					return c.callUpsertCharacter(ctx, typedReq)
+					return c.callPollEvents(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*PollEventsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PollEventsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -335,8 +535,8 @@ func (c *shipgateProtobufClient) UpsertCharacter(ctx context.Context, in *Upsert
 	return caller(ctx, in)
 }
 
-func (c *shipgateProtobufClient) callUpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *shipgateProtobufClient) callPollEvents(ctx context.Context, in *PollEventsRequest) (*PollEventsResponse, error) {
+	out := new(PollEventsResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -352,20 +552,20 @@ func (c *shipgateProtobufClient) callUpsertCharacter(ctx context.Context, in *Up
 	return out, nil
 }
 
-func (c *shipgateProtobufClient) DeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) Broadcast(ctx context.Context, in *BroadcastRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
-	caller := c.callDeleteCharacter
+	ctx = ctxsetters.WithMethodName(ctx, "Broadcast")
+	caller := c.callBroadcast
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *BroadcastRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*BroadcastRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BroadcastRequest) when calling interceptor")
 					}
-					return c.callDeleteCharacter(ctx, typedReq)
+					return c.callBroadcast(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -381,7 +581,7 @@ func (c *shipgateProtobufClient) DeleteCharacter(ctx context.Context, in *Charac
 	return caller(ctx, in)
 }
 
-func (c *shipgateProtobufClient) callDeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) callBroadcast(ctx context.Context, in *BroadcastRequest) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
@@ -398,26 +598,26 @@ func (c *shipgateProtobufClient) callDeleteCharacter(ctx context.Context, in *Ch
 	return out, nil
 }
 
-func (c *shipgateProtobufClient) GetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+func (c *shipgateProtobufClient) SetPlayerLocation(ctx context.Context, in *PlayerLocation) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "GetGuildcardEntries")
-	caller := c.callGetGuildcardEntries
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerLocation")
+	caller := c.callSetPlayerLocation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+		caller = func(ctx context.Context, req *PlayerLocation) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetGuildcardEntriesRequest)
+					typedReq, ok := req.(*PlayerLocation)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetGuildcardEntriesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerLocation) when calling interceptor")
 					}
-					return c.callGetGuildcardEntries(ctx, typedReq)
+					return c.callSetPlayerLocation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetGuildcardEntriesResponse)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetGuildcardEntriesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -427,8 +627,8 @@ func (c *shipgateProtobufClient) GetGuildcardEntries(ctx context.Context, in *Ge
 	return caller(ctx, in)
 }
 
-func (c *shipgateProtobufClient) callGetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
-	out := new(GetGuildcardEntriesResponse)
+func (c *shipgateProtobufClient) callSetPlayerLocation(ctx context.Context, in *PlayerLocation) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -444,26 +644,26 @@ func (c *shipgateProtobufClient) callGetGuildcardEntries(ctx context.Context, in
 	return out, nil
 }
 
-func (c *shipgateProtobufClient) GetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+func (c *shipgateProtobufClient) ClearPlayerLocation(ctx context.Context, in *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "GetPlayerOptions")
-	caller := c.callGetPlayerOptions
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerLocation")
+	caller := c.callClearPlayerLocation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+		caller = func(ctx context.Context, req *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPlayerOptionsRequest)
+					typedReq, ok := req.(*ClearPlayerLocationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPlayerOptionsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClearPlayerLocationRequest) when calling interceptor")
 					}
-					return c.callGetPlayerOptions(ctx, typedReq)
+					return c.callClearPlayerLocation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPlayerOptionsResponse)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPlayerOptionsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -473,8 +673,8 @@ func (c *shipgateProtobufClient) GetPlayerOptions(ctx context.Context, in *GetPl
 	return caller(ctx, in)
 }
 
-func (c *shipgateProtobufClient) callGetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
-	out := new(GetPlayerOptionsResponse)
+func (c *shipgateProtobufClient) callClearPlayerLocation(ctx context.Context, in *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -490,20 +690,20 @@ func (c *shipgateProtobufClient) callGetPlayerOptions(ctx context.Context, in *G
 	return out, nil
 }
 
-func (c *shipgateProtobufClient) UpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) SetPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertPlayerOptions")
-	caller := c.callUpsertPlayerOptions
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerPresence")
+	caller := c.callSetPlayerPresence
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpsertPlayerOptionsRequest)
+					typedReq, ok := req.(*PlayerPresence)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpsertPlayerOptionsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
 					}
-					return c.callUpsertPlayerOptions(ctx, typedReq)
+					return c.callSetPlayerPresence(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -519,7 +719,7 @@ func (c *shipgateProtobufClient) UpsertPlayerOptions(ctx context.Context, in *Up
 	return caller(ctx, in)
 }
 
-func (c *shipgateProtobufClient) callUpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) callSetPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
@@ -536,80 +736,26 @@ func (c *shipgateProtobufClient) callUpsertPlayerOptions(ctx context.Context, in
 	return out, nil
 }
 
-// ====================
-// Shipgate JSON Client
-// ====================
-
-type shipgateJSONClient struct {
-	client      HTTPClient
-	urls        [9]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewShipgateJSONClient creates a JSON client that implements the Shipgate interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewShipgateJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Shipgate {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
-
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "archon", "Shipgate")
-	urls := [9]string{
-		serviceURL + "GetActiveShips",
-		serviceURL + "RegisterShip",
-		serviceURL + "AuthenticateAccount",
-		serviceURL + "FindCharacter",
-		serviceURL + "UpsertCharacter",
-		serviceURL + "DeleteCharacter",
-		serviceURL + "GetGuildcardEntries",
-		serviceURL + "GetPlayerOptions",
-		serviceURL + "UpsertPlayerOptions",
-	}
-
-	return &shipgateJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
-	}
-}
-
-func (c *shipgateJSONClient) GetActiveShips(ctx context.Context, in *google_protobuf.Empty) (*ShipList, error) {
+func (c *shipgateProtobufClient) ClearPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "GetActiveShips")
-	caller := c.callGetActiveShips
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerPresence")
+	caller := c.callClearPlayerPresence
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ShipList, error) {
+		caller = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*PlayerPresence)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
 					}
-					return c.callGetActiveShips(ctx, typedReq)
+					return c.callClearPlayerPresence(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ShipList)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ShipList) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -619,9 +765,9 @@ func (c *shipgateJSONClient) GetActiveShips(ctx context.Context, in *google_prot
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callGetActiveShips(ctx context.Context, in *google_protobuf.Empty) (*ShipList, error) {
-	out := new(ShipList)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *shipgateProtobufClient) callClearPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -636,26 +782,26 @@ func (c *shipgateJSONClient) callGetActiveShips(ctx context.Context, in *google_
 	return out, nil
 }
 
-func (c *shipgateJSONClient) RegisterShip(ctx context.Context, in *RegisterShipRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) FindPlayer(ctx context.Context, in *FindPlayerRequest) (*FindPlayerResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "RegisterShip")
-	caller := c.callRegisterShip
+	ctx = ctxsetters.WithMethodName(ctx, "FindPlayer")
+	caller := c.callFindPlayer
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *RegisterShipRequest) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *FindPlayerRequest) (*FindPlayerResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RegisterShipRequest)
+					typedReq, ok := req.(*FindPlayerRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RegisterShipRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*FindPlayerRequest) when calling interceptor")
 					}
-					return c.callRegisterShip(ctx, typedReq)
+					return c.callFindPlayer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf.Empty)
+				typedResp, ok := resp.(*FindPlayerResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*FindPlayerResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -665,9 +811,9 @@ func (c *shipgateJSONClient) RegisterShip(ctx context.Context, in *RegisterShipR
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callRegisterShip(ctx context.Context, in *RegisterShipRequest) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *shipgateProtobufClient) callFindPlayer(ctx context.Context, in *FindPlayerRequest) (*FindPlayerResponse, error) {
+	out := new(FindPlayerResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -682,26 +828,26 @@ func (c *shipgateJSONClient) callRegisterShip(ctx context.Context, in *RegisterS
 	return out, nil
 }
 
-func (c *shipgateJSONClient) AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
+func (c *shipgateProtobufClient) ListPlayers(ctx context.Context, in *ListPlayersRequest) (*ListPlayersResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
-	caller := c.callAuthenticateAccount
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlayers")
+	caller := c.callListPlayers
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
+		caller = func(ctx context.Context, req *ListPlayersRequest) (*ListPlayersResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AuthenticateAccountRequest)
+					typedReq, ok := req.(*ListPlayersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListPlayersRequest) when calling interceptor")
 					}
-					return c.callAuthenticateAccount(ctx, typedReq)
+					return c.callListPlayers(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*archon.Account)
+				typedResp, ok := resp.(*ListPlayersResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPlayersResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -711,9 +857,9 @@ func (c *shipgateJSONClient) AuthenticateAccount(ctx context.Context, in *Authen
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callAuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
-	out := new(archon.Account)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+func (c *shipgateProtobufClient) callListPlayers(ctx context.Context, in *ListPlayersRequest) (*ListPlayersResponse, error) {
+	out := new(ListPlayersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -728,26 +874,26 @@ func (c *shipgateJSONClient) callAuthenticateAccount(ctx context.Context, in *Au
 	return out, nil
 }
 
-func (c *shipgateJSONClient) FindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
+func (c *shipgateProtobufClient) MessagePlayer(ctx context.Context, in *MessagePlayerRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
-	caller := c.callFindCharacter
+	ctx = ctxsetters.WithMethodName(ctx, "MessagePlayer")
+	caller := c.callMessagePlayer
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+		caller = func(ctx context.Context, req *MessagePlayerRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*MessagePlayerRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*MessagePlayerRequest) when calling interceptor")
 					}
-					return c.callFindCharacter(ctx, typedReq)
+					return c.callMessagePlayer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindCharacterResponse)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -757,9 +903,9 @@ func (c *shipgateJSONClient) FindCharacter(ctx context.Context, in *CharacterReq
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callFindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
-	out := new(FindCharacterResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+func (c *shipgateProtobufClient) callMessagePlayer(ctx context.Context, in *MessagePlayerRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -774,20 +920,20 @@ func (c *shipgateJSONClient) callFindCharacter(ctx context.Context, in *Characte
 	return out, nil
 }
 
-func (c *shipgateJSONClient) UpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) KickPlayer(ctx context.Context, in *KickPlayerRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
-	caller := c.callUpsertCharacter
+	ctx = ctxsetters.WithMethodName(ctx, "KickPlayer")
+	caller := c.callKickPlayer
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *KickPlayerRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpsertCharacterRequest)
+					typedReq, ok := req.(*KickPlayerRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*KickPlayerRequest) when calling interceptor")
 					}
-					return c.callUpsertCharacter(ctx, typedReq)
+					return c.callKickPlayer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -803,9 +949,9 @@ func (c *shipgateJSONClient) UpsertCharacter(ctx context.Context, in *UpsertChar
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callUpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) callKickPlayer(ctx context.Context, in *KickPlayerRequest) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -820,20 +966,20 @@ func (c *shipgateJSONClient) callUpsertCharacter(ctx context.Context, in *Upsert
 	return out, nil
 }
 
-func (c *shipgateJSONClient) DeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) ScheduleWarp(ctx context.Context, in *WarpRequest) (*google_protobuf.Empty, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
-	caller := c.callDeleteCharacter
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleWarp")
+	caller := c.callScheduleWarp
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *WarpRequest) (*google_protobuf.Empty, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*WarpRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*WarpRequest) when calling interceptor")
 					}
-					return c.callDeleteCharacter(ctx, typedReq)
+					return c.callScheduleWarp(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -849,9 +995,9 @@ func (c *shipgateJSONClient) DeleteCharacter(ctx context.Context, in *CharacterR
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callDeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) callScheduleWarp(ctx context.Context, in *WarpRequest) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -866,26 +1012,26 @@ func (c *shipgateJSONClient) callDeleteCharacter(ctx context.Context, in *Charac
 	return out, nil
 }
 
-func (c *shipgateJSONClient) GetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+func (c *shipgateProtobufClient) AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "GetGuildcardEntries")
-	caller := c.callGetGuildcardEntries
+	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
+	caller := c.callAuthenticateAccount
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+		caller = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetGuildcardEntriesRequest)
+					typedReq, ok := req.(*AuthenticateAccountRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetGuildcardEntriesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
 					}
-					return c.callGetGuildcardEntries(ctx, typedReq)
+					return c.callAuthenticateAccount(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetGuildcardEntriesResponse)
+				typedResp, ok := resp.(*archon.Account)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetGuildcardEntriesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -895,9 +1041,9 @@ func (c *shipgateJSONClient) GetGuildcardEntries(ctx context.Context, in *GetGui
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callGetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
-	out := new(GetGuildcardEntriesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+func (c *shipgateProtobufClient) callAuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -912,26 +1058,26 @@ func (c *shipgateJSONClient) callGetGuildcardEntries(ctx context.Context, in *Ge
 	return out, nil
 }
 
-func (c *shipgateJSONClient) GetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+func (c *shipgateProtobufClient) IssueSessionToken(ctx context.Context, in *IssueSessionTokenRequest) (*SessionToken, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "GetPlayerOptions")
-	caller := c.callGetPlayerOptions
+	ctx = ctxsetters.WithMethodName(ctx, "IssueSessionToken")
+	caller := c.callIssueSessionToken
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+		caller = func(ctx context.Context, req *IssueSessionTokenRequest) (*SessionToken, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPlayerOptionsRequest)
+					typedReq, ok := req.(*IssueSessionTokenRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPlayerOptionsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*IssueSessionTokenRequest) when calling interceptor")
 					}
-					return c.callGetPlayerOptions(ctx, typedReq)
+					return c.callIssueSessionToken(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPlayerOptionsResponse)
+				typedResp, ok := resp.(*SessionToken)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPlayerOptionsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SessionToken) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -941,9 +1087,9 @@ func (c *shipgateJSONClient) GetPlayerOptions(ctx context.Context, in *GetPlayer
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callGetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
-	out := new(GetPlayerOptionsResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+func (c *shipgateProtobufClient) callIssueSessionToken(ctx context.Context, in *IssueSessionTokenRequest) (*SessionToken, error) {
+	out := new(SessionToken)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -958,20 +1104,66 @@ func (c *shipgateJSONClient) callGetPlayerOptions(ctx context.Context, in *GetPl
 	return out, nil
 }
 
-func (c *shipgateJSONClient) UpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) VerifySessionToken(ctx context.Context, in *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertPlayerOptions")
-	caller := c.callUpsertPlayerOptions
+	ctx = ctxsetters.WithMethodName(ctx, "VerifySessionToken")
+	caller := c.callVerifySessionToken
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+		caller = func(ctx context.Context, req *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpsertPlayerOptionsRequest)
+					typedReq, ok := req.(*VerifySessionTokenRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpsertPlayerOptionsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*VerifySessionTokenRequest) when calling interceptor")
 					}
-					return c.callUpsertPlayerOptions(ctx, typedReq)
+					return c.callVerifySessionToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifySessionTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifySessionTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callVerifySessionToken(ctx context.Context, in *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
+	out := new(VerifySessionTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSessions")
+	caller := c.callRevokeSessions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionsRequest) when calling interceptor")
+					}
+					return c.callRevokeSessions(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -987,9 +1179,9 @@ func (c *shipgateJSONClient) UpsertPlayerOptions(ctx context.Context, in *Upsert
 	return caller(ctx, in)
 }
 
-func (c *shipgateJSONClient) callUpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+func (c *shipgateProtobufClient) callRevokeSessions(ctx context.Context, in *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1004,138 +1196,11462 @@ func (c *shipgateJSONClient) callUpsertPlayerOptions(ctx context.Context, in *Up
 	return out, nil
 }
 
-// =======================
-// Shipgate Server Handler
-// =======================
-
-type shipgateServer struct {
-	Shipgate
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *shipgateProtobufClient) EndSession(ctx context.Context, in *EndSessionRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "EndSession")
+	caller := c.callEndSession
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EndSessionRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EndSessionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EndSessionRequest) when calling interceptor")
+					}
+					return c.callEndSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewShipgateServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewShipgateServer(svc Shipgate, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *shipgateProtobufClient) callEndSession(ctx context.Context, in *EndSessionRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &shipgateServer{
-		Shipgate:         svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *shipgateServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *shipgateServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
-	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
+func (c *shipgateProtobufClient) BanPlayer(ctx context.Context, in *BanRequest) (*Ban, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "BanPlayer")
+	caller := c.callBanPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BanRequest) (*Ban, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanRequest) when calling interceptor")
+					}
+					return c.callBanPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+	return caller(ctx, in)
 }
 
-// ShipgatePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const ShipgatePathPrefix = "/twirp/archon.Shipgate/"
+func (c *shipgateProtobufClient) callBanPlayer(ctx context.Context, in *BanRequest) (*Ban, error) {
+	out := new(Ban)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
 
-func (s *shipgateServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) ListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "archon")
 	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	caller := c.callListBans
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return c.callListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
+func (c *shipgateProtobufClient) callListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) LiftBan(ctx context.Context, in *LiftBanRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "LiftBan")
+	caller := c.callLiftBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LiftBanRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LiftBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LiftBanRequest) when calling interceptor")
+					}
+					return c.callLiftBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "archon.Shipgate" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+func (c *shipgateProtobufClient) callLiftBan(ctx context.Context, in *LiftBanRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
-	if prefix != s.pathPrefix {
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) CheckBan(ctx context.Context, in *CheckBanRequest) (*CheckBanResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBan")
+	caller := c.callCheckBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckBanRequest) (*CheckBanResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBanRequest) when calling interceptor")
+					}
+					return c.callCheckBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callCheckBan(ctx context.Context, in *CheckBanRequest) (*CheckBanResponse, error) {
+	out := new(CheckBanResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) SetPrivilegeLevel(ctx context.Context, in *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPrivilegeLevel")
+	caller := c.callSetPrivilegeLevel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPrivilegeLevelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPrivilegeLevelRequest) when calling interceptor")
+					}
+					return c.callSetPrivilegeLevel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callSetPrivilegeLevel(ctx context.Context, in *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) CreateAccount(ctx context.Context, in *CreateAccountRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAccount")
+	caller := c.callCreateAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateAccountRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAccountRequest) when calling interceptor")
+					}
+					return c.callCreateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callCreateAccount(ctx context.Context, in *CreateAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) RegisterAccount(ctx context.Context, in *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterAccount")
+	caller := c.callRegisterAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterAccountRequest) when calling interceptor")
+					}
+					return c.callRegisterAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callRegisterAccount(ctx context.Context, in *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+	out := new(RegisterAccountResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	caller := c.callVerifyEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyEmailRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailRequest) when calling interceptor")
+					}
+					return c.callVerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callVerifyEmail(ctx context.Context, in *VerifyEmailRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) IssuePasswordReset(ctx context.Context, in *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "IssuePasswordReset")
+	caller := c.callIssuePasswordReset
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssuePasswordResetRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssuePasswordResetRequest) when calling interceptor")
+					}
+					return c.callIssuePasswordReset(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssuePasswordResetResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssuePasswordResetResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callIssuePasswordReset(ctx context.Context, in *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+	out := new(IssuePasswordResetResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	caller := c.callResetPassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResetPasswordRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordRequest) when calling interceptor")
+					}
+					return c.callResetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callResetPassword(ctx context.Context, in *ResetPasswordRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAccount")
+	caller := c.callUpdateAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateAccountRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAccountRequest) when calling interceptor")
+					}
+					return c.callUpdateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callUpdateAccount(ctx context.Context, in *UpdateAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) SetPassword(ctx context.Context, in *SetPasswordRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPassword")
+	caller := c.callSetPassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPasswordRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPasswordRequest) when calling interceptor")
+					}
+					return c.callSetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callSetPassword(ctx context.Context, in *SetPasswordRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) BanAccount(ctx context.Context, in *BanAccountRequest) (*Ban, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "BanAccount")
+	caller := c.callBanAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BanAccountRequest) (*Ban, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanAccountRequest) when calling interceptor")
+					}
+					return c.callBanAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callBanAccount(ctx context.Context, in *BanAccountRequest) (*Ban, error) {
+	out := new(Ban)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) ListAccounts(ctx context.Context, in *ListAccountsRequest) (*ListAccountsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccounts")
+	caller := c.callListAccounts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountsRequest) when calling interceptor")
+					}
+					return c.callListAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callListAccounts(ctx context.Context, in *ListAccountsRequest) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) GetAccount(ctx context.Context, in *GetAccountRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAccount")
+	caller := c.callGetAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAccountRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAccountRequest) when calling interceptor")
+					}
+					return c.callGetAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callGetAccount(ctx context.Context, in *GetAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	caller := c.callDeleteAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return c.callDeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callDeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) RecordAuditEntry(ctx context.Context, in *AuditEntry) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAuditEntry")
+	caller := c.callRecordAuditEntry
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuditEntry) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditEntry)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditEntry) when calling interceptor")
+					}
+					return c.callRecordAuditEntry(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callRecordAuditEntry(ctx context.Context, in *AuditEntry) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) ReportViolation(ctx context.Context, in *Violation) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ReportViolation")
+	caller := c.callReportViolation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Violation) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Violation)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Violation) when calling interceptor")
+					}
+					return c.callReportViolation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callReportViolation(ctx context.Context, in *Violation) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[37], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) ListViolations(ctx context.Context, in *ListViolationsRequest) (*ListViolationsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ListViolations")
+	caller := c.callListViolations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListViolationsRequest) (*ListViolationsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListViolationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListViolationsRequest) when calling interceptor")
+					}
+					return c.callListViolations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListViolationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListViolationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callListViolations(ctx context.Context, in *ListViolationsRequest) (*ListViolationsResponse, error) {
+	out := new(ListViolationsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[38], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) FindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
+	caller := c.callFindCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+					}
+					return c.callFindCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callFindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
+	out := new(FindCharacterResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[39], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) FindCharacterProfiles(ctx context.Context, in *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacterProfiles")
+	caller := c.callFindCharacterProfiles
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindCharacterProfilesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindCharacterProfilesRequest) when calling interceptor")
+					}
+					return c.callFindCharacterProfiles(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterProfilesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterProfilesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callFindCharacterProfiles(ctx context.Context, in *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+	out := new(FindCharacterProfilesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[40], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) EditCharacter(ctx context.Context, in *EditCharacterRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "EditCharacter")
+	caller := c.callEditCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EditCharacterRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EditCharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EditCharacterRequest) when calling interceptor")
+					}
+					return c.callEditCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callEditCharacter(ctx context.Context, in *EditCharacterRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[41], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) UpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
+	caller := c.callUpsertCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpsertCharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
+					}
+					return c.callUpsertCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callUpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[42], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) DeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
+	caller := c.callDeleteCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+					}
+					return c.callDeleteCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callDeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[43], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) UpdateCharacterStats(ctx context.Context, in *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateCharacterStats")
+	caller := c.callUpdateCharacterStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateCharacterStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateCharacterStatsRequest) when calling interceptor")
+					}
+					return c.callUpdateCharacterStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callUpdateCharacterStats(ctx context.Context, in *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[44], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) UpdateInfoBoard(ctx context.Context, in *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateInfoBoard")
+	caller := c.callUpdateInfoBoard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateInfoBoardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateInfoBoardRequest) when calling interceptor")
+					}
+					return c.callUpdateInfoBoard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callUpdateInfoBoard(ctx context.Context, in *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[45], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) UpdateChallengeRecords(ctx context.Context, in *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateChallengeRecords")
+	caller := c.callUpdateChallengeRecords
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateChallengeRecordsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateChallengeRecordsRequest) when calling interceptor")
+					}
+					return c.callUpdateChallengeRecords(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callUpdateChallengeRecords(ctx context.Context, in *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[46], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) GetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetGuildcardEntries")
+	caller := c.callGetGuildcardEntries
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetGuildcardEntriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetGuildcardEntriesRequest) when calling interceptor")
+					}
+					return c.callGetGuildcardEntries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetGuildcardEntriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetGuildcardEntriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callGetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+	out := new(GetGuildcardEntriesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[47], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) GetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlayerOptions")
+	caller := c.callGetPlayerOptions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlayerOptionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlayerOptionsRequest) when calling interceptor")
+					}
+					return c.callGetPlayerOptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPlayerOptionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPlayerOptionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callGetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+	out := new(GetPlayerOptionsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[48], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateProtobufClient) UpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertPlayerOptions")
+	caller := c.callUpsertPlayerOptions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpsertPlayerOptionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertPlayerOptionsRequest) when calling interceptor")
+					}
+					return c.callUpsertPlayerOptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateProtobufClient) callUpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[49], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ====================
+// Shipgate JSON Client
+// ====================
+
+type shipgateJSONClient struct {
+	client      HTTPClient
+	urls        [50]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewShipgateJSONClient creates a JSON client that implements the Shipgate interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewShipgateJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Shipgate {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "archon", "Shipgate")
+	urls := [50]string{
+		serviceURL + "GetActiveShips",
+		serviceURL + "RegisterShip",
+		serviceURL + "ShipHeartbeat",
+		serviceURL + "GetServerStatus",
+		serviceURL + "PollEvents",
+		serviceURL + "Broadcast",
+		serviceURL + "SetPlayerLocation",
+		serviceURL + "ClearPlayerLocation",
+		serviceURL + "SetPlayerPresence",
+		serviceURL + "ClearPlayerPresence",
+		serviceURL + "FindPlayer",
+		serviceURL + "ListPlayers",
+		serviceURL + "MessagePlayer",
+		serviceURL + "KickPlayer",
+		serviceURL + "ScheduleWarp",
+		serviceURL + "AuthenticateAccount",
+		serviceURL + "IssueSessionToken",
+		serviceURL + "VerifySessionToken",
+		serviceURL + "RevokeSessions",
+		serviceURL + "EndSession",
+		serviceURL + "BanPlayer",
+		serviceURL + "ListBans",
+		serviceURL + "LiftBan",
+		serviceURL + "CheckBan",
+		serviceURL + "SetPrivilegeLevel",
+		serviceURL + "CreateAccount",
+		serviceURL + "RegisterAccount",
+		serviceURL + "VerifyEmail",
+		serviceURL + "IssuePasswordReset",
+		serviceURL + "ResetPassword",
+		serviceURL + "UpdateAccount",
+		serviceURL + "SetPassword",
+		serviceURL + "BanAccount",
+		serviceURL + "ListAccounts",
+		serviceURL + "GetAccount",
+		serviceURL + "DeleteAccount",
+		serviceURL + "RecordAuditEntry",
+		serviceURL + "ReportViolation",
+		serviceURL + "ListViolations",
+		serviceURL + "FindCharacter",
+		serviceURL + "FindCharacterProfiles",
+		serviceURL + "EditCharacter",
+		serviceURL + "UpsertCharacter",
+		serviceURL + "DeleteCharacter",
+		serviceURL + "UpdateCharacterStats",
+		serviceURL + "UpdateInfoBoard",
+		serviceURL + "UpdateChallengeRecords",
+		serviceURL + "GetGuildcardEntries",
+		serviceURL + "GetPlayerOptions",
+		serviceURL + "UpsertPlayerOptions",
+	}
+
+	return &shipgateJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *shipgateJSONClient) GetActiveShips(ctx context.Context, in *google_protobuf.Empty) (*ShipList, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetActiveShips")
+	caller := c.callGetActiveShips
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ShipList, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetActiveShips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ShipList)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ShipList) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callGetActiveShips(ctx context.Context, in *google_protobuf.Empty) (*ShipList, error) {
+	out := new(ShipList)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) RegisterShip(ctx context.Context, in *RegisterShipRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterShip")
+	caller := c.callRegisterShip
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterShipRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterShipRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterShipRequest) when calling interceptor")
+					}
+					return c.callRegisterShip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callRegisterShip(ctx context.Context, in *RegisterShipRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ShipHeartbeat(ctx context.Context, in *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ShipHeartbeat")
+	caller := c.callShipHeartbeat
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ShipHeartbeatRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ShipHeartbeatRequest) when calling interceptor")
+					}
+					return c.callShipHeartbeat(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callShipHeartbeat(ctx context.Context, in *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) GetServerStatus(ctx context.Context, in *google_protobuf.Empty) (*ServerStatus, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetServerStatus")
+	caller := c.callGetServerStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf.Empty) (*ServerStatus, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return c.callGetServerStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ServerStatus)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ServerStatus) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callGetServerStatus(ctx context.Context, in *google_protobuf.Empty) (*ServerStatus, error) {
+	out := new(ServerStatus)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) PollEvents(ctx context.Context, in *PollEventsRequest) (*PollEventsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "PollEvents")
+	caller := c.callPollEvents
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PollEventsRequest) (*PollEventsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PollEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PollEventsRequest) when calling interceptor")
+					}
+					return c.callPollEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PollEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PollEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callPollEvents(ctx context.Context, in *PollEventsRequest) (*PollEventsResponse, error) {
+	out := new(PollEventsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) Broadcast(ctx context.Context, in *BroadcastRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "Broadcast")
+	caller := c.callBroadcast
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BroadcastRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BroadcastRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BroadcastRequest) when calling interceptor")
+					}
+					return c.callBroadcast(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callBroadcast(ctx context.Context, in *BroadcastRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) SetPlayerLocation(ctx context.Context, in *PlayerLocation) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerLocation")
+	caller := c.callSetPlayerLocation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PlayerLocation) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerLocation)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerLocation) when calling interceptor")
+					}
+					return c.callSetPlayerLocation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callSetPlayerLocation(ctx context.Context, in *PlayerLocation) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ClearPlayerLocation(ctx context.Context, in *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerLocation")
+	caller := c.callClearPlayerLocation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearPlayerLocationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearPlayerLocationRequest) when calling interceptor")
+					}
+					return c.callClearPlayerLocation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callClearPlayerLocation(ctx context.Context, in *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) SetPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerPresence")
+	caller := c.callSetPlayerPresence
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerPresence)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
+					}
+					return c.callSetPlayerPresence(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callSetPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ClearPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerPresence")
+	caller := c.callClearPlayerPresence
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerPresence)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
+					}
+					return c.callClearPlayerPresence(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callClearPlayerPresence(ctx context.Context, in *PlayerPresence) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) FindPlayer(ctx context.Context, in *FindPlayerRequest) (*FindPlayerResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "FindPlayer")
+	caller := c.callFindPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindPlayerRequest) (*FindPlayerResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindPlayerRequest) when calling interceptor")
+					}
+					return c.callFindPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindPlayerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindPlayerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callFindPlayer(ctx context.Context, in *FindPlayerRequest) (*FindPlayerResponse, error) {
+	out := new(FindPlayerResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ListPlayers(ctx context.Context, in *ListPlayersRequest) (*ListPlayersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlayers")
+	caller := c.callListPlayers
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListPlayersRequest) (*ListPlayersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPlayersRequest) when calling interceptor")
+					}
+					return c.callListPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPlayersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPlayersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callListPlayers(ctx context.Context, in *ListPlayersRequest) (*ListPlayersResponse, error) {
+	out := new(ListPlayersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) MessagePlayer(ctx context.Context, in *MessagePlayerRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "MessagePlayer")
+	caller := c.callMessagePlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MessagePlayerRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MessagePlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MessagePlayerRequest) when calling interceptor")
+					}
+					return c.callMessagePlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callMessagePlayer(ctx context.Context, in *MessagePlayerRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) KickPlayer(ctx context.Context, in *KickPlayerRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "KickPlayer")
+	caller := c.callKickPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *KickPlayerRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KickPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KickPlayerRequest) when calling interceptor")
+					}
+					return c.callKickPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callKickPlayer(ctx context.Context, in *KickPlayerRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ScheduleWarp(ctx context.Context, in *WarpRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleWarp")
+	caller := c.callScheduleWarp
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WarpRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WarpRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WarpRequest) when calling interceptor")
+					}
+					return c.callScheduleWarp(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callScheduleWarp(ctx context.Context, in *WarpRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
+	caller := c.callAuthenticateAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuthenticateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
+					}
+					return c.callAuthenticateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callAuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) IssueSessionToken(ctx context.Context, in *IssueSessionTokenRequest) (*SessionToken, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "IssueSessionToken")
+	caller := c.callIssueSessionToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IssueSessionTokenRequest) (*SessionToken, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueSessionTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueSessionTokenRequest) when calling interceptor")
+					}
+					return c.callIssueSessionToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SessionToken)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SessionToken) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callIssueSessionToken(ctx context.Context, in *IssueSessionTokenRequest) (*SessionToken, error) {
+	out := new(SessionToken)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) VerifySessionToken(ctx context.Context, in *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifySessionToken")
+	caller := c.callVerifySessionToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifySessionTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifySessionTokenRequest) when calling interceptor")
+					}
+					return c.callVerifySessionToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifySessionTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifySessionTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callVerifySessionToken(ctx context.Context, in *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
+	out := new(VerifySessionTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSessions")
+	caller := c.callRevokeSessions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionsRequest) when calling interceptor")
+					}
+					return c.callRevokeSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callRevokeSessions(ctx context.Context, in *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) EndSession(ctx context.Context, in *EndSessionRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "EndSession")
+	caller := c.callEndSession
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EndSessionRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EndSessionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EndSessionRequest) when calling interceptor")
+					}
+					return c.callEndSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callEndSession(ctx context.Context, in *EndSessionRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) BanPlayer(ctx context.Context, in *BanRequest) (*Ban, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "BanPlayer")
+	caller := c.callBanPlayer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BanRequest) (*Ban, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanRequest) when calling interceptor")
+					}
+					return c.callBanPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callBanPlayer(ctx context.Context, in *BanRequest) (*Ban, error) {
+	out := new(Ban)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	caller := c.callListBans
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return c.callListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callListBans(ctx context.Context, in *ListBansRequest) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) LiftBan(ctx context.Context, in *LiftBanRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "LiftBan")
+	caller := c.callLiftBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LiftBanRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LiftBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LiftBanRequest) when calling interceptor")
+					}
+					return c.callLiftBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callLiftBan(ctx context.Context, in *LiftBanRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) CheckBan(ctx context.Context, in *CheckBanRequest) (*CheckBanResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBan")
+	caller := c.callCheckBan
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckBanRequest) (*CheckBanResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBanRequest) when calling interceptor")
+					}
+					return c.callCheckBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callCheckBan(ctx context.Context, in *CheckBanRequest) (*CheckBanResponse, error) {
+	out := new(CheckBanResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) SetPrivilegeLevel(ctx context.Context, in *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPrivilegeLevel")
+	caller := c.callSetPrivilegeLevel
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPrivilegeLevelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPrivilegeLevelRequest) when calling interceptor")
+					}
+					return c.callSetPrivilegeLevel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callSetPrivilegeLevel(ctx context.Context, in *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) CreateAccount(ctx context.Context, in *CreateAccountRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAccount")
+	caller := c.callCreateAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateAccountRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAccountRequest) when calling interceptor")
+					}
+					return c.callCreateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callCreateAccount(ctx context.Context, in *CreateAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) RegisterAccount(ctx context.Context, in *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterAccount")
+	caller := c.callRegisterAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterAccountRequest) when calling interceptor")
+					}
+					return c.callRegisterAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callRegisterAccount(ctx context.Context, in *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+	out := new(RegisterAccountResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	caller := c.callVerifyEmail
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VerifyEmailRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailRequest) when calling interceptor")
+					}
+					return c.callVerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callVerifyEmail(ctx context.Context, in *VerifyEmailRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) IssuePasswordReset(ctx context.Context, in *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "IssuePasswordReset")
+	caller := c.callIssuePasswordReset
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssuePasswordResetRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssuePasswordResetRequest) when calling interceptor")
+					}
+					return c.callIssuePasswordReset(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssuePasswordResetResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssuePasswordResetResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callIssuePasswordReset(ctx context.Context, in *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+	out := new(IssuePasswordResetResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	caller := c.callResetPassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResetPasswordRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordRequest) when calling interceptor")
+					}
+					return c.callResetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callResetPassword(ctx context.Context, in *ResetPasswordRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[29], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAccount")
+	caller := c.callUpdateAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateAccountRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAccountRequest) when calling interceptor")
+					}
+					return c.callUpdateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callUpdateAccount(ctx context.Context, in *UpdateAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[30], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) SetPassword(ctx context.Context, in *SetPasswordRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPassword")
+	caller := c.callSetPassword
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPasswordRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPasswordRequest) when calling interceptor")
+					}
+					return c.callSetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callSetPassword(ctx context.Context, in *SetPasswordRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[31], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) BanAccount(ctx context.Context, in *BanAccountRequest) (*Ban, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "BanAccount")
+	caller := c.callBanAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BanAccountRequest) (*Ban, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanAccountRequest) when calling interceptor")
+					}
+					return c.callBanAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callBanAccount(ctx context.Context, in *BanAccountRequest) (*Ban, error) {
+	out := new(Ban)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[32], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ListAccounts(ctx context.Context, in *ListAccountsRequest) (*ListAccountsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccounts")
+	caller := c.callListAccounts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountsRequest) when calling interceptor")
+					}
+					return c.callListAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callListAccounts(ctx context.Context, in *ListAccountsRequest) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[33], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) GetAccount(ctx context.Context, in *GetAccountRequest) (*archon.Account, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAccount")
+	caller := c.callGetAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAccountRequest) (*archon.Account, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAccountRequest) when calling interceptor")
+					}
+					return c.callGetAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callGetAccount(ctx context.Context, in *GetAccountRequest) (*archon.Account, error) {
+	out := new(archon.Account)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[34], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	caller := c.callDeleteAccount
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return c.callDeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callDeleteAccount(ctx context.Context, in *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[35], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) RecordAuditEntry(ctx context.Context, in *AuditEntry) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAuditEntry")
+	caller := c.callRecordAuditEntry
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuditEntry) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditEntry)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditEntry) when calling interceptor")
+					}
+					return c.callRecordAuditEntry(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callRecordAuditEntry(ctx context.Context, in *AuditEntry) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[36], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ReportViolation(ctx context.Context, in *Violation) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ReportViolation")
+	caller := c.callReportViolation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *Violation) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Violation)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Violation) when calling interceptor")
+					}
+					return c.callReportViolation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callReportViolation(ctx context.Context, in *Violation) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[37], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) ListViolations(ctx context.Context, in *ListViolationsRequest) (*ListViolationsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "ListViolations")
+	caller := c.callListViolations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListViolationsRequest) (*ListViolationsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListViolationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListViolationsRequest) when calling interceptor")
+					}
+					return c.callListViolations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListViolationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListViolationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callListViolations(ctx context.Context, in *ListViolationsRequest) (*ListViolationsResponse, error) {
+	out := new(ListViolationsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[38], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) FindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
+	caller := c.callFindCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+					}
+					return c.callFindCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callFindCharacter(ctx context.Context, in *CharacterRequest) (*FindCharacterResponse, error) {
+	out := new(FindCharacterResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[39], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) FindCharacterProfiles(ctx context.Context, in *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacterProfiles")
+	caller := c.callFindCharacterProfiles
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindCharacterProfilesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindCharacterProfilesRequest) when calling interceptor")
+					}
+					return c.callFindCharacterProfiles(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterProfilesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterProfilesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callFindCharacterProfiles(ctx context.Context, in *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+	out := new(FindCharacterProfilesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[40], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) EditCharacter(ctx context.Context, in *EditCharacterRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "EditCharacter")
+	caller := c.callEditCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EditCharacterRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EditCharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EditCharacterRequest) when calling interceptor")
+					}
+					return c.callEditCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callEditCharacter(ctx context.Context, in *EditCharacterRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[41], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) UpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
+	caller := c.callUpsertCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpsertCharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
+					}
+					return c.callUpsertCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callUpsertCharacter(ctx context.Context, in *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[42], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) DeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
+	caller := c.callDeleteCharacter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+					}
+					return c.callDeleteCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callDeleteCharacter(ctx context.Context, in *CharacterRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[43], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) UpdateCharacterStats(ctx context.Context, in *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateCharacterStats")
+	caller := c.callUpdateCharacterStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateCharacterStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateCharacterStatsRequest) when calling interceptor")
+					}
+					return c.callUpdateCharacterStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callUpdateCharacterStats(ctx context.Context, in *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[44], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) UpdateInfoBoard(ctx context.Context, in *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateInfoBoard")
+	caller := c.callUpdateInfoBoard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateInfoBoardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateInfoBoardRequest) when calling interceptor")
+					}
+					return c.callUpdateInfoBoard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callUpdateInfoBoard(ctx context.Context, in *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[45], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) UpdateChallengeRecords(ctx context.Context, in *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateChallengeRecords")
+	caller := c.callUpdateChallengeRecords
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateChallengeRecordsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateChallengeRecordsRequest) when calling interceptor")
+					}
+					return c.callUpdateChallengeRecords(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callUpdateChallengeRecords(ctx context.Context, in *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[46], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) GetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetGuildcardEntries")
+	caller := c.callGetGuildcardEntries
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetGuildcardEntriesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetGuildcardEntriesRequest) when calling interceptor")
+					}
+					return c.callGetGuildcardEntries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetGuildcardEntriesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetGuildcardEntriesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callGetGuildcardEntries(ctx context.Context, in *GetGuildcardEntriesRequest) (*GetGuildcardEntriesResponse, error) {
+	out := new(GetGuildcardEntriesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[47], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) GetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPlayerOptions")
+	caller := c.callGetPlayerOptions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPlayerOptionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPlayerOptionsRequest) when calling interceptor")
+					}
+					return c.callGetPlayerOptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPlayerOptionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPlayerOptionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callGetPlayerOptions(ctx context.Context, in *GetPlayerOptionsRequest) (*GetPlayerOptionsResponse, error) {
+	out := new(GetPlayerOptionsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[48], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *shipgateJSONClient) UpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertPlayerOptions")
+	caller := c.callUpsertPlayerOptions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpsertPlayerOptionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertPlayerOptionsRequest) when calling interceptor")
+					}
+					return c.callUpsertPlayerOptions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *shipgateJSONClient) callUpsertPlayerOptions(ctx context.Context, in *UpsertPlayerOptionsRequest) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[49], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// Shipgate Server Handler
+// =======================
+
+type shipgateServer struct {
+	Shipgate
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewShipgateServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewShipgateServer(svc Shipgate, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &shipgateServer{
+		Shipgate:         svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *shipgateServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *shipgateServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ShipgatePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ShipgatePathPrefix = "/twirp/archon.Shipgate/"
+
+func (s *shipgateServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "archon")
+	ctx = ctxsetters.WithServiceName(ctx, "Shipgate")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "archon.Shipgate" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
 		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
 
-	switch method {
-	case "GetActiveShips":
-		s.serveGetActiveShips(ctx, resp, req)
-		return
-	case "RegisterShip":
-		s.serveRegisterShip(ctx, resp, req)
-		return
-	case "AuthenticateAccount":
-		s.serveAuthenticateAccount(ctx, resp, req)
-		return
-	case "FindCharacter":
-		s.serveFindCharacter(ctx, resp, req)
-		return
-	case "UpsertCharacter":
-		s.serveUpsertCharacter(ctx, resp, req)
-		return
-	case "DeleteCharacter":
-		s.serveDeleteCharacter(ctx, resp, req)
-		return
-	case "GetGuildcardEntries":
-		s.serveGetGuildcardEntries(ctx, resp, req)
-		return
-	case "GetPlayerOptions":
-		s.serveGetPlayerOptions(ctx, resp, req)
-		return
-	case "UpsertPlayerOptions":
-		s.serveUpsertPlayerOptions(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	switch method {
+	case "GetActiveShips":
+		s.serveGetActiveShips(ctx, resp, req)
+		return
+	case "RegisterShip":
+		s.serveRegisterShip(ctx, resp, req)
+		return
+	case "ShipHeartbeat":
+		s.serveShipHeartbeat(ctx, resp, req)
+		return
+	case "GetServerStatus":
+		s.serveGetServerStatus(ctx, resp, req)
+		return
+	case "PollEvents":
+		s.servePollEvents(ctx, resp, req)
+		return
+	case "Broadcast":
+		s.serveBroadcast(ctx, resp, req)
+		return
+	case "SetPlayerLocation":
+		s.serveSetPlayerLocation(ctx, resp, req)
+		return
+	case "ClearPlayerLocation":
+		s.serveClearPlayerLocation(ctx, resp, req)
+		return
+	case "SetPlayerPresence":
+		s.serveSetPlayerPresence(ctx, resp, req)
+		return
+	case "ClearPlayerPresence":
+		s.serveClearPlayerPresence(ctx, resp, req)
+		return
+	case "FindPlayer":
+		s.serveFindPlayer(ctx, resp, req)
+		return
+	case "ListPlayers":
+		s.serveListPlayers(ctx, resp, req)
+		return
+	case "MessagePlayer":
+		s.serveMessagePlayer(ctx, resp, req)
+		return
+	case "KickPlayer":
+		s.serveKickPlayer(ctx, resp, req)
+		return
+	case "ScheduleWarp":
+		s.serveScheduleWarp(ctx, resp, req)
+		return
+	case "AuthenticateAccount":
+		s.serveAuthenticateAccount(ctx, resp, req)
+		return
+	case "IssueSessionToken":
+		s.serveIssueSessionToken(ctx, resp, req)
+		return
+	case "VerifySessionToken":
+		s.serveVerifySessionToken(ctx, resp, req)
+		return
+	case "RevokeSessions":
+		s.serveRevokeSessions(ctx, resp, req)
+		return
+	case "EndSession":
+		s.serveEndSession(ctx, resp, req)
+		return
+	case "BanPlayer":
+		s.serveBanPlayer(ctx, resp, req)
+		return
+	case "ListBans":
+		s.serveListBans(ctx, resp, req)
+		return
+	case "LiftBan":
+		s.serveLiftBan(ctx, resp, req)
+		return
+	case "CheckBan":
+		s.serveCheckBan(ctx, resp, req)
+		return
+	case "SetPrivilegeLevel":
+		s.serveSetPrivilegeLevel(ctx, resp, req)
+		return
+	case "CreateAccount":
+		s.serveCreateAccount(ctx, resp, req)
+		return
+	case "RegisterAccount":
+		s.serveRegisterAccount(ctx, resp, req)
+		return
+	case "VerifyEmail":
+		s.serveVerifyEmail(ctx, resp, req)
+		return
+	case "IssuePasswordReset":
+		s.serveIssuePasswordReset(ctx, resp, req)
+		return
+	case "ResetPassword":
+		s.serveResetPassword(ctx, resp, req)
+		return
+	case "UpdateAccount":
+		s.serveUpdateAccount(ctx, resp, req)
+		return
+	case "SetPassword":
+		s.serveSetPassword(ctx, resp, req)
+		return
+	case "BanAccount":
+		s.serveBanAccount(ctx, resp, req)
+		return
+	case "ListAccounts":
+		s.serveListAccounts(ctx, resp, req)
+		return
+	case "GetAccount":
+		s.serveGetAccount(ctx, resp, req)
+		return
+	case "DeleteAccount":
+		s.serveDeleteAccount(ctx, resp, req)
+		return
+	case "RecordAuditEntry":
+		s.serveRecordAuditEntry(ctx, resp, req)
+		return
+	case "ReportViolation":
+		s.serveReportViolation(ctx, resp, req)
+		return
+	case "ListViolations":
+		s.serveListViolations(ctx, resp, req)
+		return
+	case "FindCharacter":
+		s.serveFindCharacter(ctx, resp, req)
+		return
+	case "FindCharacterProfiles":
+		s.serveFindCharacterProfiles(ctx, resp, req)
+		return
+	case "EditCharacter":
+		s.serveEditCharacter(ctx, resp, req)
+		return
+	case "UpsertCharacter":
+		s.serveUpsertCharacter(ctx, resp, req)
+		return
+	case "DeleteCharacter":
+		s.serveDeleteCharacter(ctx, resp, req)
+		return
+	case "UpdateCharacterStats":
+		s.serveUpdateCharacterStats(ctx, resp, req)
+		return
+	case "UpdateInfoBoard":
+		s.serveUpdateInfoBoard(ctx, resp, req)
+		return
+	case "UpdateChallengeRecords":
+		s.serveUpdateChallengeRecords(ctx, resp, req)
+		return
+	case "GetGuildcardEntries":
+		s.serveGetGuildcardEntries(ctx, resp, req)
+		return
+	case "GetPlayerOptions":
+		s.serveGetPlayerOptions(ctx, resp, req)
+		return
+	case "UpsertPlayerOptions":
+		s.serveUpsertPlayerOptions(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *shipgateServer) serveGetActiveShips(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetActiveShipsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetActiveShipsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveGetActiveShipsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetActiveShips")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.GetActiveShips
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ShipList, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Shipgate.GetActiveShips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ShipList)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ShipList) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ShipList
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ShipList and nil error while calling GetActiveShips. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveGetActiveShipsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetActiveShips")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.GetActiveShips
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ShipList, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Shipgate.GetActiveShips(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ShipList)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ShipList) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ShipList
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ShipList and nil error while calling GetActiveShips. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRegisterShip(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRegisterShipJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRegisterShipProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveRegisterShipJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterShip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RegisterShipRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.RegisterShip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterShipRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterShipRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterShipRequest) when calling interceptor")
+					}
+					return s.Shipgate.RegisterShip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RegisterShip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRegisterShipProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterShip")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RegisterShipRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.RegisterShip
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterShipRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterShipRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterShipRequest) when calling interceptor")
+					}
+					return s.Shipgate.RegisterShip(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RegisterShip. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveShipHeartbeat(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveShipHeartbeatJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveShipHeartbeatProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveShipHeartbeatJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ShipHeartbeat")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ShipHeartbeatRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ShipHeartbeat
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ShipHeartbeatRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ShipHeartbeatRequest) when calling interceptor")
+					}
+					return s.Shipgate.ShipHeartbeat(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ShipHeartbeat. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveShipHeartbeatProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ShipHeartbeat")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ShipHeartbeatRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ShipHeartbeat
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ShipHeartbeatRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ShipHeartbeatRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ShipHeartbeatRequest) when calling interceptor")
+					}
+					return s.Shipgate.ShipHeartbeat(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ShipHeartbeat. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveGetServerStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetServerStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetServerStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveGetServerStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetServerStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.GetServerStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ServerStatus, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Shipgate.GetServerStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ServerStatus)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ServerStatus) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ServerStatus
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ServerStatus and nil error while calling GetServerStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveGetServerStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetServerStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.GetServerStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ServerStatus, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+					}
+					return s.Shipgate.GetServerStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ServerStatus)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ServerStatus) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ServerStatus
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ServerStatus and nil error while calling GetServerStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) servePollEvents(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePollEventsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePollEventsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) servePollEventsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PollEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PollEventsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.PollEvents
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PollEventsRequest) (*PollEventsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PollEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PollEventsRequest) when calling interceptor")
+					}
+					return s.Shipgate.PollEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PollEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PollEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PollEventsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PollEventsResponse and nil error while calling PollEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) servePollEventsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PollEvents")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PollEventsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.PollEvents
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PollEventsRequest) (*PollEventsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PollEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PollEventsRequest) when calling interceptor")
+					}
+					return s.Shipgate.PollEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PollEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PollEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PollEventsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PollEventsResponse and nil error while calling PollEvents. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveBroadcast(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBroadcastJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBroadcastProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveBroadcastJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Broadcast")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(BroadcastRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.Broadcast
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BroadcastRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BroadcastRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BroadcastRequest) when calling interceptor")
+					}
+					return s.Shipgate.Broadcast(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Broadcast. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveBroadcastProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Broadcast")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(BroadcastRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.Broadcast
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BroadcastRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BroadcastRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BroadcastRequest) when calling interceptor")
+					}
+					return s.Shipgate.Broadcast(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling Broadcast. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPlayerLocation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPlayerLocationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPlayerLocationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveSetPlayerLocationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerLocation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PlayerLocation)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.SetPlayerLocation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlayerLocation) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerLocation)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerLocation) when calling interceptor")
+					}
+					return s.Shipgate.SetPlayerLocation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlayerLocation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPlayerLocationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerLocation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PlayerLocation)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.SetPlayerLocation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlayerLocation) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerLocation)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerLocation) when calling interceptor")
+					}
+					return s.Shipgate.SetPlayerLocation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlayerLocation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveClearPlayerLocation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveClearPlayerLocationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveClearPlayerLocationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveClearPlayerLocationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerLocation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ClearPlayerLocationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ClearPlayerLocation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearPlayerLocationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearPlayerLocationRequest) when calling interceptor")
+					}
+					return s.Shipgate.ClearPlayerLocation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ClearPlayerLocation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveClearPlayerLocationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerLocation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ClearPlayerLocationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ClearPlayerLocation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearPlayerLocationRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearPlayerLocationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearPlayerLocationRequest) when calling interceptor")
+					}
+					return s.Shipgate.ClearPlayerLocation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ClearPlayerLocation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPlayerPresence(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPlayerPresenceJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPlayerPresenceProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveSetPlayerPresenceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerPresence")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PlayerPresence)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.SetPlayerPresence
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerPresence)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
+					}
+					return s.Shipgate.SetPlayerPresence(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlayerPresence. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPlayerPresenceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPlayerPresence")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PlayerPresence)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.SetPlayerPresence
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerPresence)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
+					}
+					return s.Shipgate.SetPlayerPresence(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPlayerPresence. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveClearPlayerPresence(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveClearPlayerPresenceJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveClearPlayerPresenceProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveClearPlayerPresenceJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerPresence")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PlayerPresence)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ClearPlayerPresence
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerPresence)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
+					}
+					return s.Shipgate.ClearPlayerPresence(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ClearPlayerPresence. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveClearPlayerPresenceProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearPlayerPresence")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PlayerPresence)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ClearPlayerPresence
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PlayerPresence) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PlayerPresence)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PlayerPresence) when calling interceptor")
+					}
+					return s.Shipgate.ClearPlayerPresence(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ClearPlayerPresence. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveFindPlayer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindPlayerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindPlayerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveFindPlayerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindPlayerRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.FindPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindPlayerRequest) (*FindPlayerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindPlayerRequest) when calling interceptor")
+					}
+					return s.Shipgate.FindPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindPlayerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindPlayerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindPlayerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindPlayerResponse and nil error while calling FindPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveFindPlayerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindPlayerRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.FindPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindPlayerRequest) (*FindPlayerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindPlayerRequest) when calling interceptor")
+					}
+					return s.Shipgate.FindPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindPlayerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindPlayerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindPlayerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindPlayerResponse and nil error while calling FindPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListPlayers(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListPlayersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListPlayersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveListPlayersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListPlayersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ListPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPlayersRequest) (*ListPlayersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPlayersRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPlayersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPlayersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListPlayersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPlayersResponse and nil error while calling ListPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListPlayersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPlayers")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListPlayersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ListPlayers
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPlayersRequest) (*ListPlayersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPlayersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPlayersRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListPlayers(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPlayersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPlayersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListPlayersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPlayersResponse and nil error while calling ListPlayers. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveMessagePlayer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMessagePlayerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMessagePlayerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveMessagePlayerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MessagePlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MessagePlayerRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.MessagePlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MessagePlayerRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MessagePlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MessagePlayerRequest) when calling interceptor")
+					}
+					return s.Shipgate.MessagePlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling MessagePlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveMessagePlayerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MessagePlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MessagePlayerRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.MessagePlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MessagePlayerRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MessagePlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MessagePlayerRequest) when calling interceptor")
+					}
+					return s.Shipgate.MessagePlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling MessagePlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveKickPlayer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveKickPlayerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveKickPlayerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveKickPlayerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KickPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(KickPlayerRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.KickPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KickPlayerRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KickPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KickPlayerRequest) when calling interceptor")
+					}
+					return s.Shipgate.KickPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling KickPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveKickPlayerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "KickPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(KickPlayerRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.KickPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *KickPlayerRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*KickPlayerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*KickPlayerRequest) when calling interceptor")
+					}
+					return s.Shipgate.KickPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling KickPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveScheduleWarp(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveScheduleWarpJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveScheduleWarpProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveScheduleWarpJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleWarp")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WarpRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ScheduleWarp
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WarpRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WarpRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WarpRequest) when calling interceptor")
+					}
+					return s.Shipgate.ScheduleWarp(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScheduleWarp. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveScheduleWarpProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleWarp")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WarpRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ScheduleWarp
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WarpRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WarpRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WarpRequest) when calling interceptor")
+					}
+					return s.Shipgate.ScheduleWarp(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ScheduleWarp. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveAuthenticateAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAuthenticateAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAuthenticateAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveAuthenticateAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AuthenticateAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.AuthenticateAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuthenticateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.AuthenticateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling AuthenticateAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveAuthenticateAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AuthenticateAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.AuthenticateAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuthenticateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.AuthenticateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling AuthenticateAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveIssueSessionToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveIssueSessionTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveIssueSessionTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveIssueSessionTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssueSessionToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IssueSessionTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.IssueSessionToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssueSessionTokenRequest) (*SessionToken, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueSessionTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueSessionTokenRequest) when calling interceptor")
+					}
+					return s.Shipgate.IssueSessionToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SessionToken)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SessionToken) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SessionToken
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SessionToken and nil error while calling IssueSessionToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveIssueSessionTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssueSessionToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IssueSessionTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.IssueSessionToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssueSessionTokenRequest) (*SessionToken, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssueSessionTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssueSessionTokenRequest) when calling interceptor")
+					}
+					return s.Shipgate.IssueSessionToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SessionToken)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SessionToken) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SessionToken
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SessionToken and nil error while calling IssueSessionToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveVerifySessionToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveVerifySessionTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveVerifySessionTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveVerifySessionTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifySessionToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(VerifySessionTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.VerifySessionToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifySessionTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifySessionTokenRequest) when calling interceptor")
+					}
+					return s.Shipgate.VerifySessionToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifySessionTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifySessionTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifySessionTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifySessionTokenResponse and nil error while calling VerifySessionToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveVerifySessionTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifySessionToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(VerifySessionTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.VerifySessionToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifySessionTokenRequest) (*VerifySessionTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifySessionTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifySessionTokenRequest) when calling interceptor")
+					}
+					return s.Shipgate.VerifySessionToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*VerifySessionTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*VerifySessionTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *VerifySessionTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *VerifySessionTokenResponse and nil error while calling VerifySessionToken. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRevokeSessions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRevokeSessionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRevokeSessionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveRevokeSessionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RevokeSessionsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.RevokeSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionsRequest) when calling interceptor")
+					}
+					return s.Shipgate.RevokeSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RevokeSessions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRevokeSessionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeSessions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RevokeSessionsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.RevokeSessions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RevokeSessionsRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeSessionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeSessionsRequest) when calling interceptor")
+					}
+					return s.Shipgate.RevokeSessions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RevokeSessions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveEndSession(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveEndSessionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveEndSessionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveEndSessionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EndSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(EndSessionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.EndSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EndSessionRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EndSessionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EndSessionRequest) when calling interceptor")
+					}
+					return s.Shipgate.EndSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling EndSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveEndSessionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EndSession")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(EndSessionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.EndSession
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EndSessionRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EndSessionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EndSessionRequest) when calling interceptor")
+					}
+					return s.Shipgate.EndSession(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling EndSession. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveBanPlayer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBanPlayerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBanPlayerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveBanPlayerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BanPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(BanRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.BanPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BanRequest) (*Ban, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanRequest) when calling interceptor")
+					}
+					return s.Shipgate.BanPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Ban
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Ban and nil error while calling BanPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveBanPlayerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BanPlayer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(BanRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.BanPlayer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BanRequest) (*Ban, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanRequest) when calling interceptor")
+					}
+					return s.Shipgate.BanPlayer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Ban
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Ban and nil error while calling BanPlayer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListBans(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListBansJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListBansProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveListBansJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListBansRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ListBans
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListBansResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListBansResponse and nil error while calling ListBans. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListBansProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListBans")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListBansRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ListBans
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListBansRequest) (*ListBansResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListBansRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListBansRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListBans(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListBansResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListBansResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListBansResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListBansResponse and nil error while calling ListBans. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveLiftBan(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveLiftBanJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveLiftBanProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveLiftBanJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "LiftBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(LiftBanRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.LiftBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LiftBanRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LiftBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LiftBanRequest) when calling interceptor")
+					}
+					return s.Shipgate.LiftBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling LiftBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveLiftBanProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "LiftBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(LiftBanRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.LiftBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LiftBanRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LiftBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LiftBanRequest) when calling interceptor")
+					}
+					return s.Shipgate.LiftBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling LiftBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveCheckBan(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCheckBanJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCheckBanProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveCheckBanJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CheckBanRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.CheckBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckBanRequest) (*CheckBanResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBanRequest) when calling interceptor")
+					}
+					return s.Shipgate.CheckBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckBanResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckBanResponse and nil error while calling CheckBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveCheckBanProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CheckBan")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CheckBanRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.CheckBan
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckBanRequest) (*CheckBanResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckBanRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckBanRequest) when calling interceptor")
+					}
+					return s.Shipgate.CheckBan(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckBanResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckBanResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckBanResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckBanResponse and nil error while calling CheckBan. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPrivilegeLevel(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPrivilegeLevelJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPrivilegeLevelProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveSetPrivilegeLevelJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPrivilegeLevel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetPrivilegeLevelRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.SetPrivilegeLevel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPrivilegeLevelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPrivilegeLevelRequest) when calling interceptor")
+					}
+					return s.Shipgate.SetPrivilegeLevel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPrivilegeLevel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPrivilegeLevelProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPrivilegeLevel")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetPrivilegeLevelRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.SetPrivilegeLevel
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPrivilegeLevelRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPrivilegeLevelRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPrivilegeLevelRequest) when calling interceptor")
+					}
+					return s.Shipgate.SetPrivilegeLevel(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPrivilegeLevel. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveCreateAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveCreateAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.CreateAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.CreateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling CreateAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveCreateAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.CreateAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.CreateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling CreateAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRegisterAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRegisterAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRegisterAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveRegisterAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RegisterAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.RegisterAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.RegisterAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterAccountResponse and nil error while calling RegisterAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRegisterAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegisterAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RegisterAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.RegisterAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegisterAccountRequest) (*RegisterAccountResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegisterAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegisterAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.RegisterAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegisterAccountResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegisterAccountResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegisterAccountResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegisterAccountResponse and nil error while calling RegisterAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveVerifyEmail(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveVerifyEmailJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveVerifyEmailProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveVerifyEmailJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(VerifyEmailRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.VerifyEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyEmailRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailRequest) when calling interceptor")
+					}
+					return s.Shipgate.VerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling VerifyEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveVerifyEmailProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VerifyEmail")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(VerifyEmailRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.VerifyEmail
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VerifyEmailRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VerifyEmailRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VerifyEmailRequest) when calling interceptor")
+					}
+					return s.Shipgate.VerifyEmail(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling VerifyEmail. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveIssuePasswordReset(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveIssuePasswordResetJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveIssuePasswordResetProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveIssuePasswordResetJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssuePasswordReset")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(IssuePasswordResetRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.IssuePasswordReset
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssuePasswordResetRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssuePasswordResetRequest) when calling interceptor")
+					}
+					return s.Shipgate.IssuePasswordReset(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssuePasswordResetResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssuePasswordResetResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *IssuePasswordResetResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *IssuePasswordResetResponse and nil error while calling IssuePasswordReset. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveIssuePasswordResetProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "IssuePasswordReset")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(IssuePasswordResetRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.IssuePasswordReset
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *IssuePasswordResetRequest) (*IssuePasswordResetResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*IssuePasswordResetRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*IssuePasswordResetRequest) when calling interceptor")
+					}
+					return s.Shipgate.IssuePasswordReset(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*IssuePasswordResetResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*IssuePasswordResetResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *IssuePasswordResetResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *IssuePasswordResetResponse and nil error while calling IssuePasswordReset. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveResetPassword(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveResetPasswordJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveResetPasswordProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveResetPasswordJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ResetPasswordRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ResetPassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResetPasswordRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordRequest) when calling interceptor")
+					}
+					return s.Shipgate.ResetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling ResetPassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveResetPasswordProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResetPassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ResetPasswordRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ResetPassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResetPasswordRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResetPasswordRequest) when calling interceptor")
+					}
+					return s.Shipgate.ResetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling ResetPassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveUpdateAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveUpdateAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.UpdateAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.UpdateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling UpdateAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveUpdateAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.UpdateAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.UpdateAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling UpdateAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPassword(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPasswordJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPasswordProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveSetPasswordJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetPasswordRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.SetPassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPasswordRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPasswordRequest) when calling interceptor")
+					}
+					return s.Shipgate.SetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveSetPasswordProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPassword")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetPasswordRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.SetPassword
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPasswordRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPasswordRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPasswordRequest) when calling interceptor")
+					}
+					return s.Shipgate.SetPassword(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling SetPassword. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveBanAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBanAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBanAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveBanAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BanAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(BanAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.BanAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BanAccountRequest) (*Ban, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.BanAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Ban
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Ban and nil error while calling BanAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveBanAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BanAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(BanAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.BanAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BanAccountRequest) (*Ban, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.BanAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Ban)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Ban) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Ban
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Ban and nil error while calling BanAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListAccounts(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListAccountsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListAccountsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveListAccountsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccounts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListAccountsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ListAccounts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountsRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAccountsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAccountsResponse and nil error while calling ListAccounts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListAccountsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAccounts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListAccountsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ListAccounts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAccountsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAccountsRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListAccounts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAccountsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAccountsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAccountsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAccountsResponse and nil error while calling ListAccounts. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveGetAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveGetAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.GetAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.GetAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling GetAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveGetAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.GetAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAccountRequest) (*archon.Account, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.GetAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*archon.Account)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *archon.Account
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling GetAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveDeleteAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteAccountJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteAccountProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveDeleteAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteAccountRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.DeleteAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.DeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveDeleteAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAccount")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteAccountRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.DeleteAccount
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAccountRequest) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAccountRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAccountRequest) when calling interceptor")
+					}
+					return s.Shipgate.DeleteAccount(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteAccount. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRecordAuditEntry(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRecordAuditEntryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRecordAuditEntryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveRecordAuditEntryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAuditEntry")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AuditEntry)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.RecordAuditEntry
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuditEntry) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditEntry)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditEntry) when calling interceptor")
+					}
+					return s.Shipgate.RecordAuditEntry(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RecordAuditEntry. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveRecordAuditEntryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAuditEntry")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AuditEntry)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.RecordAuditEntry
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AuditEntry) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditEntry)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditEntry) when calling interceptor")
+					}
+					return s.Shipgate.RecordAuditEntry(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RecordAuditEntry. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveReportViolation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReportViolationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReportViolationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveReportViolationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReportViolation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(Violation)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ReportViolation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Violation) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Violation)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Violation) when calling interceptor")
+					}
+					return s.Shipgate.ReportViolation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ReportViolation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveReportViolationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReportViolation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(Violation)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ReportViolation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *Violation) (*google_protobuf.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*Violation)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*Violation) when calling interceptor")
+					}
+					return s.Shipgate.ReportViolation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling ReportViolation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListViolations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListViolationsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListViolationsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveListViolationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListViolations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListViolationsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.ListViolations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListViolationsRequest) (*ListViolationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListViolationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListViolationsRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListViolations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListViolationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListViolationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListViolationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListViolationsResponse and nil error while calling ListViolations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveListViolationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListViolations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListViolationsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.ListViolations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListViolationsRequest) (*ListViolationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListViolationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListViolationsRequest) when calling interceptor")
+					}
+					return s.Shipgate.ListViolations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListViolationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListViolationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListViolationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListViolationsResponse and nil error while calling ListViolations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveFindCharacter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindCharacterJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindCharacterProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveFindCharacterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CharacterRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.FindCharacter
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+					}
+					return s.Shipgate.FindCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindCharacterResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindCharacterResponse and nil error while calling FindCharacter. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveFindCharacterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CharacterRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.FindCharacter
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CharacterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+					}
+					return s.Shipgate.FindCharacter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindCharacterResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindCharacterResponse and nil error while calling FindCharacter. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveFindCharacterProfiles(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveFindCharacterProfilesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveFindCharacterProfilesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *shipgateServer) serveFindCharacterProfilesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacterProfiles")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(FindCharacterProfilesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Shipgate.FindCharacterProfiles
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindCharacterProfilesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindCharacterProfilesRequest) when calling interceptor")
+					}
+					return s.Shipgate.FindCharacterProfiles(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterProfilesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterProfilesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindCharacterProfilesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindCharacterProfilesResponse and nil error while calling FindCharacterProfiles. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *shipgateServer) serveFindCharacterProfilesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "FindCharacterProfiles")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(FindCharacterProfilesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Shipgate.FindCharacterProfiles
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *FindCharacterProfilesRequest) (*FindCharacterProfilesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*FindCharacterProfilesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*FindCharacterProfilesRequest) when calling interceptor")
+					}
+					return s.Shipgate.FindCharacterProfiles(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*FindCharacterProfilesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterProfilesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *FindCharacterProfilesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindCharacterProfilesResponse and nil error while calling FindCharacterProfiles. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveGetActiveShips(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveEditCharacter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1143,9 +12659,9 @@ func (s *shipgateServer) serveGetActiveShips(ctx context.Context, resp http.Resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetActiveShipsJSON(ctx, resp, req)
+		s.serveEditCharacterJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetActiveShipsProtobuf(ctx, resp, req)
+		s.serveEditCharacterProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1153,9 +12669,9 @@ func (s *shipgateServer) serveGetActiveShips(ctx context.Context, resp http.Resp
 	}
 }
 
-func (s *shipgateServer) serveGetActiveShipsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveEditCharacterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetActiveShips")
+	ctx = ctxsetters.WithMethodName(ctx, "EditCharacter")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1168,29 +12684,29 @@ func (s *shipgateServer) serveGetActiveShipsJSON(ctx context.Context, resp http.
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(EditCharacterRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Shipgate.GetActiveShips
+	handler := s.Shipgate.EditCharacter
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ShipList, error) {
+		handler = func(ctx context.Context, req *EditCharacterRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*EditCharacterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*EditCharacterRequest) when calling interceptor")
 					}
-					return s.Shipgate.GetActiveShips(ctx, typedReq)
+					return s.Shipgate.EditCharacter(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ShipList)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ShipList) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1199,7 +12715,7 @@ func (s *shipgateServer) serveGetActiveShipsJSON(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *ShipList
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1210,7 +12726,7 @@ func (s *shipgateServer) serveGetActiveShipsJSON(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ShipList and nil error while calling GetActiveShips. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling EditCharacter. nil responses are not supported"))
 		return
 	}
 
@@ -1236,9 +12752,9 @@ func (s *shipgateServer) serveGetActiveShipsJSON(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveGetActiveShipsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveEditCharacterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetActiveShips")
+	ctx = ctxsetters.WithMethodName(ctx, "EditCharacter")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1250,28 +12766,28 @@ func (s *shipgateServer) serveGetActiveShipsProtobuf(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf.Empty)
+	reqContent := new(EditCharacterRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Shipgate.GetActiveShips
+	handler := s.Shipgate.EditCharacter
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf.Empty) (*ShipList, error) {
+		handler = func(ctx context.Context, req *EditCharacterRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf.Empty)
+					typedReq, ok := req.(*EditCharacterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*EditCharacterRequest) when calling interceptor")
 					}
-					return s.Shipgate.GetActiveShips(ctx, typedReq)
+					return s.Shipgate.EditCharacter(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ShipList)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ShipList) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1280,7 +12796,7 @@ func (s *shipgateServer) serveGetActiveShipsProtobuf(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *ShipList
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1291,7 +12807,7 @@ func (s *shipgateServer) serveGetActiveShipsProtobuf(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ShipList and nil error while calling GetActiveShips. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling EditCharacter. nil responses are not supported"))
 		return
 	}
 
@@ -1315,7 +12831,7 @@ func (s *shipgateServer) serveGetActiveShipsProtobuf(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveRegisterShip(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpsertCharacter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1323,9 +12839,9 @@ func (s *shipgateServer) serveRegisterShip(ctx context.Context, resp http.Respon
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRegisterShipJSON(ctx, resp, req)
+		s.serveUpsertCharacterJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRegisterShipProtobuf(ctx, resp, req)
+		s.serveUpsertCharacterProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1333,9 +12849,9 @@ func (s *shipgateServer) serveRegisterShip(ctx context.Context, resp http.Respon
 	}
 }
 
-func (s *shipgateServer) serveRegisterShipJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpsertCharacterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RegisterShip")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1348,23 +12864,23 @@ func (s *shipgateServer) serveRegisterShipJSON(ctx context.Context, resp http.Re
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(RegisterShipRequest)
+	reqContent := new(UpsertCharacterRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Shipgate.RegisterShip
+	handler := s.Shipgate.UpsertCharacter
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RegisterShipRequest) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RegisterShipRequest)
+					typedReq, ok := req.(*UpsertCharacterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RegisterShipRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
 					}
-					return s.Shipgate.RegisterShip(ctx, typedReq)
+					return s.Shipgate.UpsertCharacter(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1390,7 +12906,7 @@ func (s *shipgateServer) serveRegisterShipJSON(ctx context.Context, resp http.Re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RegisterShip. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpsertCharacter. nil responses are not supported"))
 		return
 	}
 
@@ -1416,9 +12932,9 @@ func (s *shipgateServer) serveRegisterShipJSON(ctx context.Context, resp http.Re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveRegisterShipProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpsertCharacterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RegisterShip")
+	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1430,22 +12946,22 @@ func (s *shipgateServer) serveRegisterShipProtobuf(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(RegisterShipRequest)
+	reqContent := new(UpsertCharacterRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Shipgate.RegisterShip
+	handler := s.Shipgate.UpsertCharacter
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RegisterShipRequest) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RegisterShipRequest)
+					typedReq, ok := req.(*UpsertCharacterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RegisterShipRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
 					}
-					return s.Shipgate.RegisterShip(ctx, typedReq)
+					return s.Shipgate.UpsertCharacter(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1471,7 +12987,7 @@ func (s *shipgateServer) serveRegisterShipProtobuf(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling RegisterShip. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpsertCharacter. nil responses are not supported"))
 		return
 	}
 
@@ -1495,7 +13011,7 @@ func (s *shipgateServer) serveRegisterShipProtobuf(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveAuthenticateAccount(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveDeleteCharacter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1503,9 +13019,9 @@ func (s *shipgateServer) serveAuthenticateAccount(ctx context.Context, resp http
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAuthenticateAccountJSON(ctx, resp, req)
+		s.serveDeleteCharacterJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAuthenticateAccountProtobuf(ctx, resp, req)
+		s.serveDeleteCharacterProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1513,9 +13029,9 @@ func (s *shipgateServer) serveAuthenticateAccount(ctx context.Context, resp http
 	}
 }
 
-func (s *shipgateServer) serveAuthenticateAccountJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveDeleteCharacterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1528,29 +13044,29 @@ func (s *shipgateServer) serveAuthenticateAccountJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(AuthenticateAccountRequest)
+	reqContent := new(CharacterRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Shipgate.AuthenticateAccount
+	handler := s.Shipgate.DeleteCharacter
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
+		handler = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AuthenticateAccountRequest)
+					typedReq, ok := req.(*CharacterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
 					}
-					return s.Shipgate.AuthenticateAccount(ctx, typedReq)
+					return s.Shipgate.DeleteCharacter(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*archon.Account)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1559,7 +13075,7 @@ func (s *shipgateServer) serveAuthenticateAccountJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *archon.Account
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1570,7 +13086,7 @@ func (s *shipgateServer) serveAuthenticateAccountJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling AuthenticateAccount. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteCharacter. nil responses are not supported"))
 		return
 	}
 
@@ -1596,9 +13112,9 @@ func (s *shipgateServer) serveAuthenticateAccountJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveAuthenticateAccountProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveDeleteCharacterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AuthenticateAccount")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1610,28 +13126,28 @@ func (s *shipgateServer) serveAuthenticateAccountProtobuf(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(AuthenticateAccountRequest)
+	reqContent := new(CharacterRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Shipgate.AuthenticateAccount
+	handler := s.Shipgate.DeleteCharacter
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AuthenticateAccountRequest) (*archon.Account, error) {
+		handler = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AuthenticateAccountRequest)
+					typedReq, ok := req.(*CharacterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AuthenticateAccountRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
 					}
-					return s.Shipgate.AuthenticateAccount(ctx, typedReq)
+					return s.Shipgate.DeleteCharacter(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*archon.Account)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*archon.Account) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1640,7 +13156,7 @@ func (s *shipgateServer) serveAuthenticateAccountProtobuf(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *archon.Account
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1651,7 +13167,7 @@ func (s *shipgateServer) serveAuthenticateAccountProtobuf(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *archon.Account and nil error while calling AuthenticateAccount. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteCharacter. nil responses are not supported"))
 		return
 	}
 
@@ -1675,7 +13191,7 @@ func (s *shipgateServer) serveAuthenticateAccountProtobuf(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveFindCharacter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateCharacterStats(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1683,9 +13199,9 @@ func (s *shipgateServer) serveFindCharacter(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveFindCharacterJSON(ctx, resp, req)
+		s.serveUpdateCharacterStatsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveFindCharacterProtobuf(ctx, resp, req)
+		s.serveUpdateCharacterStatsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1693,9 +13209,9 @@ func (s *shipgateServer) serveFindCharacter(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *shipgateServer) serveFindCharacterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateCharacterStatsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateCharacterStats")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1708,29 +13224,29 @@ func (s *shipgateServer) serveFindCharacterJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CharacterRequest)
+	reqContent := new(UpdateCharacterStatsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Shipgate.FindCharacter
+	handler := s.Shipgate.UpdateCharacterStats
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+		handler = func(ctx context.Context, req *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*UpdateCharacterStatsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateCharacterStatsRequest) when calling interceptor")
 					}
-					return s.Shipgate.FindCharacter(ctx, typedReq)
+					return s.Shipgate.UpdateCharacterStats(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindCharacterResponse)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1739,7 +13255,7 @@ func (s *shipgateServer) serveFindCharacterJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *FindCharacterResponse
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1750,7 +13266,7 @@ func (s *shipgateServer) serveFindCharacterJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindCharacterResponse and nil error while calling FindCharacter. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpdateCharacterStats. nil responses are not supported"))
 		return
 	}
 
@@ -1776,9 +13292,9 @@ func (s *shipgateServer) serveFindCharacterJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveFindCharacterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateCharacterStatsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "FindCharacter")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateCharacterStats")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1790,28 +13306,28 @@ func (s *shipgateServer) serveFindCharacterProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CharacterRequest)
+	reqContent := new(UpdateCharacterStatsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Shipgate.FindCharacter
+	handler := s.Shipgate.UpdateCharacterStats
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CharacterRequest) (*FindCharacterResponse, error) {
+		handler = func(ctx context.Context, req *UpdateCharacterStatsRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*UpdateCharacterStatsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateCharacterStatsRequest) when calling interceptor")
 					}
-					return s.Shipgate.FindCharacter(ctx, typedReq)
+					return s.Shipgate.UpdateCharacterStats(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*FindCharacterResponse)
+				typedResp, ok := resp.(*google_protobuf.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*FindCharacterResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1820,7 +13336,7 @@ func (s *shipgateServer) serveFindCharacterProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *FindCharacterResponse
+	var respContent *google_protobuf.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1831,7 +13347,7 @@ func (s *shipgateServer) serveFindCharacterProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *FindCharacterResponse and nil error while calling FindCharacter. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpdateCharacterStats. nil responses are not supported"))
 		return
 	}
 
@@ -1855,7 +13371,7 @@ func (s *shipgateServer) serveFindCharacterProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveUpsertCharacter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateInfoBoard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1863,9 +13379,9 @@ func (s *shipgateServer) serveUpsertCharacter(ctx context.Context, resp http.Res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveUpsertCharacterJSON(ctx, resp, req)
+		s.serveUpdateInfoBoardJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveUpsertCharacterProtobuf(ctx, resp, req)
+		s.serveUpdateInfoBoardProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1873,9 +13389,9 @@ func (s *shipgateServer) serveUpsertCharacter(ctx context.Context, resp http.Res
 	}
 }
 
-func (s *shipgateServer) serveUpsertCharacterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateInfoBoardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateInfoBoard")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1888,23 +13404,23 @@ func (s *shipgateServer) serveUpsertCharacterJSON(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(UpsertCharacterRequest)
+	reqContent := new(UpdateInfoBoardRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Shipgate.UpsertCharacter
+	handler := s.Shipgate.UpdateInfoBoard
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpsertCharacterRequest)
+					typedReq, ok := req.(*UpdateInfoBoardRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateInfoBoardRequest) when calling interceptor")
 					}
-					return s.Shipgate.UpsertCharacter(ctx, typedReq)
+					return s.Shipgate.UpdateInfoBoard(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1930,7 +13446,7 @@ func (s *shipgateServer) serveUpsertCharacterJSON(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpsertCharacter. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpdateInfoBoard. nil responses are not supported"))
 		return
 	}
 
@@ -1956,9 +13472,9 @@ func (s *shipgateServer) serveUpsertCharacterJSON(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveUpsertCharacterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateInfoBoardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpsertCharacter")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateInfoBoard")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1970,22 +13486,22 @@ func (s *shipgateServer) serveUpsertCharacterProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(UpsertCharacterRequest)
+	reqContent := new(UpdateInfoBoardRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Shipgate.UpsertCharacter
+	handler := s.Shipgate.UpdateInfoBoard
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpsertCharacterRequest) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpdateInfoBoardRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpsertCharacterRequest)
+					typedReq, ok := req.(*UpdateInfoBoardRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpsertCharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateInfoBoardRequest) when calling interceptor")
 					}
-					return s.Shipgate.UpsertCharacter(ctx, typedReq)
+					return s.Shipgate.UpdateInfoBoard(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2011,7 +13527,7 @@ func (s *shipgateServer) serveUpsertCharacterProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpsertCharacter. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpdateInfoBoard. nil responses are not supported"))
 		return
 	}
 
@@ -2035,7 +13551,7 @@ func (s *shipgateServer) serveUpsertCharacterProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveDeleteCharacter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateChallengeRecords(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2043,9 +13559,9 @@ func (s *shipgateServer) serveDeleteCharacter(ctx context.Context, resp http.Res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveDeleteCharacterJSON(ctx, resp, req)
+		s.serveUpdateChallengeRecordsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveDeleteCharacterProtobuf(ctx, resp, req)
+		s.serveUpdateChallengeRecordsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2053,9 +13569,9 @@ func (s *shipgateServer) serveDeleteCharacter(ctx context.Context, resp http.Res
 	}
 }
 
-func (s *shipgateServer) serveDeleteCharacterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateChallengeRecordsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateChallengeRecords")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2068,23 +13584,23 @@ func (s *shipgateServer) serveDeleteCharacterJSON(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CharacterRequest)
+	reqContent := new(UpdateChallengeRecordsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Shipgate.DeleteCharacter
+	handler := s.Shipgate.UpdateChallengeRecords
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*UpdateChallengeRecordsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateChallengeRecordsRequest) when calling interceptor")
 					}
-					return s.Shipgate.DeleteCharacter(ctx, typedReq)
+					return s.Shipgate.UpdateChallengeRecords(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2110,7 +13626,7 @@ func (s *shipgateServer) serveDeleteCharacterJSON(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteCharacter. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpdateChallengeRecords. nil responses are not supported"))
 		return
 	}
 
@@ -2136,9 +13652,9 @@ func (s *shipgateServer) serveDeleteCharacterJSON(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *shipgateServer) serveDeleteCharacterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *shipgateServer) serveUpdateChallengeRecordsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteCharacter")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateChallengeRecords")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2150,22 +13666,22 @@ func (s *shipgateServer) serveDeleteCharacterProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CharacterRequest)
+	reqContent := new(UpdateChallengeRecordsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Shipgate.DeleteCharacter
+	handler := s.Shipgate.UpdateChallengeRecords
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CharacterRequest) (*google_protobuf.Empty, error) {
+		handler = func(ctx context.Context, req *UpdateChallengeRecordsRequest) (*google_protobuf.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CharacterRequest)
+					typedReq, ok := req.(*UpdateChallengeRecordsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CharacterRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateChallengeRecordsRequest) when calling interceptor")
 					}
-					return s.Shipgate.DeleteCharacter(ctx, typedReq)
+					return s.Shipgate.UpdateChallengeRecords(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -2191,7 +13707,7 @@ func (s *shipgateServer) serveDeleteCharacterProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling DeleteCharacter. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf.Empty and nil error while calling UpdateChallengeRecords. nil responses are not supported"))
 		return
 	}
 
@@ -2893,9 +14409,12 @@ func sanitizeBaseURL(baseURL string) string {
 
 // baseServicePath composes the path prefix for the service (without <Method>).
 // e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
-//       returns => "/twirp/my.pkg.MyService/"
+//
+//	returns => "/twirp/my.pkg.MyService/"
+//
 // e.g.: baseServicePath("", "", "MyService")
-//       returns => "/MyService/"
+//
+//	returns => "/MyService/"
 func baseServicePath(prefix, pkg, service string) string {
 	fullServiceName := service
 	if pkg != "" {
@@ -3333,45 +14852,188 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x6f, 0xd3, 0x3e,
-	0x14, 0x55, 0x7f, 0xfb, 0x7f, 0xf7, 0xf7, 0xe7, 0x6a, 0x25, 0xca, 0x34, 0x56, 0x85, 0x97, 0x3d,
-	0xa0, 0x04, 0x8d, 0x17, 0x04, 0xbc, 0x8c, 0x31, 0xca, 0x10, 0xd2, 0x20, 0xb0, 0x17, 0x1e, 0x18,
-	0x5e, 0x72, 0x69, 0x2c, 0xb5, 0xb1, 0xb1, 0x1d, 0x68, 0x3f, 0x1b, 0x5f, 0x0e, 0x25, 0xb6, 0xdb,
-	0x6e, 0x4b, 0xa0, 0x83, 0x37, 0xfb, 0xde, 0x73, 0x8f, 0x4f, 0x6e, 0xee, 0xb1, 0xa1, 0xcb, 0x72,
-	0x8d, 0x32, 0xa7, 0x83, 0x48, 0x65, 0x4c, 0xf4, 0xa9, 0xc6, 0xc9, 0x22, 0x14, 0x92, 0x6b, 0x4e,
-	0x96, 0xa9, 0x4c, 0x32, 0x9e, 0xfb, 0x53, 0x64, 0xc2, 0x25, 0x46, 0x55, 0x32, 0x32, 0x39, 0x83,
-	0xf4, 0xf7, 0xfa, 0x9c, 0xf7, 0x07, 0x36, 0x75, 0x55, 0x7c, 0x8d, 0x70, 0x28, 0xf4, 0xd8, 0x24,
-	0x83, 0x10, 0x56, 0x3f, 0x64, 0x4c, 0xbc, 0x65, 0x4a, 0x93, 0x00, 0x96, 0xca, 0x43, 0x94, 0xd7,
-	0xea, 0x2e, 0x1c, 0xae, 0x1f, 0x6d, 0x84, 0x96, 0xa6, 0x04, 0xc4, 0x26, 0x15, 0x8c, 0xa0, 0x1d,
-	0x63, 0x9f, 0x29, 0x8d, 0xb2, 0x0a, 0xe3, 0xb7, 0x02, 0x95, 0x26, 0x04, 0x16, 0x73, 0x3a, 0x44,
-	0xaf, 0xd5, 0x6d, 0x1d, 0xae, 0xc5, 0xd5, 0x9a, 0x78, 0xb0, 0x42, 0xd3, 0x54, 0xa2, 0x52, 0xde,
-	0x7f, 0x55, 0xd8, 0x6d, 0x4b, 0xb4, 0xe0, 0x52, 0x7b, 0x0b, 0x06, 0x5d, 0xae, 0xc9, 0x01, 0xac,
-	0x0f, 0xe9, 0xe8, 0x52, 0x0c, 0xe8, 0x18, 0xa5, 0xf2, 0x16, 0xbb, 0xad, 0xc3, 0xa5, 0x18, 0x86,
-	0x74, 0xf4, 0xce, 0x44, 0x82, 0x8f, 0xe0, 0x1f, 0x17, 0x3a, 0xc3, 0x5c, 0xb3, 0x84, 0x6a, 0x3c,
-	0x4e, 0x12, 0x5e, 0xe4, 0xda, 0x09, 0xf0, 0x61, 0xb5, 0x50, 0x65, 0x1f, 0x26, 0x22, 0x26, 0xfb,
-	0x32, 0x27, 0xa8, 0x52, 0x3f, 0xb8, 0x4c, 0xad, 0x92, 0xc9, 0x3e, 0x38, 0x85, 0x9d, 0x93, 0x8c,
-	0x4a, 0x9a, 0x68, 0x94, 0x8e, 0x6b, 0x1f, 0x80, 0x1a, 0xf6, 0x4b, 0x96, 0x56, 0x6c, 0x8b, 0xf1,
-	0x9a, 0x8d, 0x9c, 0xa5, 0xa5, 0x7a, 0x35, 0xe0, 0xba, 0xa2, 0xda, 0x8c, 0xab, 0x75, 0xf0, 0x05,
-	0x76, 0x5f, 0xb1, 0x3c, 0x9d, 0xa1, 0x52, 0x82, 0xe7, 0x0a, 0x49, 0x07, 0x96, 0x71, 0xc4, 0x94,
-	0x56, 0x15, 0xcf, 0x6a, 0x6c, 0x77, 0x24, 0x82, 0xb5, 0xc4, 0x81, 0x2b, 0xa6, 0xf5, 0xa3, 0xff,
-	0x5d, 0xbf, 0xa7, 0x2c, 0x53, 0x4c, 0x90, 0x41, 0xe7, 0x42, 0x28, 0x94, 0xfa, 0xae, 0x72, 0xef,
-	0x7c, 0xd2, 0x33, 0xf0, 0x7b, 0xa8, 0x7b, 0x05, 0x1b, 0xa4, 0x09, 0x95, 0xe9, 0x69, 0xae, 0x25,
-	0x43, 0x35, 0xdf, 0x69, 0xc1, 0x39, 0xec, 0xd5, 0x16, 0xdb, 0x76, 0x3c, 0x82, 0x15, 0x34, 0x21,
-	0x3b, 0x64, 0x1d, 0x27, 0xe5, 0x5a, 0xc9, 0x38, 0x76, 0xb0, 0xe0, 0x09, 0xdc, 0xeb, 0xa1, 0x36,
-	0x43, 0x70, 0x2e, 0x34, 0xe3, 0xf9, 0xbc, 0x52, 0x04, 0x78, 0xb7, 0x2b, 0xff, 0xf0, 0x5b, 0x9e,
-	0xc3, 0x96, 0x99, 0xc0, 0x4b, 0x6e, 0x2a, 0x6c, 0xc7, 0x76, 0x9d, 0xcc, 0xeb, 0x74, 0x9b, 0x62,
-	0x76, 0x1b, 0x8c, 0xc1, 0x37, 0xff, 0xe8, 0x2f, 0xe4, 0xfe, 0xdb, 0xd1, 0x47, 0x3f, 0x97, 0x8c,
-	0x91, 0xcb, 0x1b, 0x82, 0x3c, 0x85, 0xad, 0x1e, 0xea, 0xe3, 0x44, 0xb3, 0xef, 0x58, 0x06, 0x15,
-	0xe9, 0x84, 0xe6, 0x12, 0x08, 0xdd, 0x25, 0x10, 0x9e, 0x96, 0x97, 0x80, 0xbf, 0x33, 0xeb, 0xf1,
-	0xea, 0x12, 0x38, 0x81, 0x8d, 0x59, 0x83, 0x93, 0x3d, 0x87, 0xa8, 0xb1, 0xbd, 0xdf, 0x40, 0x4b,
-	0xde, 0x40, 0xbb, 0xc6, 0xab, 0x24, 0x70, 0x5c, 0xcd, 0x46, 0xf6, 0xb7, 0x27, 0x18, 0x5b, 0xf4,
-	0x1a, 0x36, 0xaf, 0x59, 0x8b, 0x78, 0xb7, 0xa7, 0xd7, 0xd6, 0xee, 0xbb, 0x4c, 0xbd, 0x17, 0xcf,
-	0x60, 0xfb, 0x86, 0x85, 0xc8, 0x7d, 0x57, 0x51, 0xef, 0xad, 0xc6, 0x0f, 0x3c, 0x81, 0xed, 0x97,
-	0x38, 0x40, 0x8d, 0xf3, 0xc8, 0x6a, 0x22, 0xf9, 0x0c, 0xed, 0x1a, 0xaf, 0x4c, 0xbb, 0xd4, 0xec,
-	0x42, 0xff, 0xc1, 0x6f, 0x31, 0xf6, 0x7b, 0x2f, 0x60, 0xe7, 0xa6, 0x01, 0xc8, 0xc1, 0x4c, 0x61,
-	0xdd, 0x94, 0xfa, 0xdd, 0x66, 0x80, 0xa5, 0x7d, 0x0f, 0xed, 0x9a, 0x29, 0x9f, 0xca, 0x6e, 0xb6,
-	0x40, 0x53, 0x27, 0x5e, 0x84, 0x9f, 0x1e, 0xf6, 0x99, 0xce, 0x8a, 0xab, 0x30, 0xe1, 0xc3, 0x28,
-	0x4d, 0x24, 0x4f, 0x87, 0x34, 0xb7, 0xcf, 0x58, 0x74, 0xeb, 0x2d, 0xbc, 0x5a, 0xae, 0xea, 0x1f,
-	0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x29, 0x17, 0x4d, 0xee, 0x27, 0x07, 0x00, 0x00,
+	// 2924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x1a, 0xcb, 0x6e, 0x1c, 0xc7,
+	0xd1, 0xcb, 0xdd, 0x25, 0x77, 0x8b, 0xbb, 0x7c, 0x34, 0x29, 0x6a, 0x35, 0x92, 0x2c, 0xb9, 0x65,
+	0xc1, 0x72, 0xec, 0x90, 0xb1, 0xec, 0x83, 0x9f, 0x32, 0x48, 0x86, 0x26, 0x19, 0xcb, 0x31, 0x3d,
+	0xb4, 0x2c, 0x20, 0x4a, 0xbc, 0xee, 0xdd, 0x69, 0x92, 0x13, 0x0e, 0x67, 0xc6, 0x33, 0xbd, 0x6b,
+	0x32, 0x40, 0x0e, 0xb9, 0x04, 0xc8, 0xc5, 0x40, 0x90, 0x20, 0x08, 0x90, 0x6b, 0x0e, 0xf9, 0x8d,
+	0xe4, 0x0f, 0xf2, 0x03, 0xf9, 0x88, 0x7c, 0x41, 0xd0, 0xaf, 0x99, 0x9e, 0xd7, 0xf2, 0x61, 0x29,
+	0xb7, 0xe9, 0xea, 0xea, 0xea, 0x7a, 0x75, 0x55, 0x4f, 0x55, 0xc3, 0x5d, 0xd7, 0x67, 0x34, 0xf2,
+	0x89, 0xb7, 0x16, 0x1f, 0xb9, 0xe1, 0x21, 0x61, 0x34, 0xf9, 0x58, 0x0d, 0xa3, 0x80, 0x05, 0x68,
+	0x9a, 0x44, 0xc3, 0xa3, 0xc0, 0xb7, 0x52, 0xcc, 0x61, 0x10, 0xd1, 0x35, 0x31, 0xb9, 0x26, 0xe7,
+	0x24, 0xa6, 0x75, 0xf3, 0x30, 0x08, 0x0e, 0x3d, 0x35, 0x35, 0x18, 0x1d, 0xac, 0xd1, 0x93, 0x90,
+	0x9d, 0xc9, 0x49, 0xbc, 0x0a, 0xad, 0xfd, 0x23, 0x37, 0x7c, 0xec, 0xc6, 0x0c, 0x61, 0x68, 0xf2,
+	0x4d, 0xe2, 0x5e, 0xed, 0x6e, 0xfd, 0xc1, 0xec, 0xc3, 0xce, 0xaa, 0x22, 0xc3, 0x11, 0x6c, 0x39,
+	0x85, 0x4f, 0x61, 0xc9, 0xa6, 0x87, 0x6e, 0xcc, 0x68, 0x24, 0xc0, 0xf4, 0xdb, 0x11, 0x8d, 0x19,
+	0x42, 0xd0, 0xf0, 0xc9, 0x09, 0xed, 0xd5, 0xee, 0xd6, 0x1e, 0xb4, 0x6d, 0xf1, 0x8d, 0x7a, 0x30,
+	0x43, 0x1c, 0x27, 0xa2, 0x71, 0xdc, 0x9b, 0x12, 0x60, 0x3d, 0xe4, 0xd8, 0x61, 0x10, 0xb1, 0x5e,
+	0x5d, 0x62, 0xf3, 0x6f, 0x74, 0x07, 0x66, 0x4f, 0xc8, 0x69, 0x3f, 0xf4, 0xc8, 0x19, 0x8d, 0xe2,
+	0x5e, 0xe3, 0x6e, 0xed, 0x41, 0xd3, 0x86, 0x13, 0x72, 0xba, 0x27, 0x21, 0xf8, 0x4b, 0x98, 0xdd,
+	0xf0, 0x82, 0xe1, 0xf1, 0x3e, 0x23, 0x6c, 0x14, 0xa3, 0x39, 0x98, 0x72, 0x1d, 0xb1, 0x5f, 0xd3,
+	0x9e, 0x72, 0x9d, 0x84, 0x83, 0x29, 0x83, 0x83, 0x57, 0xa0, 0x23, 0xe9, 0xf5, 0x87, 0xc1, 0xc8,
+	0x97, 0xfb, 0x35, 0xed, 0x59, 0x09, 0xdb, 0xe4, 0x20, 0xfc, 0x14, 0x96, 0xb9, 0x1c, 0x3b, 0x94,
+	0x44, 0x6c, 0x40, 0x09, 0x9b, 0x24, 0xd0, 0x1b, 0x30, 0x3d, 0xe0, 0x1c, 0x70, 0x79, 0xb8, 0x82,
+	0x96, 0xb4, 0x82, 0x0c, 0xbe, 0x6c, 0x85, 0x82, 0x9f, 0x01, 0x70, 0xc2, 0x8a, 0xdb, 0xbb, 0xd0,
+	0xe0, 0xfa, 0x13, 0xe4, 0xf2, 0x9a, 0x15, 0x33, 0x97, 0x23, 0xfe, 0x14, 0x3a, 0xfb, 0x34, 0x1a,
+	0xd3, 0x48, 0x91, 0xbf, 0x0d, 0x10, 0x33, 0x12, 0x31, 0xea, 0xf4, 0x09, 0x13, 0x9b, 0xd4, 0xed,
+	0xb6, 0x82, 0xac, 0x33, 0xf4, 0x40, 0x1b, 0x56, 0x92, 0x46, 0xe6, 0xf6, 0x8a, 0xb2, 0x32, 0xef,
+	0x9f, 0x6b, 0x60, 0xad, 0x8f, 0xd8, 0x11, 0xf5, 0x99, 0x3b, 0x24, 0x8c, 0xae, 0x0f, 0x85, 0xe6,
+	0xb4, 0x56, 0x2c, 0x68, 0x8d, 0x62, 0x1a, 0x19, 0x9a, 0x49, 0xc6, 0x7c, 0x2e, 0x24, 0x71, 0xfc,
+	0x5d, 0x10, 0x39, 0xca, 0x08, 0xc9, 0x98, 0xf3, 0xe7, 0x86, 0x7d, 0xed, 0x0d, 0xd2, 0xec, 0x6d,
+	0x37, 0x5c, 0x97, 0x00, 0x6e, 0xfb, 0x23, 0x12, 0x39, 0xdf, 0x91, 0x88, 0xf6, 0x5d, 0x47, 0xd8,
+	0xbe, 0x6d, 0x83, 0x06, 0xed, 0x3a, 0xf8, 0x21, 0xdc, 0xfa, 0xc4, 0xf5, 0x9d, 0xcd, 0x23, 0x12,
+	0x91, 0x21, 0xa3, 0xd1, 0x5e, 0x14, 0x1c, 0xb8, 0x1e, 0x8d, 0x27, 0x58, 0x0b, 0x7f, 0x0b, 0x0b,
+	0x79, 0xfc, 0x52, 0xab, 0x2e, 0x43, 0x73, 0xe8, 0x11, 0xe5, 0xa4, 0x4d, 0x5b, 0x0e, 0x38, 0xd4,
+	0xa3, 0x63, 0xea, 0x09, 0x66, 0xbb, 0xb6, 0x1c, 0x08, 0x3d, 0xd3, 0x21, 0x73, 0x03, 0x5f, 0xf3,
+	0xd9, 0xb4, 0xdb, 0x0a, 0xb2, 0xeb, 0xe0, 0x27, 0x70, 0xbb, 0x82, 0xcd, 0x38, 0x0c, 0xfc, 0x98,
+	0xa2, 0x77, 0xa0, 0x15, 0x2a, 0x98, 0x3a, 0x64, 0x3d, 0x6d, 0x8b, 0xfc, 0x22, 0x3b, 0xc1, 0xc4,
+	0x5b, 0x86, 0x24, 0x5a, 0xe2, 0xdb, 0x00, 0x44, 0xda, 0xa6, 0xaf, 0x8e, 0x41, 0xc3, 0x6e, 0x2b,
+	0xc8, 0xae, 0x38, 0x0d, 0xb1, 0x17, 0x30, 0x21, 0x53, 0xd7, 0x16, 0xdf, 0xf8, 0x1b, 0xb8, 0x96,
+	0xe1, 0x2e, 0xe1, 0x6a, 0x05, 0xa6, 0xe9, 0xa9, 0x1b, 0xb3, 0x58, 0xd0, 0x69, 0xd9, 0x6a, 0x84,
+	0xd6, 0xa0, 0x3d, 0xd4, 0xc8, 0x82, 0xd2, 0xec, 0xc3, 0xc5, 0x02, 0xbb, 0x76, 0x8a, 0x83, 0xff,
+	0x59, 0x83, 0xe5, 0x2d, 0xc7, 0x65, 0x05, 0x6e, 0x1f, 0xc0, 0x02, 0x19, 0xb2, 0x20, 0xea, 0x17,
+	0x78, 0x9e, 0x13, 0xf0, 0xf5, 0x84, 0xf1, 0xac, 0x5c, 0x53, 0x55, 0x72, 0xd5, 0x53, 0xb9, 0x52,
+	0x53, 0x35, 0x4c, 0x53, 0xbd, 0x0c, 0x40, 0x4f, 0x43, 0x1a, 0xb9, 0xd4, 0x1f, 0xd2, 0x5e, 0x53,
+	0x4c, 0x19, 0x10, 0x2e, 0xf4, 0x09, 0x8d, 0x29, 0x23, 0xbd, 0x69, 0x31, 0xa7, 0x46, 0xf8, 0x08,
+	0x56, 0x9e, 0x84, 0x31, 0x8d, 0xd8, 0x65, 0x55, 0x7e, 0x69, 0x6d, 0xfd, 0xae, 0x06, 0x37, 0x9f,
+	0x84, 0x0e, 0x61, 0x34, 0x99, 0xe6, 0x87, 0x31, 0xbe, 0xba, 0x89, 0xb3, 0x3c, 0xd4, 0x2f, 0xc0,
+	0xc3, 0xdf, 0x6a, 0x70, 0x3b, 0xe1, 0xc1, 0xf3, 0xa8, 0x7f, 0x48, 0x6d, 0x3a, 0x0c, 0x22, 0xe7,
+	0x87, 0x70, 0xf1, 0x16, 0xcc, 0x44, 0x92, 0x48, 0xaf, 0x2e, 0x9c, 0xfc, 0xba, 0xc1, 0x83, 0xb9,
+	0x89, 0xad, 0xf1, 0xb8, 0x0d, 0x99, 0xcb, 0x3c, 0xaa, 0xce, 0xbe, 0x1c, 0xe0, 0x5f, 0xc3, 0x8a,
+	0x64, 0x6e, 0xd7, 0x3f, 0x08, 0x36, 0x02, 0x12, 0x39, 0x3f, 0x80, 0x2b, 0x1e, 0x83, 0xfc, 0x83,
+	0xa0, 0x3f, 0xe0, 0x74, 0x84, 0x72, 0x3a, 0x76, 0xdb, 0xd5, 0x84, 0xf1, 0x5f, 0x6b, 0x00, 0xeb,
+	0x23, 0xc7, 0x65, 0x5b, 0x3e, 0x8b, 0xce, 0x2e, 0xe1, 0xb1, 0x2b, 0x30, 0x4d, 0x44, 0x00, 0x50,
+	0x51, 0x4f, 0x8d, 0x38, 0x9c, 0x91, 0xe8, 0x90, 0xea, 0x34, 0xa7, 0x46, 0x3c, 0x2d, 0x3a, 0x94,
+	0x11, 0xd7, 0x8b, 0x95, 0xb0, 0x7a, 0xc8, 0x57, 0xc4, 0xc1, 0x28, 0x52, 0xee, 0xda, 0xb6, 0xd5,
+	0x08, 0xff, 0xa7, 0x06, 0xed, 0xaf, 0xdc, 0xc0, 0x23, 0x82, 0x6e, 0x9a, 0xf8, 0x1a, 0x22, 0xf1,
+	0x9d, 0x73, 0x62, 0xee, 0xc3, 0x5c, 0x62, 0xee, 0xbe, 0x08, 0x7e, 0x92, 0x9d, 0x6e, 0x02, 0xfd,
+	0x39, 0x8f, 0x82, 0x08, 0x1a, 0xd1, 0x28, 0xd1, 0xbf, 0xf8, 0x36, 0x39, 0x6d, 0x16, 0x38, 0x55,
+	0x32, 0x4f, 0xe7, 0x65, 0x56, 0x12, 0xcc, 0x98, 0x12, 0x70, 0x1e, 0x87, 0x11, 0x25, 0x2a, 0x3f,
+	0xb5, 0x64, 0x7e, 0x52, 0x90, 0x75, 0x86, 0xc7, 0x70, 0x8d, 0x5f, 0x40, 0x12, 0x19, 0xe3, 0xe7,
+	0x1e, 0x37, 0x78, 0x8c, 0x70, 0x4f, 0x5c, 0x96, 0x84, 0x73, 0x3e, 0xc0, 0x9f, 0xc2, 0x4a, 0x7e,
+	0x5f, 0x15, 0x12, 0xdf, 0x02, 0x18, 0x27, 0x50, 0x15, 0xaa, 0x93, 0x93, 0x94, 0xe0, 0xdb, 0x06,
+	0x12, 0xfe, 0x77, 0x0d, 0xea, 0x1b, 0xa4, 0x68, 0x1f, 0x04, 0x0d, 0x76, 0x16, 0x26, 0x17, 0x13,
+	0xfe, 0x9d, 0xe3, 0xb6, 0x5e, 0xc2, 0xed, 0x98, 0x78, 0xa3, 0xe4, 0x34, 0x88, 0x01, 0x57, 0x6e,
+	0x44, 0x49, 0x1c, 0xf8, 0xda, 0x3d, 0xe4, 0x08, 0xdd, 0x84, 0xb6, 0x1b, 0xc7, 0x23, 0xea, 0xf4,
+	0x07, 0x67, 0xc2, 0x1e, 0x0d, 0xbb, 0x25, 0x01, 0x1b, 0x67, 0x7c, 0x27, 0x7a, 0x1a, 0xba, 0x11,
+	0x8d, 0xb9, 0xe6, 0x67, 0xa4, 0xe6, 0x15, 0x64, 0x9d, 0x9d, 0x67, 0x98, 0x27, 0x00, 0x1b, 0xc4,
+	0xbf, 0x8a, 0x35, 0xea, 0x03, 0xe2, 0xab, 0x28, 0x38, 0x9b, 0xdc, 0x64, 0x88, 0x6f, 0x73, 0x38,
+	0xfe, 0x00, 0xe6, 0xb9, 0xde, 0x37, 0xc8, 0x15, 0x2c, 0x8d, 0xdf, 0x86, 0x85, 0x74, 0xb1, 0x32,
+	0xd7, 0x1d, 0x68, 0x0c, 0x48, 0x62, 0xa8, 0xcc, 0x86, 0x62, 0x02, 0x7f, 0x01, 0x73, 0x8f, 0xdd,
+	0x03, 0x76, 0x25, 0x61, 0xae, 0xc1, 0xf4, 0x80, 0xf8, 0xa9, 0x5b, 0x35, 0x07, 0x84, 0x27, 0xfb,
+	0x10, 0xe6, 0x37, 0x8f, 0xe8, 0xf0, 0xd8, 0xa0, 0x79, 0x4e, 0x54, 0xca, 0xde, 0x82, 0xa6, 0xce,
+	0xb9, 0x05, 0xd5, 0x0b, 0xb7, 0xa0, 0x5d, 0x58, 0x48, 0x77, 0x4c, 0x73, 0xf7, 0x80, 0xf8, 0x3e,
+	0x75, 0x74, 0xee, 0x96, 0xa3, 0xf3, 0x2c, 0xf0, 0x87, 0x1a, 0xf4, 0xf6, 0x29, 0xdb, 0x8b, 0xdc,
+	0xb1, 0xeb, 0xd1, 0x43, 0xfa, 0x98, 0xe7, 0xcc, 0xcb, 0xab, 0xc6, 0xbc, 0x0f, 0x4e, 0xe5, 0xee,
+	0x83, 0xaf, 0xc1, 0x7c, 0xa8, 0xc9, 0xf7, 0xcd, 0xbb, 0xd4, 0x5c, 0x98, 0xd9, 0x15, 0x7f, 0x5f,
+	0x83, 0xe5, 0x4d, 0xe1, 0x72, 0xb9, 0xdb, 0xe6, 0xf3, 0xe1, 0xc3, 0xbc, 0x97, 0xd6, 0x73, 0xf7,
+	0xd2, 0x65, 0x68, 0xd2, 0x13, 0xe2, 0x7a, 0xfa, 0xa0, 0x89, 0x01, 0xfe, 0x47, 0x0d, 0x56, 0xf4,
+	0x4f, 0xce, 0x73, 0xba, 0x00, 0x27, 0x1b, 0xd5, 0x8d, 0x8d, 0x72, 0x0e, 0xd1, 0xc8, 0x3b, 0xc4,
+	0x2b, 0xd0, 0x19, 0xd3, 0xc8, 0x3d, 0x38, 0xeb, 0xcb, 0xb5, 0x4d, 0x61, 0xe1, 0x59, 0x09, 0xdb,
+	0x12, 0xac, 0xc6, 0x70, 0xbd, 0xc0, 0xa9, 0xf2, 0x8c, 0xd7, 0x61, 0x46, 0xe9, 0x4d, 0xfd, 0x75,
+	0xcc, 0x6b, 0x2f, 0xd0, 0x98, 0x7a, 0x1e, 0xfd, 0x18, 0x90, 0x20, 0xea, 0x0e, 0x45, 0x2c, 0xeb,
+	0xb3, 0xe0, 0x98, 0xea, 0x74, 0xb6, 0x68, 0xce, 0x7c, 0xc9, 0x27, 0xf0, 0x8f, 0x00, 0x7d, 0x95,
+	0xf2, 0xa0, 0x55, 0xc3, 0x53, 0xb8, 0x58, 0x57, 0x53, 0x29, 0x5c, 0xe0, 0xfe, 0x06, 0x6e, 0xec,
+	0xf2, 0x58, 0xb4, 0xa7, 0x34, 0x61, 0xd3, 0x98, 0xb2, 0x17, 0x11, 0xde, 0x8b, 0xea, 0xc5, 0xbf,
+	0x02, 0xab, 0x6c, 0xef, 0xcb, 0xeb, 0x27, 0x11, 0x6d, 0xca, 0x14, 0x6d, 0x07, 0x96, 0x05, 0xc5,
+	0x94, 0xfc, 0x04, 0x45, 0x4c, 0xf2, 0x0e, 0xfc, 0x4b, 0x58, 0xdc, 0xa6, 0xec, 0xca, 0xde, 0x3f,
+	0x59, 0x39, 0xf8, 0x8f, 0x35, 0x58, 0x96, 0xd7, 0xa8, 0x17, 0xb4, 0x43, 0xe6, 0x54, 0xd4, 0x73,
+	0xa7, 0xa2, 0xfc, 0x88, 0xfd, 0xbd, 0x06, 0x68, 0xbf, 0xa8, 0xba, 0xe7, 0xc6, 0xd1, 0xeb, 0xb0,
+	0x30, 0x1c, 0x45, 0x11, 0xf5, 0x59, 0x3f, 0x77, 0xf8, 0xe7, 0x15, 0x5c, 0x6f, 0x9d, 0x31, 0x4c,
+	0x23, 0x67, 0x98, 0x3f, 0xd5, 0x60, 0x71, 0x83, 0xf8, 0x2f, 0x4a, 0x6f, 0x69, 0x46, 0xaf, 0x67,
+	0x32, 0x7a, 0x36, 0x69, 0x37, 0x72, 0x49, 0x1b, 0xff, 0xa5, 0x06, 0x4b, 0x3c, 0x05, 0xaa, 0x7d,
+	0xae, 0x70, 0x5b, 0x7a, 0x0d, 0xe6, 0xb5, 0x81, 0xfa, 0x61, 0x44, 0x0f, 0xdc, 0x53, 0xe5, 0x93,
+	0x73, 0x1a, 0xbc, 0x27, 0xa0, 0xd9, 0x7b, 0x53, 0x53, 0xdd, 0x9b, 0x38, 0xdf, 0xc1, 0xc1, 0x41,
+	0x4c, 0x99, 0xfa, 0x05, 0x56, 0x23, 0xbc, 0x09, 0xcb, 0x59, 0xbe, 0xd4, 0x51, 0x7b, 0x03, 0x5a,
+	0x8a, 0x25, 0x9d, 0xa2, 0x0b, 0x67, 0x2d, 0x41, 0xc0, 0xbf, 0x85, 0xe5, 0x9f, 0x52, 0x8f, 0xbe,
+	0x38, 0x6f, 0xbd, 0x05, 0xed, 0x90, 0x46, 0x27, 0xc4, 0xa7, 0xaa, 0x24, 0xd4, 0xb2, 0x53, 0x00,
+	0x1e, 0xc1, 0xc2, 0x46, 0x14, 0x10, 0x67, 0x48, 0xe2, 0x2b, 0x6c, 0xdd, 0x83, 0x99, 0x13, 0x1a,
+	0xc7, 0xe4, 0x50, 0xe7, 0x21, 0x3d, 0xe4, 0x4c, 0x39, 0xd4, 0x73, 0xc7, 0x34, 0xea, 0x13, 0xb9,
+	0x6d, 0xdd, 0x6e, 0x2b, 0xc8, 0x3a, 0xc3, 0x0f, 0xa0, 0xb3, 0xee, 0xfb, 0xc1, 0xc8, 0x1f, 0xd2,
+	0x13, 0xea, 0x33, 0x93, 0x50, 0x2d, 0x43, 0x08, 0xef, 0x40, 0x57, 0x96, 0xc4, 0x3e, 0x4b, 0x29,
+	0x4f, 0xba, 0x75, 0x54, 0xb2, 0x84, 0x3f, 0x86, 0xc6, 0xa7, 0xee, 0xf0, 0xf8, 0xea, 0x04, 0xfe,
+	0x55, 0x83, 0xe6, 0xd6, 0x98, 0xb3, 0x9b, 0xbf, 0xf4, 0xbe, 0x0f, 0x1d, 0x62, 0x88, 0xa3, 0xee,
+	0x21, 0xcb, 0x89, 0xd5, 0x8d, 0xb9, 0x9d, 0x97, 0xec, 0x0c, 0x2e, 0x7a, 0x04, 0x73, 0xaa, 0x6a,
+	0xa7, 0xb7, 0x95, 0x7f, 0xb2, 0xd7, 0xf4, 0xea, 0x8c, 0xf8, 0x3b, 0x2f, 0xd9, 0xdd, 0x30, 0xa3,
+	0x0f, 0x0c, 0x8d, 0x63, 0x77, 0x78, 0xdc, 0x6b, 0x64, 0x6b, 0x6d, 0x5c, 0xd4, 0x9d, 0x97, 0x6c,
+	0x31, 0xb7, 0xd1, 0x86, 0x99, 0x90, 0x9c, 0x79, 0x01, 0x71, 0xf0, 0x2a, 0x2c, 0xee, 0x05, 0x9e,
+	0x27, 0xe4, 0x48, 0x8e, 0xd2, 0x0d, 0x68, 0x91, 0x03, 0xfe, 0xc7, 0x94, 0x48, 0x35, 0x23, 0xc6,
+	0xbb, 0x0e, 0xfe, 0x12, 0x90, 0x89, 0xaf, 0x5c, 0xfc, 0x3e, 0x4c, 0xd3, 0x31, 0x4d, 0x1d, 0xbc,
+	0xab, 0xb7, 0x15, 0x78, 0xb6, 0x9a, 0x44, 0xd7, 0x61, 0xc6, 0x23, 0xb1, 0xe1, 0x97, 0xd3, 0x7c,
+	0xb8, 0xeb, 0xe0, 0x0f, 0xc0, 0xda, 0xa6, 0x6c, 0x7b, 0xe4, 0x7a, 0xce, 0x90, 0x44, 0x0e, 0xff,
+	0x09, 0x75, 0xe9, 0x05, 0x7f, 0xc2, 0xf1, 0xe7, 0x70, 0xb3, 0x74, 0xb1, 0xe2, 0xed, 0x27, 0x30,
+	0x43, 0x25, 0x48, 0x31, 0xb7, 0xa2, 0x99, 0xcb, 0x2c, 0x39, 0xb3, 0x35, 0x1a, 0x7e, 0x17, 0xae,
+	0x6f, 0x53, 0x26, 0xf5, 0xfc, 0x79, 0x98, 0xf9, 0x25, 0x3b, 0x87, 0x95, 0x10, 0x7a, 0xc5, 0x95,
+	0xe7, 0xd4, 0x99, 0x3e, 0x4c, 0x0c, 0x1e, 0xc8, 0x15, 0xbd, 0xa9, 0x32, 0x83, 0x6b, 0x72, 0xdd,
+	0xd0, 0x1c, 0xe2, 0x33, 0xb0, 0x64, 0xc1, 0xe6, 0x0a, 0xec, 0xfe, 0xc0, 0xad, 0xff, 0x5b, 0x83,
+	0x39, 0x89, 0xf0, 0x38, 0x90, 0x17, 0xa4, 0xf3, 0xf6, 0xbb, 0x05, 0xed, 0x43, 0xad, 0x73, 0x55,
+	0x9d, 0x48, 0x01, 0x97, 0xf8, 0x57, 0x17, 0xc5, 0x64, 0xf5, 0xaf, 0xce, 0xbf, 0x79, 0xa0, 0x16,
+	0xb5, 0x61, 0xf5, 0x6f, 0x28, 0x07, 0xe8, 0x1e, 0x74, 0xc5, 0x47, 0x72, 0xc7, 0x94, 0xbf, 0xeb,
+	0x1d, 0x01, 0xd4, 0xd7, 0xcc, 0x1b, 0xd0, 0xf2, 0x82, 0xc1, 0xe0, 0x8c, 0x33, 0x3c, 0x23, 0x58,
+	0x9a, 0x11, 0x63, 0x59, 0x47, 0x39, 0xe4, 0x6c, 0xb4, 0xe4, 0x4e, 0xfc, 0x1b, 0x7f, 0x03, 0xd6,
+	0xa6, 0x47, 0x49, 0x94, 0x15, 0xfc, 0x82, 0xfa, 0x2e, 0x30, 0x34, 0x55, 0x64, 0x08, 0x3f, 0xd3,
+	0x5a, 0xdd, 0x8b, 0x68, 0x2c, 0x8a, 0x75, 0x17, 0x28, 0xf7, 0x70, 0x85, 0x4c, 0x19, 0x0a, 0xe1,
+	0xa5, 0x08, 0x51, 0x22, 0xd7, 0xb9, 0x55, 0x8e, 0xf0, 0x23, 0x40, 0x3c, 0x47, 0xc9, 0x0d, 0xae,
+	0xf0, 0xfb, 0xb9, 0x0d, 0x4b, 0x99, 0xf5, 0xe9, 0x19, 0xd3, 0xad, 0x8b, 0xdc, 0x19, 0xcb, 0xe9,
+	0x49, 0xa3, 0xe1, 0x33, 0x58, 0x56, 0x11, 0x4b, 0x62, 0x3c, 0xf7, 0x3c, 0x67, 0xc4, 0xed, 0x7a,
+	0x36, 0x6e, 0x8f, 0x61, 0x91, 0x47, 0xc3, 0xff, 0xfb, 0xbe, 0x6f, 0xc1, 0x22, 0xaf, 0x40, 0x67,
+	0xf7, 0xcd, 0x1c, 0x89, 0x5a, 0xee, 0x48, 0xe0, 0xaf, 0x01, 0x99, 0x4b, 0x94, 0xb6, 0x97, 0xa1,
+	0x79, 0x10, 0x8c, 0x7c, 0xfd, 0xd3, 0x2b, 0x07, 0xe8, 0x21, 0x77, 0x64, 0xa9, 0x66, 0x75, 0x8c,
+	0xab, 0x8c, 0x90, 0xe0, 0xe1, 0xf7, 0xa0, 0x27, 0xfe, 0x11, 0xf6, 0x69, 0x1c, 0xeb, 0x1f, 0x9c,
+	0x0b, 0x86, 0xba, 0x57, 0xa1, 0x63, 0xae, 0xca, 0xde, 0xfb, 0x3b, 0xfa, 0x2f, 0xe1, 0x2b, 0xb8,
+	0x21, 0x7f, 0x96, 0xca, 0x76, 0x28, 0x5d, 0x72, 0xb1, 0x43, 0xf2, 0x7d, 0x0d, 0xac, 0x32, 0xc2,
+	0x97, 0xff, 0xbb, 0x59, 0x81, 0xe9, 0xef, 0x48, 0x14, 0x52, 0x69, 0xca, 0x96, 0xad, 0x46, 0xa5,
+	0xb5, 0x76, 0x33, 0x56, 0x34, 0x32, 0xb1, 0x82, 0xb7, 0x17, 0x6c, 0x3a, 0x0e, 0x8e, 0xb5, 0x2a,
+	0x9f, 0x7b, 0x11, 0x0f, 0xbf, 0x0e, 0x8b, 0x5b, 0xbe, 0xa3, 0xc8, 0x4f, 0x54, 0x21, 0xfe, 0x7d,
+	0x0d, 0x66, 0x9f, 0x92, 0x28, 0xe9, 0x4f, 0xde, 0x83, 0x6e, 0x2c, 0xd7, 0xf5, 0x4d, 0xec, 0x4e,
+	0x6c, 0x1a, 0xb0, 0xac, 0x6a, 0x5c, 0xb0, 0x45, 0xfd, 0x9c, 0x08, 0x9a, 0xd5, 0xca, 0xc3, 0xef,
+	0x6f, 0xc9, 0x06, 0x2b, 0xef, 0xdc, 0xa2, 0xf7, 0x61, 0x4e, 0xfc, 0xe7, 0x31, 0x77, 0x4c, 0x39,
+	0x30, 0x46, 0x2b, 0xab, 0xb2, 0x39, 0xbb, 0xaa, 0x9b, 0xb3, 0xab, 0x5b, 0xbc, 0x39, 0x6b, 0x2d,
+	0x98, 0x2d, 0x3a, 0xd1, 0x9c, 0xdd, 0x84, 0x8e, 0xd9, 0x78, 0x45, 0x37, 0x35, 0x46, 0x49, 0x3b,
+	0xd6, 0xaa, 0x20, 0x8b, 0xb6, 0xa0, 0x9b, 0xe9, 0x76, 0xa2, 0x5b, 0xe6, 0x3e, 0xf9, 0x26, 0x68,
+	0x25, 0x99, 0x8f, 0x61, 0x7e, 0x9b, 0xb2, 0x4c, 0x07, 0xb2, 0x4a, 0x90, 0xe4, 0xca, 0x97, 0xc1,
+	0xde, 0x04, 0x48, 0xef, 0x50, 0xe8, 0x46, 0x72, 0x4a, 0xf3, 0xf7, 0x30, 0xcb, 0x2a, 0x9b, 0x52,
+	0x2e, 0xfe, 0x11, 0xb4, 0x93, 0x9b, 0x3a, 0x4a, 0xfa, 0x68, 0xf9, 0xcb, 0x7b, 0xa5, 0x10, 0x9b,
+	0xb0, 0xb8, 0x4f, 0x59, 0x36, 0x30, 0xa0, 0x8a, 0x80, 0x51, 0x49, 0xe4, 0x0b, 0x58, 0x2a, 0x49,
+	0x86, 0x08, 0x27, 0x0d, 0x8f, 0xca, 0x4c, 0x79, 0x21, 0xbe, 0x92, 0x04, 0x98, 0xe3, 0x4b, 0xc3,
+	0x27, 0x18, 0xda, 0xe4, 0xeb, 0xca, 0x64, 0x36, 0x01, 0xd2, 0xe8, 0x9b, 0xda, 0xa9, 0x10, 0xc4,
+	0x2d, 0xab, 0x6c, 0x4a, 0xd9, 0xe9, 0x13, 0x98, 0x35, 0x32, 0x26, 0x4a, 0x50, 0x8b, 0x69, 0xd8,
+	0xba, 0x59, 0x3a, 0xa7, 0xe8, 0x6c, 0x41, 0x37, 0x93, 0x30, 0x53, 0xe7, 0x2d, 0xcb, 0xa3, 0x13,
+	0x9c, 0x17, 0xd2, 0xe4, 0x97, 0xca, 0x54, 0x48, 0x88, 0x95, 0x04, 0x3e, 0x80, 0xce, 0xfe, 0xf0,
+	0x88, 0x3a, 0x23, 0x8f, 0xf2, 0x10, 0x83, 0x92, 0x4e, 0xbd, 0x11, 0x70, 0x2a, 0x17, 0xff, 0x0c,
+	0x96, 0x4a, 0xfa, 0xeb, 0xa9, 0xc3, 0x54, 0x37, 0xdf, 0xad, 0x7c, 0x04, 0x47, 0x9f, 0xc2, 0x62,
+	0x21, 0x77, 0xa1, 0xbb, 0x1a, 0xab, 0x2a, 0xad, 0x99, 0x47, 0xd2, 0x58, 0xf7, 0x4c, 0x17, 0xf5,
+	0x32, 0xd0, 0x57, 0x92, 0x9e, 0x47, 0x55, 0x0e, 0xb3, 0xf0, 0x24, 0x14, 0x65, 0xba, 0x6d, 0x98,
+	0xcb, 0xe6, 0x06, 0x74, 0x3b, 0x0d, 0x5f, 0x25, 0x39, 0x63, 0x92, 0xf1, 0xd2, 0x14, 0x90, 0x1a,
+	0xaf, 0x90, 0x16, 0x2a, 0x09, 0xbc, 0x09, 0xed, 0x0d, 0xe2, 0x2b, 0xe3, 0x23, 0xb3, 0x2e, 0xae,
+	0x16, 0x9a, 0xb5, 0x72, 0xf4, 0x11, 0xb4, 0x74, 0xaf, 0x01, 0x5d, 0x37, 0x7d, 0xd3, 0x68, 0x5d,
+	0x58, 0xbd, 0xe2, 0x84, 0x12, 0xfb, 0x3d, 0x98, 0x51, 0x5d, 0x87, 0xf4, 0xe4, 0x65, 0xdb, 0x10,
+	0x95, 0x7c, 0x7e, 0x04, 0x2d, 0x5d, 0xeb, 0x47, 0x46, 0xfb, 0x34, 0xd3, 0x6f, 0xb0, 0x7a, 0xc5,
+	0x09, 0xb5, 0xf3, 0x67, 0x32, 0x88, 0x64, 0x0a, 0xed, 0xa9, 0x6b, 0x54, 0x55, 0xfe, 0x2b, 0xb9,
+	0x79, 0x04, 0xdd, 0x4c, 0x85, 0x3e, 0x3d, 0x7a, 0x65, 0x85, 0xfb, 0xa2, 0xa7, 0xda, 0x30, 0x9f,
+	0x2b, 0x53, 0xa3, 0x97, 0xf3, 0xf9, 0x2b, 0x47, 0xe3, 0x4e, 0xe5, 0xbc, 0x12, 0xf1, 0x7d, 0x98,
+	0x35, 0xaa, 0xd0, 0x69, 0x58, 0x29, 0x96, 0xa6, 0x8b, 0xfc, 0x3c, 0x03, 0x54, 0xac, 0x0c, 0xa7,
+	0xce, 0x5e, 0x59, 0xb1, 0xb6, 0xf0, 0x24, 0x14, 0xc5, 0xd8, 0x23, 0xe8, 0x66, 0xea, 0xc2, 0xa9,
+	0xb2, 0xca, 0xca, 0xc5, 0x45, 0xe6, 0x1e, 0x41, 0x37, 0x53, 0xae, 0x4d, 0xd7, 0x97, 0x55, 0x71,
+	0x8b, 0xeb, 0xd7, 0x61, 0xd6, 0x28, 0xad, 0xa6, 0x8a, 0x29, 0xd6, 0x5b, 0x2b, 0xed, 0xfd, 0x8e,
+	0xe8, 0xfb, 0x69, 0x82, 0x37, 0x8c, 0x23, 0x91, 0xdb, 0x3c, 0x73, 0x5a, 0x76, 0xa1, 0x63, 0x96,
+	0xff, 0x50, 0x26, 0x9a, 0xe7, 0x8a, 0x95, 0xd6, 0xad, 0xf2, 0x49, 0xa5, 0xc3, 0x77, 0x01, 0xd2,
+	0x8a, 0x78, 0xca, 0x40, 0xa1, 0x4a, 0x5e, 0x94, 0x7e, 0x0b, 0xba, 0x99, 0xf2, 0x61, 0xaa, 0xbd,
+	0xb2, 0xaa, 0xe2, 0x04, 0x8f, 0x5f, 0x90, 0x6f, 0x14, 0x8c, 0x37, 0x01, 0x28, 0x0d, 0xd2, 0x1a,
+	0x56, 0xb9, 0xfe, 0x43, 0xee, 0xf1, 0x61, 0x10, 0xa5, 0xcd, 0x65, 0x54, 0xec, 0x1f, 0x57, 0xae,
+	0xfe, 0x1c, 0xe6, 0xb2, 0x8d, 0xe9, 0x34, 0x5e, 0x96, 0x36, 0xca, 0xad, 0x97, 0xab, 0xa6, 0x95,
+	0x3e, 0x77, 0xa0, 0x9b, 0x79, 0xfb, 0x83, 0x8a, 0xef, 0x8e, 0x34, 0xa9, 0xdb, 0x66, 0x2a, 0x2f,
+	0x3e, 0x16, 0x72, 0x72, 0xaf, 0x88, 0xf4, 0x1b, 0x27, 0xf4, 0x6a, 0xe9, 0xba, 0xdc, 0x4b, 0x2d,
+	0xeb, 0xfe, 0x39, 0x58, 0x69, 0xae, 0xcf, 0x3c, 0x24, 0x4a, 0xad, 0x58, 0xf6, 0xbe, 0xa8, 0x52,
+	0x8f, 0xbb, 0x30, 0x9f, 0x7b, 0xcc, 0x93, 0xc6, 0x9d, 0xf2, 0x57, 0x3e, 0x13, 0xae, 0x42, 0xf3,
+	0xd2, 0x81, 0x2e, 0xa2, 0xc3, 0x2a, 0x22, 0xfb, 0xba, 0x13, 0x93, 0x7d, 0xf1, 0x83, 0xee, 0x65,
+	0x4f, 0x78, 0xe9, 0x7b, 0xa0, 0xc9, 0x42, 0x66, 0x5e, 0xc9, 0x98, 0x42, 0x96, 0x3d, 0x9f, 0xa9,
+	0x24, 0xf5, 0x54, 0x3f, 0xb8, 0xc9, 0xbf, 0x06, 0x42, 0xf7, 0x0b, 0x1c, 0x96, 0xbd, 0x16, 0xaa,
+	0x24, 0xfc, 0x35, 0x2c, 0x95, 0x54, 0x28, 0xd3, 0x6b, 0x4f, 0x75, 0xed, 0xd3, 0xba, 0x37, 0x11,
+	0x47, 0xf9, 0xcb, 0x13, 0x58, 0xc8, 0x97, 0x1d, 0xd1, 0x1d, 0x63, 0x61, 0x59, 0x6d, 0xd0, 0xba,
+	0x5b, 0x8d, 0xa0, 0xc8, 0x7e, 0x01, 0x4b, 0x25, 0xb5, 0xc5, 0x94, 0xed, 0xea, 0xc2, 0x63, 0x95,
+	0x26, 0x36, 0x56, 0x7f, 0xf1, 0xe6, 0xa1, 0xcb, 0x8e, 0x46, 0x83, 0xd5, 0x61, 0x70, 0xb2, 0xe6,
+	0x0c, 0xa3, 0xc0, 0x39, 0x21, 0xbe, 0x7a, 0xb1, 0xbb, 0x56, 0x78, 0xf6, 0x3b, 0x98, 0x16, 0xeb,
+	0xdf, 0xfe, 0xdf, 0x00, 0x09, 0xda, 0xf7, 0xf3, 0x12, 0x2c, 0x00, 0x00,
 }
@@ -0,0 +1,139 @@
+package shipgate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dcrodman/archon/internal/core/proto"
+)
+
+// Used if the config doesn't specify how long ships can go without a heartbeat.
+const defaultShipTimeout = 30 * time.Second
+
+var ErrShipNotRegistered = errors.New("ship is not registered with the shipgate")
+
+type ship struct {
+	id   int
+	name string
+	ip   string
+	port string
+	// Ships are marked inactive once they miss their heartbeats and are
+	// reactivated when they register again.
+	active        bool
+	lastHeartbeat time.Time
+	blocks        []*BlockStatus
+	// Number of players the ship can hold, or 0 if there's no limit.
+	maxPlayers int
+}
+
+// playerCount returns the number of players on all of the ship's blocks.
+func (s *ship) playerCount() int {
+	count := 0
+	for _, block := range s.blocks {
+		count += int(block.PlayerCount)
+	}
+	return count
+}
+
+// shipRegistry keeps track of the ships registered with the shipgate and
+// whether they're still sending heartbeats.
+type shipRegistry struct {
+	mu      sync.Mutex
+	ships   map[string]*ship
+	timeout time.Duration
+	now     func() time.Time
+}
+
+func newShipRegistry(timeout time.Duration) *shipRegistry {
+	if timeout <= 0 {
+		timeout = defaultShipTimeout
+	}
+	return &shipRegistry{
+		ships:   make(map[string]*ship),
+		timeout: timeout,
+		now:     time.Now,
+	}
+}
+
+// register marks the ship as active at the address and returns whether it was
+// already registered.
+func (r *shipRegistry) register(id int, name, ip, port string, maxPlayers int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.ships[name]
+	if !ok {
+		existing = &ship{name: name}
+		r.ships[name] = existing
+	}
+	existing.id = id
+	existing.ip = ip
+	existing.port = port
+	existing.maxPlayers = maxPlayers
+	existing.active = true
+	existing.lastHeartbeat = r.now()
+	return ok
+}
+
+// heartbeat records that the ship is still running with blocks.
+func (r *shipRegistry) heartbeat(name string, blocks []*BlockStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.ships[name]
+	if !ok || !s.active {
+		return ErrShipNotRegistered
+	}
+	s.lastHeartbeat = r.now()
+	s.blocks = blocks
+	return nil
+}
+
+// expire marks ships that have missed their heartbeats as inactive and returns them.
+func (r *shipRegistry) expire() []*ship {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var expired []*ship
+	now := r.now()
+	for _, s := range r.ships {
+		if s.active && now.Sub(s.lastHeartbeat) > r.timeout {
+			s.active = false
+			s.blocks = nil
+			expired = append(expired, s)
+		}
+	}
+	return expired
+}
+
+// active returns copies of the active ships, ordered by ID.
+func (r *shipRegistry) active() []ship {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ships []ship
+	for _, s := range r.ships {
+		if s.active {
+			ships = append(ships, *s)
+		}
+	}
+	sort.Slice(ships, func(i, j int) bool { return ships[i].id < ships[j].id })
+	return ships
+}
+
+// PlayerCountLabel returns the name of a ship or block as shown in the selection
+// menus, with the number of players on it.
+func PlayerCountLabel(name string, players, maxPlayers int) string {
+	if maxPlayers > 0 && players >= maxPlayers {
+		return fmt.Sprintf("%s (FULL)", name)
+	}
+	return fmt.Sprintf("%s (%d)", name, players)
+}
+
+// IsFull returns whether the ship can't take any more players.
+func IsFull(ship *proto.Ship) bool {
+	return ship.MaxPlayers > 0 && ship.PlayerCount >= ship.MaxPlayers
+}
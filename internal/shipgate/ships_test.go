@@ -0,0 +1,103 @@
+package shipgate
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestShipRegistry(t *testing.T) {
+	ships := newShipRegistry(30 * time.Second)
+	now := time.Now()
+	ships.now = func() time.Time { return now }
+
+	if ships.register(2, "Archon", "127.0.0.1", "15000", 0) {
+		t.Errorf("expected ship not to be registered yet")
+	}
+	ships.register(1, "Other", "127.0.0.1", "16000", 0)
+	if err := ships.heartbeat("Archon", []*BlockStatus{{Id: 1, PlayerCount: 3}, {Id: 2, PlayerCount: 2}}); err != nil {
+		t.Fatalf("unexpected error sending heartbeat: %v", err)
+	}
+
+	active := ships.active()
+	if len(active) != 2 || active[0].name != "Other" || active[1].name != "Archon" {
+		t.Fatalf("expected both ships to be active (ordered by ID), got %+v", active)
+	}
+	if count := active[1].playerCount(); count != 5 {
+		t.Errorf("got player count %d, want 5", count)
+	}
+
+	// Only the ship that stops sending heartbeats is deregistered.
+	now = now.Add(20 * time.Second)
+	_ = ships.heartbeat("Other", nil)
+	now = now.Add(20 * time.Second)
+	if expired := ships.expire(); len(expired) != 1 || expired[0].name != "Archon" {
+		t.Fatalf("expected Archon to be deregistered, got %+v", expired)
+	}
+	if active := ships.active(); len(active) != 1 || active[0].name != "Other" {
+		t.Errorf("expected only Other to be active, got %+v", active)
+	}
+	if err := ships.heartbeat("Archon", nil); err != ErrShipNotRegistered {
+		t.Errorf("expected ErrShipNotRegistered for a deregistered ship, got %v", err)
+	}
+
+	if !ships.register(2, "Archon", "127.0.0.1", "15000", 0) {
+		t.Errorf("expected ship to be reactivated")
+	}
+	if active := ships.active(); len(active) != 2 || active[1].id != 2 {
+		t.Errorf("expected Archon to be active again with the same ID, got %+v", active)
+	}
+}
+
+func TestService_ExpireShips(t *testing.T) {
+	s := &service{
+		logger:    zap.NewNop().Sugar(),
+		ships:     newShipRegistry(30 * time.Second),
+		locations: newPlayerLocations(),
+		presences: newPlayerPresences(),
+		verified:  newVerifiedAccounts(),
+	}
+	now := time.Now()
+	s.ships.now = func() time.Time { return now }
+	s.ships.register(1, "Archon", "127.0.0.1", "15000", 0)
+	s.ships.register(2, "Other", "127.0.0.1", "16000", 0)
+
+	s.locations.set(&PlayerLocation{AccountId: 1, Guildcard: 42000001, Ship: "Archon", BlockAddress: "block1"})
+	s.presences.set(&PlayerPresence{AccountId: 2, Ship: "Archon", Server: "SHIP"})
+	s.verified.add("Archon", 1, 42000001, now.Add(time.Hour))
+	s.locations.set(&PlayerLocation{AccountId: 3, Guildcard: 42000003, Ship: "Other", BlockAddress: "block2"})
+	s.presences.set(&PlayerPresence{AccountId: 4, Server: "CHARACTER"})
+
+	// Archon crashes while Other keeps sending heartbeats.
+	now = now.Add(20 * time.Second)
+	_ = s.ships.heartbeat("Other", nil)
+	now = now.Add(20 * time.Second)
+	s.expireShips()
+
+	if s.locations.findAccount(1) != nil || s.presences.online(2) {
+		t.Errorf("expected the players on the expired ship to be offline")
+	}
+	if s.verified.find("Archon", 1) != nil || s.locations.hosting(1, "Archon") {
+		t.Errorf("expected the expired ship not to be hosting its players anymore")
+	}
+	if s.locations.findAccount(3) == nil || !s.presences.online(4) {
+		t.Errorf("expected players elsewhere to still be online")
+	}
+}
+
+func TestPlayerCountLabel(t *testing.T) {
+	tests := []struct {
+		players, maxPlayers int
+		want                string
+	}{
+		{players: 3, maxPlayers: 0, want: "Archon (3)"},
+		{players: 3, maxPlayers: 10, want: "Archon (3)"},
+		{players: 10, maxPlayers: 10, want: "Archon (FULL)"},
+	}
+	for _, tt := range tests {
+		if got := PlayerCountLabel("Archon", tt.players, tt.maxPlayers); got != tt.want {
+			t.Errorf("PlayerCountLabel(%d, %d) = %q, want %q", tt.players, tt.maxPlayers, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package shipgate
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// How long players have to connect to the block they were warped to.
+const warpTTL = time.Minute
+
+type warp struct {
+	slot         uint32
+	blockAddress string
+	lobbyID      uint32
+	expires      time.Time
+}
+
+// pendingWarps holds the warps scheduled for players moving between blocks,
+// keyed by session ID. A warp is picked up by the block it's for when the player
+// arrives there and presents their session token.
+type pendingWarps struct {
+	mu    sync.Mutex
+	warps map[uint64]*warp
+	now   func() time.Time
+}
+
+func newPendingWarps() *pendingWarps {
+	return &pendingWarps{
+		warps: make(map[uint64]*warp),
+		now:   time.Now,
+	}
+}
+
+// schedule records w for the session, replacing any warp already scheduled for it.
+func (p *pendingWarps) schedule(sessionID uint64, w *warp) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	// Expired warps are swept up here rather than on a timer since there are never many of them.
+	for id, existing := range p.warps {
+		if now.After(existing.expires) {
+			delete(p.warps, id)
+		}
+	}
+	w.expires = now.Add(warpTTL)
+	p.warps[sessionID] = w
+}
+
+// take returns the warp scheduled for the session if it's for the block at
+// blockAddress and hasn't expired, or nil otherwise. Warps can only be taken once.
+func (p *pendingWarps) take(sessionID uint64, blockAddress string) *warp {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	w, ok := p.warps[sessionID]
+	if !ok || w.blockAddress != blockAddress {
+		return nil
+	}
+	delete(p.warps, sessionID)
+	if p.now().After(w.expires) {
+		return nil
+	}
+	return w
+}
+
+// playerLocations keeps track of which block each connected player is on,
+// keyed by guildcard.
+type playerLocations struct {
+	mu        sync.RWMutex
+	locations map[uint32]*PlayerLocation
+}
+
+func newPlayerLocations() *playerLocations {
+	return &playerLocations{locations: make(map[uint32]*PlayerLocation)}
+}
+
+func (l *playerLocations) set(location *PlayerLocation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.locations[location.Guildcard] = location
+}
+
+// clear removes the account's location if it's still on the block at blockAddress.
+// Players moving between blocks can be placed on the new one before they're
+// removed from the old one.
+func (l *playerLocations) clear(accountID uint64, blockAddress string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for guildcard, location := range l.locations {
+		if location.AccountId == accountID && location.BlockAddress == blockAddress {
+			delete(l.locations, guildcard)
+		}
+	}
+}
+
+func (l *playerLocations) find(guildcard uint32) *PlayerLocation {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.locations[guildcard]
+}
+
+// findAccount returns the location of the account or nil if it isn't connected.
+func (l *playerLocations) findAccount(accountID uint64) *PlayerLocation {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, location := range l.locations {
+		if location.AccountId == accountID {
+			return location
+		}
+	}
+	return nil
+}
+
+// list returns the location of every connected player sorted by ship, block,
+// lobby, and game.
+func (l *playerLocations) list() []*PlayerLocation {
+	l.mu.RLock()
+	locations := make([]*PlayerLocation, 0, len(l.locations))
+	for _, location := range l.locations {
+		locations = append(locations, location)
+	}
+	l.mu.RUnlock()
+
+	sort.Slice(locations, func(i, j int) bool {
+		a, b := locations[i], locations[j]
+		switch {
+		case a.Ship != b.Ship:
+			return a.Ship < b.Ship
+		case a.Block != b.Block:
+			return a.Block < b.Block
+		case a.LobbyId != b.LobbyId:
+			return a.LobbyId < b.LobbyId
+		case a.Game != b.Game:
+			return a.Game < b.Game
+		default:
+			return a.Guildcard < b.Guildcard
+		}
+	})
+	return locations
+}
+
+// hosting returns whether the account is on one of ship's blocks.
+func (l *playerLocations) hosting(accountID uint64, ship string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, location := range l.locations {
+		if location.AccountId == accountID && strings.EqualFold(location.Ship, ship) {
+			return true
+		}
+	}
+	return false
+}
+
+// clearShip removes the locations of every player on ship.
+func (l *playerLocations) clearShip(ship string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for guildcard, location := range l.locations {
+		if strings.EqualFold(location.Ship, ship) {
+			delete(l.locations, guildcard)
+		}
+	}
+}
+
+// playerPresences keeps track of the players connected to servers other than
+// blocks, which don't have a location, keyed by account ID.
+type playerPresences struct {
+	mu        sync.RWMutex
+	presences map[uint64]map[playerPresenceKey]bool
+}
+
+type playerPresenceKey struct {
+	ship   string
+	server string
+}
+
+func newPlayerPresences() *playerPresences {
+	return &playerPresences{presences: make(map[uint64]map[playerPresenceKey]bool)}
+}
+
+func (p *playerPresences) set(presence *PlayerPresence) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.presences[presence.AccountId] == nil {
+		p.presences[presence.AccountId] = make(map[playerPresenceKey]bool)
+	}
+	p.presences[presence.AccountId][presenceKey(presence)] = true
+}
+
+func (p *playerPresences) clear(presence *PlayerPresence) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.presences[presence.AccountId], presenceKey(presence))
+	if len(p.presences[presence.AccountId]) == 0 {
+		delete(p.presences, presence.AccountId)
+	}
+}
+
+// online returns whether the account is connected to any server other than a block.
+func (p *playerPresences) online(accountID uint64) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.presences[accountID]) > 0
+}
+
+// clearShip removes every player's presence on ship.
+func (p *playerPresences) clearShip(ship string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ship = strings.ToLower(ship)
+	for accountID, presences := range p.presences {
+		for key := range presences {
+			if key.ship == ship {
+				delete(presences, key)
+			}
+		}
+		if len(presences) == 0 {
+			delete(p.presences, accountID)
+		}
+	}
+}
+
+func presenceKey(presence *PlayerPresence) playerPresenceKey {
+	return playerPresenceKey{ship: strings.ToLower(presence.Ship), server: strings.ToLower(presence.Server)}
+}
+
+// verifiedAccounts keeps track of the accounts whose sessions each ship has
+// verified, keyed by ship name and then account ID. Ships can only place players
+// whose sessions they've verified, since being placed on a ship is what lets it
+// act on the player's behalf.
+type verifiedAccounts struct {
+	mu       sync.Mutex
+	accounts map[string]map[uint64]*verifiedAccount
+	now      func() time.Time
+}
+
+type verifiedAccount struct {
+	guildcard uint32
+	// When the verified session expires.
+	expires time.Time
+}
+
+func newVerifiedAccounts() *verifiedAccounts {
+	return &verifiedAccounts{
+		accounts: make(map[string]map[uint64]*verifiedAccount),
+		now:      time.Now,
+	}
+}
+
+// add records that ship verified a session of the account that lasts until expires.
+func (v *verifiedAccounts) add(ship string, accountID uint64, guildcard uint32, expires time.Time) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	ship = strings.ToLower(ship)
+	if v.accounts[ship] == nil {
+		v.accounts[ship] = make(map[uint64]*verifiedAccount)
+	}
+	v.accounts[ship][accountID] = &verifiedAccount{guildcard: guildcard, expires: expires}
+}
+
+// find returns the session of the account verified by ship, or nil if it didn't
+// verify one or the session has expired.
+func (v *verifiedAccounts) find(ship string, accountID uint64) *verifiedAccount {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	account, ok := v.accounts[strings.ToLower(ship)][accountID]
+	if !ok || !v.now().Before(account.expires) {
+		return nil
+	}
+	return account
+}
+
+// verified returns whether ship verified a session of the account with guildcard
+// that hasn't expired.
+func (v *verifiedAccounts) verified(ship string, accountID uint64, guildcard uint32) bool {
+	account := v.find(ship, accountID)
+	return account != nil && account.guildcard == guildcard
+}
+
+// forget removes the account from every ship, e.g. once its sessions are revoked.
+func (v *verifiedAccounts) forget(accountID uint64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, accounts := range v.accounts {
+		delete(accounts, accountID)
+	}
+}
+
+// forgetShip removes every account verified by ship.
+func (v *verifiedAccounts) forgetShip(ship string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.accounts, strings.ToLower(ship))
+}
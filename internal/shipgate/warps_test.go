@@ -0,0 +1,120 @@
+package shipgate
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPendingWarps(t *testing.T) {
+	warps := newPendingWarps()
+	now := time.Now()
+	warps.now = func() time.Time { return now }
+
+	warps.schedule(1, &warp{slot: 2, blockAddress: "127.0.0.1:15002", lobbyID: 3})
+	if w := warps.take(1, "127.0.0.1:15003"); w != nil {
+		t.Errorf("expected no warp for another block, got %+v", w)
+	}
+	w := warps.take(1, "127.0.0.1:15002")
+	if w == nil || w.slot != 2 || w.lobbyID != 3 {
+		t.Fatalf("got warp %+v, want slot 2, lobby 3", w)
+	}
+	if w := warps.take(1, "127.0.0.1:15002"); w != nil {
+		t.Errorf("expected the warp to only be taken once, got %+v", w)
+	}
+
+	warps.schedule(1, &warp{blockAddress: "127.0.0.1:15002"})
+	now = now.Add(warpTTL + time.Second)
+	if w := warps.take(1, "127.0.0.1:15002"); w != nil {
+		t.Errorf("expected no warp after it expired, got %+v", w)
+	}
+}
+
+func TestPlayerLocations(t *testing.T) {
+	locations := newPlayerLocations()
+	locations.set(&PlayerLocation{AccountId: 1, Guildcard: 42000001, BlockAddress: "127.0.0.1:15001"})
+
+	// Moving to another block replaces the location before the old block clears it.
+	locations.set(&PlayerLocation{AccountId: 1, Guildcard: 42000001, BlockAddress: "127.0.0.1:15002"})
+	locations.clear(1, "127.0.0.1:15001")
+	if location := locations.find(42000001); location == nil || location.BlockAddress != "127.0.0.1:15002" {
+		t.Fatalf("expected player to be on the new block, got %v", location)
+	}
+
+	locations.clear(1, "127.0.0.1:15002")
+	if location := locations.find(42000001); location != nil {
+		t.Errorf("expected player's location to be cleared, got %v", location)
+	}
+}
+
+func TestPlayerLocations_List(t *testing.T) {
+	locations := newPlayerLocations()
+	locations.set(&PlayerLocation{AccountId: 3, Guildcard: 42000003, Ship: "Ragol", Block: "BLOCK02"})
+	locations.set(&PlayerLocation{AccountId: 2, Guildcard: 42000002, Ship: "Ragol", Block: "BLOCK01", LobbyId: 1})
+	locations.set(&PlayerLocation{AccountId: 1, Guildcard: 42000001, Ship: "Pioneer", Block: "BLOCK01"})
+	locations.set(&PlayerLocation{AccountId: 4, Guildcard: 42000004, Ship: "Ragol", Block: "BLOCK01", LobbyId: 1, Game: "forest"})
+
+	var got []uint64
+	for _, location := range locations.list() {
+		got = append(got, location.AccountId)
+	}
+	if want := []uint64{1, 2, 4, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got players in order %v, want %v", got, want)
+	}
+
+	if location := locations.findAccount(4); location == nil || location.Game != "forest" {
+		t.Errorf("expected to find the player's location, got %v", location)
+	}
+	if location := locations.findAccount(5); location != nil {
+		t.Errorf("expected no location for a player who isn't connected, got %v", location)
+	}
+}
+
+func TestVerifiedAccounts(t *testing.T) {
+	verified := newVerifiedAccounts()
+	now := time.Now()
+	verified.now = func() time.Time { return now }
+
+	verified.add("Community Ship", 1, 42000001, now.Add(time.Hour))
+	if !verified.verified("community ship", 1, 42000001) {
+		t.Errorf("expected account 1 to be verified by the ship")
+	}
+	if verified.verified("other ship", 1, 42000001) {
+		t.Errorf("expected account 1 not to be verified by another ship")
+	}
+	if verified.verified("community ship", 1, 42000002) {
+		t.Errorf("expected account 1 not to be verified with another guildcard")
+	}
+
+	verified.forget(1)
+	if verified.verified("community ship", 1, 42000001) {
+		t.Errorf("expected account 1 to be forgotten")
+	}
+
+	verified.add("community ship", 2, 42000002, now.Add(time.Hour))
+	now = now.Add(time.Hour + time.Second)
+	if verified.verified("community ship", 2, 42000002) {
+		t.Errorf("expected account 2's session to have expired")
+	}
+}
+
+func TestPlayerPresences(t *testing.T) {
+	presences := newPlayerPresences()
+	presences.set(&PlayerPresence{AccountId: 1, Server: "CHARACTER"})
+	presences.set(&PlayerPresence{AccountId: 1, Ship: "Community Ship", Server: "SHIP"})
+	if !presences.online(1) {
+		t.Errorf("expected account 1 to be online")
+	}
+	if presences.online(2) {
+		t.Errorf("expected account 2 not to be online")
+	}
+
+	presences.clear(&PlayerPresence{AccountId: 1, Server: "character"})
+	if !presences.online(1) {
+		t.Errorf("expected account 1 to still be online on the ship")
+	}
+	presences.clear(&PlayerPresence{AccountId: 1, Ship: "community ship", Server: "ship"})
+	if presences.online(1) {
+		t.Errorf("expected account 1 to be offline after leaving every server")
+	}
+}
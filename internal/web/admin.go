@@ -0,0 +1,441 @@
+package web
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+const (
+	adminCookie = "archon_admin"
+	// Number of character slots on each account.
+	characterSlots = 4
+)
+
+//go:embed templates/*.html
+var templateFiles embed.FS
+
+var adminTemplates = parseAdminTemplates("login.html", "players.html", "accounts.html", "account.html")
+
+var templateFuncs = template.FuncMap{
+	"className":     func(class int32) string { return lookupName(classNames, int(class)) },
+	"sectionIDName": func(id int32) string { return lookupName(sectionIDNames, int(id)) },
+	// Levels are stored starting from 0 but shown to players starting from 1.
+	"displayLevel": func(level uint32) uint32 { return level + 1 },
+	"lobbyNumber":  func(id uint32) uint32 { return id + 1 },
+	"banExpiry": func(expiresAt int64) string {
+		if expiresAt == 0 {
+			return "Never"
+		}
+		return time.Unix(expiresAt, 0).UTC().Format(time.RFC1123)
+	},
+}
+
+// parseAdminTemplates parses each page of the admin console along with the
+// layout they share.
+func parseAdminTemplates(pages ...string) map[string]*template.Template {
+	templates := make(map[string]*template.Template)
+	for _, page := range pages {
+		templates[page] = template.Must(template.New("layout.html").Funcs(templateFuncs).
+			ParseFS(templateFiles, "templates/layout.html", "templates/"+page))
+	}
+	return templates
+}
+
+// adminSession is a staff member logged in to the admin console. Everything
+// they do goes through the shipgate as their account, so the shipgate decides
+// what their role allows.
+type adminSession struct {
+	Account *proto.Account
+	Role    *auth.Role
+	// Token that forms have to include so that other sites can't submit them.
+	CSRF string
+}
+
+// adminPage holds everything passed to the admin console templates.
+type adminPage struct {
+	Session *adminSession
+	Notice  string
+	Error   string
+	Data    interface{}
+}
+
+func (s *Server) registerAdminHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("/admin/login", s.adminLogin)
+	mux.HandleFunc("/admin/logout", s.admin(http.MethodPost, s.adminLogout))
+	mux.HandleFunc("/admin/", s.admin(http.MethodGet, s.adminPlayers))
+	mux.HandleFunc("/admin/accounts", s.admin(http.MethodGet, s.adminAccounts))
+	mux.HandleFunc("/admin/account", s.admin(http.MethodGet, s.adminAccount))
+	mux.HandleFunc("/admin/message", s.admin(http.MethodPost, s.adminMessage))
+	mux.HandleFunc("/admin/kick", s.admin(http.MethodPost, s.adminKick))
+	mux.HandleFunc("/admin/broadcast", s.admin(http.MethodPost, s.adminBroadcast))
+	mux.HandleFunc("/admin/ban", s.admin(http.MethodPost, s.adminBan))
+	mux.HandleFunc("/admin/character", s.admin(http.MethodPost, s.adminEditCharacter))
+}
+
+// admin wraps an admin console handler, only allowing requests using method from
+// logged in staff. Anyone else is sent to the login page, and forms without the
+// session's CSRF token are rejected.
+func (s *Server) admin(method string, handler func(w http.ResponseWriter, r *http.Request, session *adminSession)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		session, err := s.adminSession(r)
+		if err != nil {
+			s.Logger.Warnf("[WEB] error checking admin session: %v", err)
+		}
+		if session == nil {
+			http.Redirect(w, r, "/admin/login", http.StatusSeeOther)
+			return
+		}
+		if method == http.MethodPost && r.PostFormValue("csrf") != session.CSRF {
+			http.Error(w, "invalid form, please reload the page and try again", http.StatusForbidden)
+			return
+		}
+		handler(w, r, session)
+	}
+}
+
+// adminSession returns the staff member logged in with the request's cookie, or
+// nil if there isn't one.
+func (s *Server) adminSession(r *http.Request) (*adminSession, error) {
+	cookie, err := r.Cookie(adminCookie)
+	if err != nil {
+		return nil, nil
+	}
+	token, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return nil, nil
+	}
+
+	resp, err := s.shipgateClient.VerifySessionToken(r.Context(), &shipgate.VerifySessionTokenRequest{Token: token})
+	if err != nil {
+		if shipgate.IsError(err, shipgate.ErrInvalidSession) || shipgate.IsError(err, shipgate.ErrAccountBanned) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	role := s.roles.ForAccount(resp.Account)
+	if !role.Privileged() {
+		return nil, nil
+	}
+
+	csrf := sha256.Sum256([]byte("csrf:" + cookie.Value))
+	return &adminSession{Account: resp.Account, Role: role, CSRF: hex.EncodeToString(csrf[:])}, nil
+}
+
+func (s *Server) adminLogin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.renderAdmin(w, "login.html", adminPage{})
+	case http.MethodPost:
+		account, err := s.shipgateClient.AuthenticateAccount(r.Context(), &shipgate.AuthenticateAccountRequest{
+			Username:  r.PostFormValue("username"),
+			Password:  r.PostFormValue("password"),
+			IpAddress: remoteIP(r),
+		})
+		if err != nil {
+			s.renderAdmin(w, "login.html", adminPage{Error: adminErrorMessage(err)})
+			return
+		}
+		if !s.roles.ForAccount(account).Privileged() {
+			s.renderAdmin(w, "login.html", adminPage{Error: shipgate.ErrPermissionDenied.Error()})
+			return
+		}
+
+		token, err := s.shipgateClient.IssueSessionToken(r.Context(), &shipgate.IssueSessionTokenRequest{AccountId: account.Id})
+		if err != nil {
+			s.Logger.Warnf("[WEB] error issuing admin session for %s: %v", account.Username, err)
+			s.renderAdmin(w, "login.html", adminPage{Error: adminErrorMessage(err)})
+			return
+		}
+		s.Logger.Infof("[WEB] %s logged in to the admin console from %s", account.Username, remoteIP(r))
+		s.setAdminCookie(w, base64.RawURLEncoding.EncodeToString(token.Token), 0)
+		http.Redirect(w, r, "/admin/", http.StatusSeeOther)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) adminLogout(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	// The session is ended on the shipgate too, since a copy of the cookie would
+	// otherwise keep working until it expires.
+	if cookie, err := r.Cookie(adminCookie); err == nil {
+		token, _ := base64.RawURLEncoding.DecodeString(cookie.Value)
+		if _, err := s.shipgateClient.EndSession(r.Context(), &shipgate.EndSessionRequest{Token: token}); err != nil {
+			s.Logger.Warnf("[WEB] error ending admin session for %s: %v", session.Account.Username, err)
+		}
+	}
+	s.setAdminCookie(w, "", -1)
+	http.Redirect(w, r, "/admin/login", http.StatusSeeOther)
+}
+
+func (s *Server) setAdminCookie(w http.ResponseWriter, value string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     adminCookie,
+		Value:    value,
+		Path:     "/admin/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(s.Config.Web.PublicURL, "https://"),
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+type playersData struct {
+	Ships []*shipPlayers
+}
+
+type shipPlayers struct {
+	Name    string
+	Players []*shipgate.PlayerLocation
+}
+
+type accountsData struct {
+	Prefix   string
+	Accounts []*proto.Account
+}
+
+func (s *Server) adminPlayers(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	// Everything else under /admin/ that isn't handled ends up here.
+	if r.URL.Path != "/admin/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	page := adminPage{Session: session, Notice: r.URL.Query().Get("notice"), Error: r.URL.Query().Get("error")}
+	data := &playersData{}
+	page.Data = data
+	resp, err := s.shipgateClient.ListPlayers(r.Context(), &shipgate.ListPlayersRequest{ActorAccountId: session.Account.Id})
+	if err != nil {
+		page.Error = adminErrorMessage(err)
+		s.renderAdmin(w, "players.html", page)
+		return
+	}
+
+	// Players are sorted by ship, so each ship's players are next to each other.
+	for _, player := range resp.Players {
+		if len(data.Ships) == 0 || data.Ships[len(data.Ships)-1].Name != player.Ship {
+			data.Ships = append(data.Ships, &shipPlayers{Name: player.Ship})
+		}
+		ship := data.Ships[len(data.Ships)-1]
+		ship.Players = append(ship.Players, player)
+	}
+	s.renderAdmin(w, "players.html", page)
+}
+
+func (s *Server) adminAccounts(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	prefix := r.URL.Query().Get("username")
+	page := adminPage{Session: session}
+
+	resp, err := s.shipgateClient.ListAccounts(r.Context(), &shipgate.ListAccountsRequest{
+		ActorAccountId: session.Account.Id,
+		UsernamePrefix: prefix,
+	})
+	if err != nil {
+		page.Error = adminErrorMessage(err)
+		resp = &shipgate.ListAccountsResponse{}
+	}
+	page.Data = &accountsData{Prefix: prefix, Accounts: resp.Accounts}
+	s.renderAdmin(w, "accounts.html", page)
+}
+
+type accountDetails struct {
+	Account    *proto.Account
+	Role       string
+	Location   *shipgate.PlayerLocation
+	Characters []*proto.Character
+	Bans       []*shipgate.Ban
+}
+
+func (s *Server) adminAccount(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	accountID, _ := strconv.ParseUint(r.URL.Query().Get("id"), 10, 64)
+	account, err := s.shipgateClient.GetAccount(r.Context(), &shipgate.GetAccountRequest{
+		ActorAccountId: session.Account.Id,
+		AccountId:      accountID,
+	})
+	if err != nil {
+		s.renderAdmin(w, "accounts.html", adminPage{
+			Session: session,
+			Error:   adminErrorMessage(err),
+			Data:    &accountsData{},
+		})
+		return
+	}
+
+	page := adminPage{Session: session, Notice: r.URL.Query().Get("notice"), Error: r.URL.Query().Get("error")}
+	details := &accountDetails{Account: account, Role: s.roles.ForAccount(account).Name}
+
+	// Staff who can manage accounts may still not be able to see players or bans,
+	// in which case those parts of the page are left empty.
+	if players, err := s.shipgateClient.ListPlayers(r.Context(), &shipgate.ListPlayersRequest{ActorAccountId: session.Account.Id}); err == nil {
+		for _, player := range players.Players {
+			if player.AccountId == account.Id {
+				details.Location = player
+			}
+		}
+	}
+	if bans, err := s.shipgateClient.ListBans(r.Context(), &shipgate.ListBansRequest{ActorAccountId: session.Account.Id}); err == nil {
+		for _, ban := range bans.Bans {
+			if ban.AccountId == account.Id {
+				details.Bans = append(details.Bans, ban)
+			}
+		}
+	}
+	for slot := uint32(0); slot < characterSlots; slot++ {
+		resp, err := s.shipgateClient.FindCharacter(r.Context(), &shipgate.CharacterRequest{AccountId: account.Id, Slot: slot})
+		if err != nil {
+			page.Error = adminErrorMessage(err)
+			break
+		}
+		if resp.Exists {
+			details.Characters = append(details.Characters, resp.Character)
+		}
+	}
+
+	page.Data = details
+	s.renderAdmin(w, "account.html", page)
+}
+
+func (s *Server) adminMessage(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	accountID, _ := strconv.ParseUint(r.PostFormValue("account_id"), 10, 64)
+	_, err := s.shipgateClient.MessagePlayer(r.Context(), &shipgate.MessagePlayerRequest{
+		ActorAccountId: session.Account.Id,
+		AccountId:      accountID,
+		Message:        r.PostFormValue("message"),
+	})
+	s.redirectAfterAction(w, r, "Sent message", err)
+}
+
+func (s *Server) adminKick(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	accountID, _ := strconv.ParseUint(r.PostFormValue("account_id"), 10, 64)
+	_, err := s.shipgateClient.KickPlayer(r.Context(), &shipgate.KickPlayerRequest{
+		ActorAccountId: session.Account.Id,
+		AccountId:      accountID,
+		Message:        r.PostFormValue("message"),
+	})
+	s.redirectAfterAction(w, r, "Kicked player", err)
+}
+
+func (s *Server) adminBroadcast(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	_, err := s.shipgateClient.Broadcast(r.Context(), &shipgate.BroadcastRequest{
+		ActorAccountId: session.Account.Id,
+		Message:        r.PostFormValue("message"),
+	})
+	s.redirectAfterAction(w, r, "Sent announcement", err)
+}
+
+func (s *Server) adminBan(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	accountID, _ := strconv.ParseUint(r.PostFormValue("account_id"), 10, 64)
+	req := &shipgate.BanAccountRequest{
+		ActorAccountId: session.Account.Id,
+		AccountId:      accountID,
+		Reason:         r.PostFormValue("reason"),
+	}
+	if duration := r.PostFormValue("duration"); duration != "" {
+		d, err := time.ParseDuration(duration)
+		if err != nil || d <= 0 {
+			s.redirectAfterAction(w, r, "", fmt.Errorf("invalid duration: %s", duration))
+			return
+		}
+		req.ExpiresAt = time.Now().Add(d).Unix()
+	}
+
+	_, err := s.shipgateClient.BanAccount(r.Context(), req)
+	s.redirectAfterAction(w, r, "Banned account", err)
+}
+
+func (s *Server) adminEditCharacter(w http.ResponseWriter, r *http.Request, session *adminSession) {
+	req := &shipgate.EditCharacterRequest{ActorAccountId: session.Account.Id}
+	var err error
+	if req.AccountId, err = strconv.ParseUint(r.PostFormValue("account_id"), 10, 64); err != nil {
+		s.redirectAfterAction(w, r, "", errors.New("invalid account"))
+		return
+	}
+	var level uint32
+	for _, field := range []struct {
+		name  string
+		value *uint32
+	}{
+		{"slot", &req.Slot},
+		{"level", &level},
+		{"experience", &req.Experience},
+		{"meseta", &req.Meseta},
+	} {
+		v, err := strconv.ParseUint(r.PostFormValue(field.name), 10, 32)
+		if err != nil {
+			s.redirectAfterAction(w, r, "", fmt.Errorf("invalid %s: %s", field.name, r.PostFormValue(field.name)))
+			return
+		}
+		*field.value = uint32(v)
+	}
+	if level < 1 {
+		s.redirectAfterAction(w, r, "", errors.New("level must be at least 1"))
+		return
+	}
+	req.Level = level - 1
+
+	_, err = s.shipgateClient.EditCharacter(r.Context(), req)
+	s.redirectAfterAction(w, r, "Saved character", err)
+}
+
+// redirectAfterAction sends the browser back to the page a form was submitted
+// from, showing notice if the action succeeded or err if it didn't.
+func (s *Server) redirectAfterAction(w http.ResponseWriter, r *http.Request, notice string, err error) {
+	target := r.PostFormValue("return")
+	// Only pages on the console are allowed so that forms can't redirect elsewhere.
+	if !strings.HasPrefix(target, "/admin/") || strings.HasPrefix(target, "//") {
+		target = "/admin/"
+	}
+	u, parseErr := url.Parse(target)
+	if parseErr != nil {
+		u = &url.URL{Path: "/admin/"}
+	}
+
+	query := u.Query()
+	if err != nil {
+		s.Logger.Infof("[WEB] admin action %s failed: %v", r.URL.Path, err)
+		query.Set("error", adminErrorMessage(err))
+	} else {
+		query.Set("notice", notice)
+	}
+	u.RawQuery = query.Encode()
+	http.Redirect(w, r, u.String(), http.StatusSeeOther)
+}
+
+func (s *Server) renderAdmin(w http.ResponseWriter, name string, page adminPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := adminTemplates[name].Execute(w, page); err != nil {
+		s.Logger.Warnf("[WEB] error rendering %s: %v", name, err)
+	}
+}
+
+// adminErrorMessage returns the message to show staff for err. Unlike the public
+// API, the console shows the shipgate's errors as they are.
+func adminErrorMessage(err error) string {
+	var twirpErr twirp.Error
+	if errors.As(err, &twirpErr) {
+		return twirpErr.Msg()
+	}
+	return err.Error()
+}
@@ -0,0 +1,314 @@
+package web
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// fakeAdminShipgate implements the calls made by the admin console. Anything else panics.
+type fakeAdminShipgate struct {
+	shipgate.Shipgate
+	accounts map[string]*proto.Account
+	players  []*shipgate.PlayerLocation
+
+	kicked *shipgate.KickPlayerRequest
+	edited *shipgate.EditCharacterRequest
+	// Tokens of the sessions that have been ended.
+	ended map[string]bool
+}
+
+func (f *fakeAdminShipgate) AuthenticateAccount(_ context.Context, req *shipgate.AuthenticateAccountRequest) (*proto.Account, error) {
+	account, ok := f.accounts[req.Username]
+	if !ok || req.Password != "hunter22" {
+		return nil, shipgate.ErrInvalidCredentials
+	}
+	return account, nil
+}
+
+func (f *fakeAdminShipgate) IssueSessionToken(_ context.Context, req *shipgate.IssueSessionTokenRequest) (*shipgate.SessionToken, error) {
+	for username, account := range f.accounts {
+		if account.Id == req.AccountId {
+			return &shipgate.SessionToken{Token: []byte(username)}, nil
+		}
+	}
+	return nil, shipgate.ErrUnknown
+}
+
+func (f *fakeAdminShipgate) VerifySessionToken(_ context.Context, req *shipgate.VerifySessionTokenRequest) (*shipgate.VerifySessionTokenResponse, error) {
+	account, ok := f.accounts[string(req.Token)]
+	if !ok || f.ended[string(req.Token)] {
+		return nil, shipgate.ErrInvalidSession
+	}
+	return &shipgate.VerifySessionTokenResponse{Account: account}, nil
+}
+
+func (f *fakeAdminShipgate) EndSession(_ context.Context, req *shipgate.EndSessionRequest) (*emptypb.Empty, error) {
+	if f.ended == nil {
+		f.ended = make(map[string]bool)
+	}
+	f.ended[string(req.Token)] = true
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeAdminShipgate) ListPlayers(context.Context, *shipgate.ListPlayersRequest) (*shipgate.ListPlayersResponse, error) {
+	return &shipgate.ListPlayersResponse{Players: f.players}, nil
+}
+
+func (f *fakeAdminShipgate) GetAccount(_ context.Context, req *shipgate.GetAccountRequest) (*proto.Account, error) {
+	for _, account := range f.accounts {
+		if account.Id == req.AccountId {
+			return account, nil
+		}
+	}
+	return nil, shipgate.ErrUnknown
+}
+
+func (f *fakeAdminShipgate) FindCharacter(_ context.Context, req *shipgate.CharacterRequest) (*shipgate.FindCharacterResponse, error) {
+	if req.Slot != 0 {
+		return &shipgate.FindCharacterResponse{}, nil
+	}
+	return &shipgate.FindCharacterResponse{
+		Exists:    true,
+		Character: &proto.Character{ReadableName: "Sonic", Class: 9, SectionId: 2, Level: 99, Meseta: 500},
+	}, nil
+}
+
+func (f *fakeAdminShipgate) ListBans(context.Context, *shipgate.ListBansRequest) (*shipgate.ListBansResponse, error) {
+	return &shipgate.ListBansResponse{Bans: []*shipgate.Ban{
+		{Id: 7, AccountId: 2, Reason: "duping"},
+		{Id: 8, AccountId: 3, Reason: "someone else"},
+	}}, nil
+}
+
+func (f *fakeAdminShipgate) KickPlayer(_ context.Context, req *shipgate.KickPlayerRequest) (*emptypb.Empty, error) {
+	f.kicked = req
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeAdminShipgate) EditCharacter(_ context.Context, req *shipgate.EditCharacterRequest) (*emptypb.Empty, error) {
+	if req.AccountId == 2 {
+		return nil, shipgate.ErrPlayerOnline
+	}
+	f.edited = req
+	return &emptypb.Empty{}, nil
+}
+
+func newAdminTestServer(t *testing.T) (*Server, *fakeAdminShipgate) {
+	t.Helper()
+	cfg := &core.Config{}
+	cfg.Web.Admin.Enabled = true
+	roles, err := auth.NewRoles(cfg)
+	if err != nil {
+		t.Fatalf("error loading roles: %v", err)
+	}
+
+	fake := &fakeAdminShipgate{
+		accounts: map[string]*proto.Account{
+			"mod":    {Id: 1, Username: "mod", PrivilegeLevel: []byte{auth.LevelModerator}},
+			"player": {Id: 2, Username: "player"},
+		},
+		players: []*shipgate.PlayerLocation{
+			{AccountId: 2, CharacterName: "Sonic", Ship: "Ragol", Block: "BLOCK01"},
+			{AccountId: 3, CharacterName: "Tails", Ship: "Pioneer", Block: "BLOCK02", Game: "forest"},
+		},
+	}
+	return &Server{Config: cfg, Logger: zap.NewNop().Sugar(), shipgateClient: fake, roles: roles}, fake
+}
+
+// adminRequest makes a request to the console as the account logged in with
+// username, if any, including the session's CSRF token in forms.
+func adminRequest(s *Server, method, path, username string, form url.Values) *httptest.ResponseRecorder {
+	var cookie string
+	if username != "" {
+		cookie = base64.RawURLEncoding.EncodeToString([]byte(username))
+		if form != nil && form.Get("csrf") == "" {
+			r := httptest.NewRequest(http.MethodGet, "/admin/", nil)
+			r.AddCookie(&http.Cookie{Name: adminCookie, Value: cookie})
+			if session, _ := s.adminSession(r); session != nil {
+				form.Set("csrf", session.CSRF)
+			}
+		}
+	}
+
+	r := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+	if form != nil {
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if cookie != "" {
+		r.AddCookie(&http.Cookie{Name: adminCookie, Value: cookie})
+	}
+	w := httptest.NewRecorder()
+	s.handler().ServeHTTP(w, r)
+	return w
+}
+
+func TestAdmin_Login(t *testing.T) {
+	s, _ := newAdminTestServer(t)
+
+	if w := adminRequest(s, http.MethodGet, "/admin/", "", nil); w.Code != http.StatusSeeOther || w.Header().Get("Location") != "/admin/login" {
+		t.Errorf("expected logged out staff to be sent to the login page, got status %d to %s", w.Code, w.Header().Get("Location"))
+	}
+	if w := adminRequest(s, http.MethodGet, "/admin/", "player", nil); w.Code != http.StatusSeeOther {
+		t.Errorf("expected players without a privileged role to be sent to the login page, got status %d", w.Code)
+	}
+
+	tests := []struct {
+		name       string
+		username   string
+		password   string
+		wantCookie bool
+		wantBody   string
+	}{
+		{name: "staff", username: "mod", password: "hunter22", wantCookie: true},
+		{name: "wrong password", username: "mod", password: "guess", wantBody: shipgate.ErrInvalidCredentials.Error()},
+		{name: "player", username: "player", password: "hunter22", wantBody: shipgate.ErrPermissionDenied.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := adminRequest(s, http.MethodPost, "/admin/login", "", url.Values{"username": {tt.username}, "password": {tt.password}})
+
+			var cookie *http.Cookie
+			for _, c := range w.Result().Cookies() {
+				if c.Name == adminCookie {
+					cookie = c
+				}
+			}
+			if (cookie != nil) != tt.wantCookie {
+				t.Fatalf("got session cookie %v, wantCookie %v", cookie, tt.wantCookie)
+			}
+			if tt.wantCookie && (!cookie.HttpOnly || cookie.SameSite != http.SameSiteStrictMode) {
+				t.Errorf("expected the session cookie to be HttpOnly and SameSite=Strict, got %+v", cookie)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("expected the response to contain %q, got:\n%s", tt.wantBody, w.Body)
+			}
+		})
+	}
+}
+
+func TestAdmin_Logout(t *testing.T) {
+	s, fake := newAdminTestServer(t)
+
+	w := adminRequest(s, http.MethodPost, "/admin/logout", "mod", url.Values{})
+	if w.Code != http.StatusSeeOther || w.Header().Get("Location") != "/admin/login" {
+		t.Fatalf("expected to be sent to the login page, got status %d to %s", w.Code, w.Header().Get("Location"))
+	}
+	if !fake.ended["mod"] {
+		t.Errorf("expected the session to be ended on the shipgate")
+	}
+	// A copy of the cookie no longer works.
+	if w := adminRequest(s, http.MethodGet, "/admin/", "mod", nil); w.Code != http.StatusSeeOther || w.Header().Get("Location") != "/admin/login" {
+		t.Errorf("expected the old session to be rejected, got status %d to %s", w.Code, w.Header().Get("Location"))
+	}
+}
+
+func TestAdmin_Players(t *testing.T) {
+	s, _ := newAdminTestServer(t)
+
+	w := adminRequest(s, http.MethodGet, "/admin/", "mod", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusOK)
+	}
+	for _, want := range []string{"<h2>Ragol</h2>", "<h2>Pioneer</h2>", "Sonic", "Tails", "forest", `action="/admin/kick"`} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("expected the players page to contain %q", want)
+		}
+	}
+}
+
+func TestAdmin_Account(t *testing.T) {
+	s, _ := newAdminTestServer(t)
+
+	w := adminRequest(s, http.MethodGet, "/admin/account?id=2", "mod", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusOK)
+	}
+	body := w.Body.String()
+	for _, want := range []string{"<h1>player</h1>", "Sonic on Ragol BLOCK01", "HUcaseal", "Skyly", `value="100"`, "duping", "Never"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected the account page to contain %q", want)
+		}
+	}
+	if strings.Contains(body, "someone else") {
+		t.Error("expected bans of other accounts to be left out")
+	}
+
+	w = adminRequest(s, http.MethodGet, "/admin/account?id=99", "mod", nil)
+	if !strings.Contains(w.Body.String(), shipgate.ErrUnknown.Error()) {
+		t.Errorf("expected unknown accounts to show an error, got:\n%s", w.Body)
+	}
+}
+
+func TestAdmin_Kick(t *testing.T) {
+	s, fake := newAdminTestServer(t)
+
+	form := url.Values{"account_id": {"2"}, "message": {"Be nice"}, "return": {"/admin/"}, "csrf": {"forged"}}
+	if w := adminRequest(s, http.MethodPost, "/admin/kick", "mod", form); w.Code != http.StatusForbidden || fake.kicked != nil {
+		t.Fatalf("expected forms without the session's CSRF token to be rejected, got status %d", w.Code)
+	}
+
+	form.Del("csrf")
+	w := adminRequest(s, http.MethodPost, "/admin/kick", "mod", form)
+	if w.Code != http.StatusSeeOther || w.Header().Get("Location") != "/admin/?notice=Kicked+player" {
+		t.Errorf("expected to be sent back with a notice, got status %d to %s", w.Code, w.Header().Get("Location"))
+	}
+	if fake.kicked == nil || fake.kicked.ActorAccountId != 1 || fake.kicked.AccountId != 2 || fake.kicked.Message != "Be nice" {
+		t.Errorf("got unexpected kick request %v", fake.kicked)
+	}
+}
+
+func TestAdmin_EditCharacter(t *testing.T) {
+	s, fake := newAdminTestServer(t)
+
+	tests := []struct {
+		name         string
+		form         url.Values
+		wantLocation string
+	}{
+		{
+			name:         "edit",
+			form:         url.Values{"account_id": {"3"}, "slot": {"1"}, "level": {"10"}, "experience": {"1200"}, "meseta": {"500"}, "return": {"/admin/account?id=3"}},
+			wantLocation: "/admin/account?id=3&notice=Saved+character",
+		},
+		{
+			name:         "invalid level",
+			form:         url.Values{"account_id": {"3"}, "slot": {"1"}, "level": {"0"}, "experience": {"0"}, "meseta": {"0"}, "return": {"/admin/account?id=3"}},
+			wantLocation: "/admin/account?error=level+must+be+at+least+1&id=3",
+		},
+		{
+			name:         "shipgate error",
+			form:         url.Values{"account_id": {"2"}, "slot": {"1"}, "level": {"10"}, "experience": {"0"}, "meseta": {"0"}, "return": {"/admin/account?id=2"}},
+			wantLocation: "/admin/account?error=" + url.QueryEscape(shipgate.ErrPlayerOnline.Error()) + "&id=2",
+		},
+		{
+			name:         "redirect elsewhere",
+			form:         url.Values{"account_id": {"3"}, "slot": {"1"}, "level": {"10"}, "experience": {"1200"}, "meseta": {"500"}, "return": {"https://example.com/"}},
+			wantLocation: "/admin/?notice=Saved+character",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := adminRequest(s, http.MethodPost, "/admin/character", "mod", tt.form)
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("got redirected to %s, want %s", got, tt.wantLocation)
+			}
+		})
+	}
+
+	if fake.edited == nil || fake.edited.Level != 9 || fake.edited.Experience != 1200 || fake.edited.Meseta != 500 || fake.edited.Slot != 1 {
+		t.Errorf("got unexpected edit request %v", fake.edited)
+	}
+}
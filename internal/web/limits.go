@@ -0,0 +1,56 @@
+package web
+
+import (
+	"sync"
+	"time"
+)
+
+// ipLimiter limits how many times each IP address can do something within a window.
+type ipLimiter struct {
+	mu       sync.Mutex
+	attempts map[string][]time.Time
+	limit    int
+	window   time.Duration
+	now      func() time.Time
+}
+
+// newIPLimiter returns a limiter allowing limit attempts per window, or no limit if it's 0.
+func newIPLimiter(limit int, window time.Duration) *ipLimiter {
+	return &ipLimiter{
+		attempts: make(map[string][]time.Time),
+		limit:    limit,
+		window:   window,
+		now:      time.Now,
+	}
+}
+
+// allow records an attempt from ip and returns whether it's within the limit.
+func (l *ipLimiter) allow(ip string) bool {
+	if l.limit <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	// Attempts outside the window are swept up here rather than on a timer.
+	for key, times := range l.attempts {
+		recent := times[:0]
+		for _, t := range times {
+			if now.Sub(t) < l.window {
+				recent = append(recent, t)
+			}
+		}
+		if len(recent) == 0 {
+			delete(l.attempts, key)
+		} else {
+			l.attempts[key] = recent
+		}
+	}
+
+	if len(l.attempts[ip]) >= l.limit {
+		return false
+	}
+	l.attempts[ip] = append(l.attempts[ip], now)
+	return true
+}
@@ -0,0 +1,86 @@
+package web
+
+import (
+	"fmt"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dcrodman/archon/internal/core"
+)
+
+// Mailer sends emails to players.
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+// newMailer returns the Mailer configured by web.mail.sender.
+func newMailer(cfg *core.Config, logger *zap.SugaredLogger) (Mailer, error) {
+	mail := cfg.Web.Mail
+	switch strings.ToLower(mail.Sender) {
+	case "", "spool":
+		return &spoolMailer{dir: cfg.QualifiedPath(mail.SpoolDir), from: mail.From, now: time.Now}, nil
+	case "log":
+		return &logMailer{logger: logger}, nil
+	case "smtp":
+		return &smtpMailer{
+			address: fmt.Sprintf("%s:%d", mail.SMTP.Host, mail.SMTP.Port),
+			auth:    smtp.PlainAuth("", mail.SMTP.Username, mail.SMTP.Password, mail.SMTP.Host),
+			from:    mail.From,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown mail sender: %s", mail.Sender)
+	}
+}
+
+// logMailer writes emails to the server log instead of sending them, which is
+// enough for testing or for servers whose admins pass links along by hand.
+type logMailer struct {
+	logger *zap.SugaredLogger
+}
+
+func (m *logMailer) Send(to, subject, body string) error {
+	m.logger.Infof("[WEB] email to %s: %s\n%s", to, subject, body)
+	return nil
+}
+
+// spoolMailer writes each email to its own file in a directory instead of
+// sending it, leaving delivery to the admin or another program.
+type spoolMailer struct {
+	dir  string
+	from string
+	now  func() time.Time
+}
+
+func (m *spoolMailer) Send(to, subject, body string) error {
+	if err := os.MkdirAll(m.dir, 0700); err != nil {
+		return fmt.Errorf("error creating mail spool directory: %w", err)
+	}
+	now := m.now()
+	f, err := os.CreateTemp(m.dir, now.Format("20060102-150405-")+"*.eml")
+	if err != nil {
+		return fmt.Errorf("error creating spooled email: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "Date: %s\r\n%s", now.Format(time.RFC1123Z), message(m.from, to, subject, body))
+	return err
+}
+
+// smtpMailer sends emails through an SMTP server.
+type smtpMailer struct {
+	address string
+	auth    smtp.Auth
+	from    string
+}
+
+func (m *smtpMailer) Send(to, subject, body string) error {
+	return smtp.SendMail(m.address, m.auth, m.from, []string{to}, []byte(message(m.from, to, subject, body)))
+}
+
+func message(from, to, subject, body string) string {
+	return fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", from, to, subject, body)
+}
@@ -0,0 +1,417 @@
+// Package web implements the public JSON API used by community websites to show
+// live information about the server and to let players register accounts, as
+// well as the admin console used by staff.
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/auth"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+var classNames = []string{
+	"HUmar", "HUnewearl", "HUcast", "RAmar", "RAcast", "RAcaseal",
+	"FOmarl", "FOnewm", "FOnewearl", "HUcaseal", "FOmar", "RAmarl",
+}
+
+var sectionIDNames = []string{
+	"Viridia", "Greenill", "Skyly", "Bluefull", "Purplenum",
+	"Pinkal", "Redria", "Oran", "Yellowboze", "Whitill",
+}
+
+type Server struct {
+	Config *core.Config
+	Logger *zap.SugaredLogger
+
+	shipgateClient shipgate.Shipgate
+	mailer         Mailer
+	roles          *auth.Roles
+	registrations  *ipLimiter
+	resets         *ipLimiter
+	httpServer     http.Server
+}
+
+// Start serves the API on the configured HTTP port, unless it's set to 0.
+func (s *Server) Start(ctx context.Context) error {
+	if s.Config.Web.HTTPPort == 0 {
+		return nil
+	}
+
+	var err error
+	if s.shipgateClient, err = shipgate.NewRPCClient(s.Config); err != nil {
+		return fmt.Errorf("error creating shipgate client: %w", err)
+	}
+	if s.mailer, err = newMailer(s.Config, s.Logger); err != nil {
+		return err
+	}
+	if s.roles, err = auth.NewRoles(s.Config); err != nil {
+		return fmt.Errorf("error loading roles: %w", err)
+	}
+	registration := s.Config.Web.Registration
+	s.registrations = newIPLimiter(registration.IPLimit, registration.IPWindow)
+	passwordReset := s.Config.Web.PasswordReset
+	s.resets = newIPLimiter(passwordReset.IPLimit, passwordReset.IPWindow)
+	s.httpServer = http.Server{
+		Addr:    fmt.Sprintf(":%d", s.Config.Web.HTTPPort),
+		Handler: s.handler(),
+	}
+
+	go func() {
+		s.Logger.Infof("[WEB] serving API on port %d", s.Config.Web.HTTPPort)
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			s.Logger.Errorf("[WEB] error: %v", err)
+		}
+		s.Logger.Infof("[WEB] exited")
+	}()
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) {
+	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 10*time.Second)
+	_ = s.httpServer.Shutdown(shutdownCtx)
+	shutdownCancel()
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/status", allowCrossOrigin(s.handle(http.MethodGet, s.status)))
+	mux.HandleFunc("/api/ships", allowCrossOrigin(s.handle(http.MethodGet, s.ships)))
+	mux.HandleFunc("/api/characters", allowCrossOrigin(s.handle(http.MethodGet, s.characters)))
+	if s.Config.Web.Registration.Enabled {
+		mux.HandleFunc("/api/register", s.handle(http.MethodPost, s.register))
+		mux.HandleFunc("/api/verify", s.handle(http.MethodGet, s.verify))
+	}
+	if s.Config.Web.PasswordReset.Enabled {
+		mux.HandleFunc("/api/password-reset/request", s.handle(http.MethodPost, s.requestPasswordReset))
+	}
+	mux.HandleFunc("/api/password-reset", s.handle(http.MethodPost, s.resetPassword))
+	if s.Config.Web.Admin.Enabled {
+		s.registerAdminHandlers(mux)
+	}
+	return mux
+}
+
+// apiError is an error whose message can be shown to the caller.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+// handle wraps an API endpoint, only allowing requests using method and writing
+// whatever it returns as JSON.
+func (s *Server) handle(method string, endpoint func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+			return
+		}
+
+		resp, err := endpoint(r)
+		if err != nil {
+			var apiErr *apiError
+			var twirpErr twirp.Error
+			switch {
+			case errors.As(err, &apiErr):
+				writeJSON(w, apiErr.status, errorResponse{Error: apiErr.message})
+			case errors.As(err, &twirpErr) && twirpErr.Code() == twirp.InvalidArgument:
+				// The shipgate marks errors caused by bad input as safe to show.
+				writeJSON(w, http.StatusBadRequest, errorResponse{Error: twirpErr.Msg()})
+			default:
+				s.Logger.Warnf("[WEB] error handling %s: %v", r.URL.Path, err)
+				writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "server error"})
+			}
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// allowCrossOrigin lets any website read the responses of a read-only endpoint,
+// since community websites are expected to call them from browsers. Endpoints
+// that make changes are left to the browser's same-origin policy.
+func allowCrossOrigin(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		h(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type statusResponse struct {
+	StartedAt time.Time `json:"started_at"`
+	Ships     int       `json:"ships"`
+	Players   int       `json:"players"`
+}
+
+func (s *Server) status(r *http.Request) (interface{}, error) {
+	status, err := s.shipgateClient.GetServerStatus(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := statusResponse{StartedAt: time.Unix(status.StartedAt, 0).UTC(), Ships: len(status.Ships)}
+	for _, ship := range status.Ships {
+		resp.Players += int(ship.Ship.PlayerCount)
+	}
+	return resp, nil
+}
+
+type shipResponse struct {
+	ID         int             `json:"id"`
+	Name       string          `json:"name"`
+	Players    int             `json:"players"`
+	MaxPlayers int             `json:"max_players"`
+	Blocks     []blockResponse `json:"blocks"`
+}
+
+type blockResponse struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Players int    `json:"players"`
+}
+
+func (s *Server) ships(r *http.Request) (interface{}, error) {
+	status, err := s.shipgateClient.GetServerStatus(r.Context(), &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := []shipResponse{}
+	for _, ship := range status.Ships {
+		shipResp := shipResponse{
+			ID:         int(ship.Ship.Id),
+			Name:       ship.Ship.Name,
+			Players:    int(ship.Ship.PlayerCount),
+			MaxPlayers: int(ship.Ship.MaxPlayers),
+			Blocks:     []blockResponse{},
+		}
+		for _, block := range ship.Blocks {
+			shipResp.Blocks = append(shipResp.Blocks, blockResponse{
+				ID:      int(block.Id),
+				Name:    block.Name,
+				Players: int(block.PlayerCount),
+			})
+		}
+		resp = append(resp, shipResp)
+	}
+	return resp, nil
+}
+
+type characterResponse struct {
+	Name      string `json:"name"`
+	Class     string `json:"class"`
+	Level     int    `json:"level"`
+	SectionID string `json:"section_id"`
+}
+
+func (s *Server) characters(r *http.Request) (interface{}, error) {
+	resp := []characterResponse{}
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		return resp, nil
+	}
+
+	profiles, err := s.shipgateClient.FindCharacterProfiles(r.Context(), &shipgate.FindCharacterProfilesRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles.Profiles {
+		resp = append(resp, characterResponse{
+			Name:      profile.Name,
+			Class:     lookupName(classNames, int(profile.Class)),
+			Level:     int(profile.Level),
+			SectionID: lookupName(sectionIDNames, int(profile.SectionId)),
+		})
+	}
+	return resp, nil
+}
+
+func lookupName(names []string, i int) string {
+	if i < 0 || i >= len(names) {
+		return "Unknown"
+	}
+	return names[i]
+}
+
+type registerRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Email    string `json:"email"`
+}
+
+type registerResponse struct {
+	Username             string `json:"username"`
+	VerificationRequired bool   `json:"verification_required"`
+}
+
+func (s *Server) register(r *http.Request) (interface{}, error) {
+	var req registerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, &apiError{status: http.StatusBadRequest, message: "invalid request body"}
+	}
+
+	ip := remoteIP(r)
+	if !s.registrations.allow(ip) {
+		return nil, &apiError{status: http.StatusTooManyRequests, message: "too many registrations, try again later"}
+	}
+
+	verify := s.Config.Web.Registration.VerifyEmail
+	resp, err := s.shipgateClient.RegisterAccount(r.Context(), &shipgate.RegisterAccountRequest{
+		Username:    req.Username,
+		Password:    req.Password,
+		Email:       req.Email,
+		IpAddress:   ip,
+		VerifyEmail: verify,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.Logger.Infof("[WEB] registered account %s from %s", resp.Account.Username, ip)
+
+	if resp.VerificationToken != "" {
+		link := s.publicURL() + "/api/verify?token=" + url.QueryEscape(resp.VerificationToken)
+		body := fmt.Sprintf("Welcome, %s!\n\nVisit the link below to verify your email address before logging in:\n\n%s\n",
+			resp.Account.Username, link)
+		if err := s.mailer.Send(resp.Account.Email, "Verify your email address", body); err != nil {
+			// The account can't be verified without the email, so it's removed to let
+			// the player register again with the same details.
+			if _, deleteErr := s.shipgateClient.DeleteAccount(r.Context(), &shipgate.DeleteAccountRequest{
+				AccountId: resp.Account.Id,
+				Permanent: true,
+			}); deleteErr != nil {
+				s.Logger.Warnf("[WEB] error removing unverified account %s: %v", resp.Account.Username, deleteErr)
+			}
+			return nil, fmt.Errorf("error sending verification email to %s: %w", resp.Account.Username, err)
+		}
+	}
+	return registerResponse{Username: resp.Account.Username, VerificationRequired: verify}, nil
+}
+
+type verifyResponse struct {
+	Username string `json:"username"`
+	Verified bool   `json:"verified"`
+}
+
+func (s *Server) verify(r *http.Request) (interface{}, error) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		return nil, &apiError{status: http.StatusBadRequest, message: "missing token"}
+	}
+
+	account, err := s.shipgateClient.VerifyEmail(r.Context(), &shipgate.VerifyEmailRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return verifyResponse{Username: account.Username, Verified: true}, nil
+}
+
+type passwordResetRequest struct {
+	Email string `json:"email"`
+}
+
+type passwordResetRequestResponse struct {
+	Sent bool `json:"sent"`
+}
+
+func (s *Server) requestPasswordReset(r *http.Request) (interface{}, error) {
+	var req passwordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Email == "" {
+		return nil, &apiError{status: http.StatusBadRequest, message: "invalid request body"}
+	}
+
+	ip := remoteIP(r)
+	if !s.resets.allow(ip) {
+		return nil, &apiError{status: http.StatusTooManyRequests, message: "too many requests, try again later"}
+	}
+
+	resp, err := s.shipgateClient.IssuePasswordReset(r.Context(), &shipgate.IssuePasswordResetRequest{Email: req.Email})
+	if err != nil {
+		return nil, err
+	}
+	// The response is the same whether or not the address belongs to an account
+	// so that it can't be used to find out who has one.
+	if resp.Token == "" {
+		s.Logger.Infof("[WEB] password reset requested for unknown email address from %s", ip)
+		return passwordResetRequestResponse{Sent: true}, nil
+	}
+	s.Logger.Infof("[WEB] password reset requested for account %s from %s", resp.Account.Username, ip)
+
+	body := fmt.Sprintf("Hello, %s.\n\nSomeone (hopefully you) asked to reset the password of your account. "+
+		"Use this token to choose a new one at %s/api/password-reset within the next day:\n\n%s\n\n"+
+		"If you didn't ask for this, you can ignore this email.\n",
+		resp.Account.Username, s.publicURL(), resp.Token)
+	if err := s.mailer.Send(resp.Account.Email, "Reset your password", body); err != nil {
+		return nil, fmt.Errorf("error sending password reset email to %s: %w", resp.Account.Username, err)
+	}
+	return passwordResetRequestResponse{Sent: true}, nil
+}
+
+type resetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type resetPasswordResponse struct {
+	Username string `json:"username"`
+}
+
+func (s *Server) resetPassword(r *http.Request) (interface{}, error) {
+	var req resetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Token == "" {
+		return nil, &apiError{status: http.StatusBadRequest, message: "invalid request body"}
+	}
+
+	account, err := s.shipgateClient.ResetPassword(r.Context(), &shipgate.ResetPasswordRequest{
+		Token:    req.Token,
+		Password: req.Password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.Logger.Infof("[WEB] reset password of account %s from %s", account.Username, remoteIP(r))
+	return resetPasswordResponse{Username: account.Username}, nil
+}
+
+// publicURL returns the base URL players reach the API at.
+func (s *Server) publicURL() string {
+	if s.Config.Web.PublicURL != "" {
+		return strings.TrimSuffix(s.Config.Web.PublicURL, "/")
+	}
+	return fmt.Sprintf("http://%s:%d", s.Config.ExternalIP, s.Config.Web.HTTPPort)
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
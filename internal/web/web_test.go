@@ -0,0 +1,497 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dcrodman/archon/internal/core"
+	"github.com/dcrodman/archon/internal/core/proto"
+	"github.com/dcrodman/archon/internal/shipgate"
+)
+
+// fakeShipgate implements the calls made by the API. Anything else panics.
+type fakeShipgate struct {
+	shipgate.Shipgate
+	status   *shipgate.ServerStatus
+	profiles []*shipgate.CharacterProfile
+	err      error
+
+	registered *shipgate.RegisterAccountRequest
+	deleted    *shipgate.DeleteAccountRequest
+	token      string
+}
+
+func (f *fakeShipgate) GetServerStatus(context.Context, *emptypb.Empty) (*shipgate.ServerStatus, error) {
+	return f.status, f.err
+}
+
+func (f *fakeShipgate) FindCharacterProfiles(_ context.Context, req *shipgate.FindCharacterProfilesRequest) (*shipgate.FindCharacterProfilesResponse, error) {
+	return &shipgate.FindCharacterProfilesResponse{Profiles: f.profiles}, f.err
+}
+
+func (f *fakeShipgate) RegisterAccount(_ context.Context, req *shipgate.RegisterAccountRequest) (*shipgate.RegisterAccountResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.registered = req
+	resp := &shipgate.RegisterAccountResponse{Account: &proto.Account{Id: 1, Username: req.Username, Email: req.Email}}
+	if req.VerifyEmail {
+		resp.VerificationToken = f.token
+	}
+	return resp, nil
+}
+
+func (f *fakeShipgate) DeleteAccount(_ context.Context, req *shipgate.DeleteAccountRequest) (*emptypb.Empty, error) {
+	f.deleted = req
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeShipgate) VerifyEmail(_ context.Context, req *shipgate.VerifyEmailRequest) (*proto.Account, error) {
+	if req.Token != f.token {
+		return nil, twirp.NewError(twirp.InvalidArgument, "this link is invalid or has expired")
+	}
+	return &proto.Account{Username: "sonic"}, nil
+}
+
+func (f *fakeShipgate) IssuePasswordReset(_ context.Context, req *shipgate.IssuePasswordResetRequest) (*shipgate.IssuePasswordResetResponse, error) {
+	if req.Email != "sonic@example.com" {
+		return &shipgate.IssuePasswordResetResponse{}, nil
+	}
+	return &shipgate.IssuePasswordResetResponse{
+		Account: &proto.Account{Username: "sonic", Email: req.Email},
+		Token:   f.token,
+	}, nil
+}
+
+func (f *fakeShipgate) ResetPassword(_ context.Context, req *shipgate.ResetPasswordRequest) (*proto.Account, error) {
+	if req.Token != f.token {
+		return nil, twirp.NewError(twirp.InvalidArgument, "this link is invalid or has expired")
+	}
+	return &proto.Account{Username: "sonic"}, nil
+}
+
+type fakeMailer struct {
+	to, subject, body string
+	err               error
+}
+
+func (m *fakeMailer) Send(to, subject, body string) error {
+	m.to, m.subject, m.body = to, subject, body
+	return m.err
+}
+
+func TestServer(t *testing.T) {
+	fake := &fakeShipgate{
+		status: &shipgate.ServerStatus{
+			StartedAt: 1700000000,
+			Ships: []*shipgate.ShipStatus{{
+				Ship: &proto.Ship{Id: 1, Name: "Ragol", PlayerCount: 3, MaxPlayers: 60},
+				Blocks: []*shipgate.BlockStatus{
+					{Id: 1, Name: "BLOCK01", PlayerCount: 2},
+					{Id: 2, Name: "BLOCK02", PlayerCount: 1},
+				},
+			}},
+		},
+		profiles: []*shipgate.CharacterProfile{{Name: "Sonic", Class: 9, Level: 100, SectionId: 2}},
+	}
+	s := &Server{Config: &core.Config{}, Logger: zap.NewNop().Sugar(), shipgateClient: fake}
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		wantCode int
+		want     string
+	}{
+		{
+			name:     "status",
+			path:     "/api/status",
+			wantCode: http.StatusOK,
+			want:     `{"started_at":"2023-11-14T22:13:20Z","ships":1,"players":3}`,
+		},
+		{
+			name:     "ships",
+			path:     "/api/ships",
+			wantCode: http.StatusOK,
+			want: `[{"id":1,"name":"Ragol","players":3,"max_players":60,"blocks":[` +
+				`{"id":1,"name":"BLOCK01","players":2},{"id":2,"name":"BLOCK02","players":1}]}]`,
+		},
+		{
+			name:     "characters",
+			path:     "/api/characters?name=sonic",
+			wantCode: http.StatusOK,
+			want:     `[{"name":"Sonic","class":"HUcaseal","level":100,"section_id":"Skyly"}]`,
+		},
+		{
+			name:     "characters without a name",
+			path:     "/api/characters",
+			wantCode: http.StatusOK,
+			want:     `[]`,
+		},
+		{
+			name:     "not a GET",
+			method:   http.MethodPost,
+			path:     "/api/status",
+			wantCode: http.StatusMethodNotAllowed,
+			want:     `{"error":"method not allowed"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			w := httptest.NewRecorder()
+			s.handler().ServeHTTP(w, httptest.NewRequest(method, tt.path, nil))
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("got status %d, want %d", w.Code, tt.wantCode)
+			}
+			assertJSONEqual(t, tt.want, w.Body.Bytes())
+		})
+	}
+}
+
+func TestServer_ShipgateError(t *testing.T) {
+	s := &Server{Config: &core.Config{}, Logger: zap.NewNop().Sugar(), shipgateClient: &fakeShipgate{err: errors.New("unavailable")}}
+
+	w := httptest.NewRecorder()
+	s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/ships", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+	assertJSONEqual(t, `{"error":"server error"}`, w.Body.Bytes())
+}
+
+func TestServer_Register(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.Web.PublicURL = "https://pso.example.com/"
+	cfg.Web.Registration.Enabled = true
+	cfg.Web.Registration.VerifyEmail = true
+
+	fake := &fakeShipgate{token: "abc123"}
+	mailer := &fakeMailer{}
+	s := &Server{
+		Config:         cfg,
+		Logger:         zap.NewNop().Sugar(),
+		shipgateClient: fake,
+		mailer:         mailer,
+		registrations:  newIPLimiter(1, time.Hour),
+	}
+
+	register := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/api/register", strings.NewReader(body))
+		r.RemoteAddr = "203.0.113.7:50000"
+		w := httptest.NewRecorder()
+		s.handler().ServeHTTP(w, r)
+		return w
+	}
+
+	w := register(`{"username":"sonic","password":"hunter22","email":"sonic@example.com"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d (%s)", w.Code, http.StatusOK, w.Body)
+	}
+	assertJSONEqual(t, `{"username":"sonic","verification_required":true}`, w.Body.Bytes())
+	if fake.registered.IpAddress != "203.0.113.7" || !fake.registered.VerifyEmail {
+		t.Errorf("got unexpected registration request %+v", fake.registered)
+	}
+	if mailer.to != "sonic@example.com" || !strings.Contains(mailer.body, "https://pso.example.com/api/verify?token=abc123") {
+		t.Errorf("expected a verification email to be sent to the player, got %+v", mailer)
+	}
+
+	if w := register(`{"username":"tails","password":"hunter22","email":"tails@example.com"}`); w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected the second registration from the same IP to be limited, got status %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/verify?token=abc123", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d (%s)", w.Code, http.StatusOK, w.Body)
+	}
+	assertJSONEqual(t, `{"username":"sonic","verified":true}`, w.Body.Bytes())
+
+	w = httptest.NewRecorder()
+	s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/verify?token=guess", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	assertJSONEqual(t, `{"error":"this link is invalid or has expired"}`, w.Body.Bytes())
+}
+
+func TestServer_RegisterMailError(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.Web.Registration.Enabled = true
+	cfg.Web.Registration.VerifyEmail = true
+
+	fake := &fakeShipgate{token: "abc123"}
+	s := &Server{
+		Config:         cfg,
+		Logger:         zap.NewNop().Sugar(),
+		shipgateClient: fake,
+		mailer:         &fakeMailer{err: errors.New("mail server unavailable")},
+		registrations:  newIPLimiter(0, 0),
+	}
+
+	w := httptest.NewRecorder()
+	body := `{"username":"sonic","password":"hunter22","email":"sonic@example.com"}`
+	s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/register", strings.NewReader(body)))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+	// The account is removed so that the player can try again with the same details.
+	if fake.deleted == nil || fake.deleted.AccountId != 1 || !fake.deleted.Permanent {
+		t.Errorf("expected the unverified account to be permanently deleted, got %+v", fake.deleted)
+	}
+}
+
+func TestServer_CrossOrigin(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.Web.Registration.Enabled = true
+	s := &Server{
+		Config:         cfg,
+		Logger:         zap.NewNop().Sugar(),
+		shipgateClient: &fakeShipgate{status: &shipgate.ServerStatus{}},
+		mailer:         &fakeMailer{},
+		registrations:  newIPLimiter(0, 0),
+	}
+
+	w := httptest.NewRecorder()
+	s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/status", nil))
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("expected other websites to be able to read the status, got %q", got)
+	}
+
+	w = httptest.NewRecorder()
+	body := `{"username":"sonic","password":"hunter22","email":"sonic@example.com"}`
+	s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/register", strings.NewReader(body)))
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("expected registration not to allow other websites, got %q", got)
+	}
+}
+
+func TestServer_RegisterErrors(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.Web.Registration.Enabled = true
+
+	tests := []struct {
+		name     string
+		body     string
+		err      error
+		wantCode int
+		want     string
+	}{
+		{
+			name:     "invalid body",
+			body:     `{"username":`,
+			wantCode: http.StatusBadRequest,
+			want:     `{"error":"invalid request body"}`,
+		},
+		{
+			name:     "invalid input",
+			body:     `{"username":"Sonic","password":"hunter22","email":"sonic@example.com"}`,
+			err:      twirp.NewError(twirp.InvalidArgument, "usernames can only contain lowercase letters"),
+			wantCode: http.StatusBadRequest,
+			want:     `{"error":"usernames can only contain lowercase letters"}`,
+		},
+		{
+			name:     "shipgate error",
+			body:     `{"username":"sonic","password":"hunter22","email":"sonic@example.com"}`,
+			err:      twirp.InternalError("database unavailable"),
+			wantCode: http.StatusInternalServerError,
+			want:     `{"error":"server error"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{
+				Config:         cfg,
+				Logger:         zap.NewNop().Sugar(),
+				shipgateClient: &fakeShipgate{err: tt.err},
+				registrations:  newIPLimiter(0, 0),
+			}
+			w := httptest.NewRecorder()
+			s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/register", strings.NewReader(tt.body)))
+			if w.Code != tt.wantCode {
+				t.Fatalf("got status %d, want %d", w.Code, tt.wantCode)
+			}
+			assertJSONEqual(t, tt.want, w.Body.Bytes())
+		})
+	}
+}
+
+func TestServer_RegistrationDisabled(t *testing.T) {
+	s := &Server{Config: &core.Config{}, Logger: zap.NewNop().Sugar(), shipgateClient: &fakeShipgate{}}
+
+	w := httptest.NewRecorder()
+	s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/register", strings.NewReader(`{}`)))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestServer_PasswordReset(t *testing.T) {
+	cfg := &core.Config{}
+	cfg.Web.PublicURL = "https://pso.example.com"
+	cfg.Web.PasswordReset.Enabled = true
+
+	mailer := &fakeMailer{}
+	s := &Server{
+		Config:         cfg,
+		Logger:         zap.NewNop().Sugar(),
+		shipgateClient: &fakeShipgate{token: "abc123"},
+		mailer:         mailer,
+		resets:         newIPLimiter(2, time.Hour),
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		wantCode int
+		want     string
+		wantMail bool
+	}{
+		{
+			name:     "request for unknown email",
+			path:     "/api/password-reset/request",
+			body:     `{"email":"tails@example.com"}`,
+			wantCode: http.StatusOK,
+			want:     `{"sent":true}`,
+		},
+		{
+			name:     "request",
+			path:     "/api/password-reset/request",
+			body:     `{"email":"sonic@example.com"}`,
+			wantCode: http.StatusOK,
+			want:     `{"sent":true}`,
+			wantMail: true,
+		},
+		{
+			name:     "request over the limit",
+			path:     "/api/password-reset/request",
+			body:     `{"email":"sonic@example.com"}`,
+			wantCode: http.StatusTooManyRequests,
+			want:     `{"error":"too many requests, try again later"}`,
+		},
+		{
+			name:     "reset",
+			path:     "/api/password-reset",
+			body:     `{"token":"abc123","password":"hunter22"}`,
+			wantCode: http.StatusOK,
+			want:     `{"username":"sonic"}`,
+		},
+		{
+			name:     "reset with invalid token",
+			path:     "/api/password-reset",
+			body:     `{"token":"guess","password":"hunter22"}`,
+			wantCode: http.StatusBadRequest,
+			want:     `{"error":"this link is invalid or has expired"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*mailer = fakeMailer{}
+			w := httptest.NewRecorder()
+			s.handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body)))
+			if w.Code != tt.wantCode {
+				t.Fatalf("got status %d, want %d", w.Code, tt.wantCode)
+			}
+			assertJSONEqual(t, tt.want, w.Body.Bytes())
+
+			if sent := mailer.to != ""; sent != tt.wantMail {
+				t.Fatalf("got email sent %v, want %v", sent, tt.wantMail)
+			}
+			if tt.wantMail && (mailer.to != "sonic@example.com" || !strings.Contains(mailer.body, "abc123")) {
+				t.Errorf("expected the reset token to be emailed to the player, got %+v", mailer)
+			}
+		})
+	}
+}
+
+func TestSpoolMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	m := &spoolMailer{dir: dir, from: "archon@localhost", now: time.Now}
+
+	for i := 0; i < 2; i++ {
+		if err := m.Send("sonic@example.com", "Reset your password", "abc123"); err != nil {
+			t.Fatalf("Send() returned an unexpected error: %v", err)
+		}
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("error reading spool directory: %v", err)
+	} else if len(files) != 2 {
+		t.Fatalf("expected one file per email, got %d", len(files))
+	}
+	contents, _ := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	for _, want := range []string{"To: sonic@example.com", "Subject: Reset your password", "abc123"} {
+		if !strings.Contains(string(contents), want) {
+			t.Errorf("expected the spooled email to contain %q, got:\n%s", want, contents)
+		}
+	}
+}
+
+func TestLookupName_SectionIDs(t *testing.T) {
+	want := []string{
+		"Viridia", "Greenill", "Skyly", "Bluefull", "Purplenum",
+		"Pinkal", "Redria", "Oran", "Yellowboze", "Whitill",
+	}
+	for id, name := range want {
+		if got := lookupName(sectionIDNames, id); got != name {
+			t.Errorf("section ID %d: got %q, want %q", id, got, name)
+		}
+	}
+	if got := lookupName(sectionIDNames, len(want)); got != "Unknown" {
+		t.Errorf("expected an unknown section ID to be Unknown, got %q", got)
+	}
+}
+
+func TestIPLimiter(t *testing.T) {
+	now := time.Now()
+	l := newIPLimiter(2, time.Hour)
+	l.now = func() time.Time { return now }
+
+	if !l.allow("203.0.113.7") || !l.allow("203.0.113.7") {
+		t.Fatal("expected attempts within the limit to be allowed")
+	}
+	if l.allow("203.0.113.7") {
+		t.Error("expected attempts over the limit to be denied")
+	}
+	if !l.allow("198.51.100.1") {
+		t.Error("expected attempts from other addresses to be allowed")
+	}
+
+	now = now.Add(time.Hour)
+	if !l.allow("203.0.113.7") {
+		t.Error("expected attempts to be allowed again once the window passes")
+	}
+}
+
+func assertJSONEqual(t *testing.T, want string, got []byte) {
+	t.Helper()
+	var wantValue, gotValue interface{}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("invalid expected JSON: %v", err)
+	}
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("response is not valid JSON: %v (%s)", err, got)
+	}
+	if diff := cmp.Diff(wantValue, gotValue); diff != "" {
+		t.Errorf("got the wrong response; diff:\n%s", diff)
+	}
+}